@@ -0,0 +1,81 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Contributor comparison action
+
+package actions
+
+import (
+	"fmt"
+	"git-stats/analyzers"
+	"git-stats/cli"
+	"git-stats/git"
+	"git-stats/models"
+)
+
+// CompareWithConfig compares the contributors listed in config.Compare side by side
+func CompareWithConfig(config *cli.Config) error {
+	repo, err := git.NewGitRepository(git.RepositoryConfig{Path: config.RepoPath})
+	if err != nil {
+		return NewCommandError(ErrRepositoryAccess, "Failed to open repository", err)
+	}
+
+	repoInfo, err := repo.GetRepositoryInfo()
+	if err != nil {
+		return NewCommandError(ErrRepositoryAccess, "Failed to read repository info", err)
+	}
+	if repoInfo.TotalCommits == 0 {
+		return NewCommandError(ErrExecutionFailed, "Repository has no commits yet", nil)
+	}
+
+	startDate := getStartTime(config.Since)
+	endDate := getEndTime(config.Until)
+
+	// The -compare selectors pick the authors; the validator rejects -author alongside them
+	commits, err := repo.GetCommits(startDate, endDate, "")
+	if err != nil {
+		return NewCommandError(ErrExecutionFailed, "Failed to read commits", err)
+	}
+
+	modelCommits := convertGitCommitsToModelCommits(commits)
+	modelCommits, teams, err := selectTeamCommits(config, modelCommits)
+	if err != nil {
+		return NewCommandError(ErrInvalidConfiguration, "Failed to load teams", err)
+	}
+	if config.Limit > 0 && len(modelCommits) > config.Limit {
+		modelCommits = modelCommits[:config.Limit]
+	}
+
+	analysisConfig := models.AnalysisConfig{
+		TimeRange: models.TimeRange{
+			Start: startDate,
+			End:   endDate,
+		},
+		IncludeMerges: true,
+		Limit:         config.Limit,
+	}
+
+	comparison, err := analyzers.NewComparisonAnalyzer().CompareContributors(modelCommits, config.Compare, analysisConfig)
+	if err != nil {
+		return NewCommandError(ErrInvalidConfiguration, fmt.Sprintf("Cannot compare contributors: %v", err), err)
+	}
+
+	analysisResult := &models.AnalysisResult{
+		Repository: &models.RepositoryInfo{
+			Path:         repoInfo.Path,
+			Name:         repoInfo.Name,
+			TotalCommits: repoInfo.TotalCommits,
+			FirstCommit:  repoInfo.FirstCommit,
+			LastCommit:   repoInfo.LastCommit,
+			Branches:     repoInfo.Branches,
+		},
+		Comparison: comparison,
+		TimeRange:  comparison.TimeRange,
+	}
+
+	analysisResult.Teams = teamRollups(config, teams, modelCommits)
+
+	if err := writeAnalysisOutput(analysisResult, config, "compare"); err != nil {
+		return NewCommandError(ErrExecutionFailed, fmt.Sprintf("Failed to write %s output", config.Format), err)
+	}
+
+	return nil
+}
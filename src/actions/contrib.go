@@ -106,6 +106,11 @@ func ContribWithConfig(config *cli.Config) {
 
 	// Convert git.Commit to models.Commit
 	modelCommits := convertGitCommitsToModelCommits(commits)
+	modelCommits, teams, err := selectTeamCommits(config, modelCommits)
+	if err != nil {
+		fmt.Printf("Error loading teams: %v\n", err)
+		return
+	}
 
 	// Apply limit if specified
 	if config.Limit > 0 && len(modelCommits) > config.Limit {
@@ -118,7 +123,7 @@ func ContribWithConfig(config *cli.Config) {
 		fmt.Printf("Error getting contributors: %v\n", err)
 		return
 	}
-	modelContributors := convertGitContributorsToModelContributors(gitContributors)
+	modelContributors := teamContributors(config, convertGitContributorsToModelContributors(gitContributors), modelCommits)
 
 	// Create analysis configuration
 	analysisConfig := models.AnalysisConfig{
@@ -129,6 +134,9 @@ func ContribWithConfig(config *cli.Config) {
 		AuthorFilter:  config.Author,
 		IncludeMerges: true,
 		Limit:         config.Limit,
+		Metric:        config.Metric,
+		Levels:        config.Levels,
+		Thresholds:    config.Thresholds,
 	}
 
 	// Analyze contributions
@@ -175,16 +183,27 @@ func ContribWithConfig(config *cli.Config) {
 		},
 	}
 
-	// Handle different output formats
-	switch config.Format {
-	case "json":
-		err = outputJSON(analysisResult, config)
-	case "csv":
-		err = outputCSV(analysisResult, config)
-	default:
-		err = outputTerminal(analysisResult, config, "contrib")
+	// -year and -years show one calendar per year
+	if config.HasYears() {
+		analysisResult.ContribYears = contribAnalyzer.SummarizeYears(contribGraph, config.FromYear, config.ToYear)
+	}
+
+	// -by-author colors days by author or draws one calendar per author; -by-team does the same
+	// per team, one calendar per team unless -by-author picks the mode
+	if config.ByTeam {
+		if config.ByAuthor == "" {
+			config.ByAuthor = models.ByAuthorMultiples
+		}
+		contribAnalyzer.AnalyzeTeams(contribGraph, modelCommits, analysisConfig, teams)
+	} else if config.ByAuthor != "" {
+		contribAnalyzer.AnalyzeAuthors(contribGraph, modelCommits, analysisConfig)
 	}
 
+	analysisResult.Teams = teamRollups(config, teams, modelCommits)
+
+	// Handle different output formats
+	err = writeAnalysisOutput(analysisResult, config, "contrib")
+
 	if err != nil {
 		fmt.Printf("Error generating output: %v\n", err)
 		return
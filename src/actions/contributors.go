@@ -91,6 +91,11 @@ func ContributorsWithConfig(config *cli.Config) {
 
 	// Convert git.Commit to models.Commit
 	modelCommits := convertGitCommitsToModelCommits(commits)
+	modelCommits, teams, err := selectTeamCommits(config, modelCommits)
+	if err != nil {
+		fmt.Printf("Error loading teams: %v\n", err)
+		return
+	}
 
 	// Apply limit if specified
 	if config.Limit > 0 && len(modelCommits) > config.Limit {
@@ -103,7 +108,7 @@ func ContributorsWithConfig(config *cli.Config) {
 		fmt.Printf("Error getting contributors: %v\n", err)
 		return
 	}
-	modelContributors := convertGitContributorsToModelContributors(gitContributors)
+	modelContributors := withPunchCards(teamContributors(config, convertGitContributorsToModelContributors(gitContributors), modelCommits), modelCommits)
 
 	// Create analysis configuration
 	analysisConfig := models.AnalysisConfig{
@@ -114,6 +119,9 @@ func ContributorsWithConfig(config *cli.Config) {
 		AuthorFilter:  config.Author,
 		IncludeMerges: true,
 		Limit:         config.Limit,
+		Metric:        config.Metric,
+		Levels:        config.Levels,
+		Thresholds:    config.Thresholds,
 	}
 
 	// Analyze statistics for additional context
@@ -160,15 +168,10 @@ func ContributorsWithConfig(config *cli.Config) {
 		},
 	}
 
+	analysisResult.Teams = teamRollups(config, teams, modelCommits)
+
 	// Handle different output formats
-	switch config.Format {
-	case "json":
-		err = outputJSON(analysisResult, config)
-	case "csv":
-		err = outputCSV(analysisResult, config)
-	default:
-		err = outputTerminal(analysisResult, config, "contributors")
-	}
+	err = writeAnalysisOutput(analysisResult, config, "contributors")
 
 	if err != nil {
 		fmt.Printf("Error generating output: %v\n", err)
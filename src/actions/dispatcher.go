@@ -26,6 +26,12 @@ func NewCommandDispatcher() *CommandDispatcher {
 
 // ExecuteCommand dispatches and executes the appropriate command based on configuration
 func (d *CommandDispatcher) ExecuteCommand(config *cli.Config) error {
+	// "gui" is accepted as a command name and runs the GUI on the contribution view
+	if config != nil && config.Command == "gui" {
+		config.GUIMode = true
+		config.Command = "contrib"
+	}
+
 	// Validate configuration
 	if err := d.validateConfiguration(config); err != nil {
 		return NewCommandError(ErrInvalidConfiguration, fmt.Sprintf("Configuration validation failed: %v", err), err)
@@ -41,6 +47,11 @@ func (d *CommandDispatcher) ExecuteCommand(config *cli.Config) error {
 		return NewCommandError(ErrRepositoryAccess, fmt.Sprintf("Repository validation failed: %v", err), err)
 	}
 
+	// GUI mode shows every view, so it is routed independently of the command
+	if config.GUIMode {
+		return d.executeGUICommand(config)
+	}
+
 	// Route to appropriate command handler
 	switch config.Command {
 	case "contrib":
@@ -51,6 +62,16 @@ func (d *CommandDispatcher) ExecuteCommand(config *cli.Config) error {
 		return d.executeContributorsCommand(config)
 	case "health":
 		return d.executeHealthCommand(config)
+	case "compare":
+		return d.executeCompareCommand(config)
+	case "graph":
+		return d.executeGraphCommand(config)
+	case "punchcard":
+		return d.executePunchCardCommand(config)
+	case "files":
+		return d.executeFilesCommand(config)
+	case "growth":
+		return d.executeGrowthCommand(config)
 	default:
 		return NewCommandError(ErrUnknownCommand, fmt.Sprintf("Unknown command: %s", config.Command), nil)
 	}
@@ -64,10 +85,50 @@ func (d *CommandDispatcher) validateConfiguration(config *cli.Config) error {
 
 	// Validate individual components but skip repository validation
 	// (we'll do that separately with better error classification)
+	if err := d.validator.ValidateYears(config); err != nil {
+		return err
+	}
+
 	if err := d.validator.ValidateDateRange(config.Since, config.Until); err != nil {
 		return err
 	}
 
+	if err := d.validator.ValidateMetric(config.Metric); err != nil {
+		return err
+	}
+
+	if err := d.validator.ValidateLevels(config); err != nil {
+		return err
+	}
+
+	if err := d.validator.ValidateByAuthor(config); err != nil {
+		return err
+	}
+
+	if err := d.validator.ValidateGrowth(config); err != nil {
+		return err
+	}
+
+	if err := d.validator.ValidateByDirectory(config); err != nil {
+		return err
+	}
+
+	if err := d.validator.ValidateTeam(config); err != nil {
+		return err
+	}
+
+	if _, err := loadTeams(config); err != nil {
+		return err
+	}
+
+	if err := d.validator.ValidateColorMode(config.Color); err != nil {
+		return err
+	}
+
+	if _, err := resolveColorTheme(config); err != nil {
+		return err
+	}
+
 	if config.Author != "" {
 		if err := d.validator.ValidateAuthor(config.Author); err != nil {
 			return err
@@ -78,7 +139,41 @@ func (d *CommandDispatcher) validateConfiguration(config *cli.Config) error {
 		return err
 	}
 
-	if config.OutputFile != "" {
+	if err := d.validator.ValidateTemplate(config.Format, config.Template); err != nil {
+		return err
+	}
+
+	if err := d.validator.ValidateCSVOptions(config); err != nil {
+		return err
+	}
+
+	if err := d.validator.ValidateCompare(config); err != nil {
+		return err
+	}
+
+	if err := d.validator.ValidateGraph(config); err != nil {
+		return err
+	}
+
+	for _, format := range config.Formats() {
+		if format == "svg" && (config.Command != "contrib" && config.Command != "punchcard" || config.GUIMode) {
+			return fmt.Errorf("svg format is only available with -contrib and -punchcard")
+		}
+	}
+
+	if config.Format == "xlsx" && config.OutputFile == "" {
+		return fmt.Errorf("xlsx format requires -output <file.xlsx>")
+	}
+
+	if len(config.Formats()) > 1 && config.OutputFile == "" {
+		return fmt.Errorf("multiple formats require -output <base path>")
+	}
+
+	if config.GUIMode && (config.Format != "terminal" || config.OutputFile != "") {
+		return fmt.Errorf("GUI mode cannot be combined with -format %s or -output", config.Format)
+	}
+
+	if config.OutputFile != "" && !config.SplitsCSVOutput() {
 		if err := d.validator.ValidateOutputFile(config.OutputFile); err != nil {
 			return err
 		}
@@ -89,7 +184,7 @@ func (d *CommandDispatcher) validateConfiguration(config *cli.Config) error {
 	}
 
 	// Validate command separately
-	validCommands := []string{"contrib", "summary", "contributors", "health"}
+	validCommands := []string{"contrib", "summary", "contributors", "files", "health", "compare", "graph", "punchcard", "growth"}
 	validCommand := false
 	for _, valid := range validCommands {
 		if config.Command == valid {
@@ -170,11 +265,6 @@ func (d *CommandDispatcher) executeContribCommand(config *cli.Config) error {
 		}
 	}()
 
-	if config.GUIMode {
-		LaunchGUI(config)
-		return nil
-	}
-
 	// The ContribWithConfig function handles its own errors
 	// and prints directly to stdout/stderr, so we just call it
 	ContribWithConfig(config)
@@ -189,11 +279,6 @@ func (d *CommandDispatcher) executeSummaryCommand(config *cli.Config) error {
 		}
 	}()
 
-	if config.GUIMode {
-		LaunchGUI(config)
-		return nil
-	}
-
 	SummarizeWithConfig(config)
 	return nil
 }
@@ -206,11 +291,6 @@ func (d *CommandDispatcher) executeContributorsCommand(config *cli.Config) error
 		}
 	}()
 
-	if config.GUIMode {
-		LaunchGUI(config)
-		return nil
-	}
-
 	ContributorsWithConfig(config)
 	return nil
 }
@@ -223,15 +303,76 @@ func (d *CommandDispatcher) executeHealthCommand(config *cli.Config) error {
 		}
 	}()
 
-	if config.GUIMode {
-		LaunchGUI(config)
-		return nil
-	}
-
 	HealthWithConfig(config)
 	return nil
 }
 
+// executeCompareCommand executes the contributor comparison command
+func (d *CommandDispatcher) executeCompareCommand(config *cli.Config) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = NewCommandError(ErrExecutionFailed, fmt.Sprintf("Fatal error in contributor comparison: %v", r), nil)
+		}
+	}()
+
+	return CompareWithConfig(config)
+}
+
+// executeGraphCommand executes the commit graph command
+func (d *CommandDispatcher) executeGraphCommand(config *cli.Config) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = NewCommandError(ErrExecutionFailed, fmt.Sprintf("Fatal error in commit graph: %v", r), nil)
+		}
+	}()
+
+	return GraphWithConfig(config)
+}
+
+// executePunchCardCommand executes the hour by weekday punch card command
+func (d *CommandDispatcher) executePunchCardCommand(config *cli.Config) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = NewCommandError(ErrExecutionFailed, fmt.Sprintf("Fatal error in punch card: %v", r), nil)
+		}
+	}()
+
+	return PunchCardWithConfig(config)
+}
+
+// executeGrowthCommand executes the lines of code growth command
+func (d *CommandDispatcher) executeGrowthCommand(config *cli.Config) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = NewCommandError(ErrExecutionFailed, fmt.Sprintf("Fatal error in code growth: %v", r), nil)
+		}
+	}()
+
+	return GrowthWithConfig(config)
+}
+
+// executeFilesCommand executes the file statistics command
+func (d *CommandDispatcher) executeFilesCommand(config *cli.Config) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = NewCommandError(ErrExecutionFailed, fmt.Sprintf("Fatal error in file statistics: %v", r), nil)
+		}
+	}()
+
+	return FilesWithConfig(config)
+}
+
+// executeGUICommand launches the interactive GUI
+func (d *CommandDispatcher) executeGUICommand(config *cli.Config) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = NewCommandError(ErrExecutionFailed, fmt.Sprintf("Fatal error in GUI: %v", r), nil)
+		}
+	}()
+
+	return LaunchGUI(config)
+}
+
 // CommandErrorType represents different types of command errors
 type CommandErrorType int
 
@@ -242,6 +383,7 @@ const (
 	ErrRepositoryAccess
 	ErrNotImplemented
 	ErrExecutionFailed
+	ErrGUIUnavailable
 )
 
 // CommandError represents an error that occurred during command execution
@@ -292,7 +434,7 @@ func GetUserFriendlyMessage(err error) string {
 	if cmdErr, ok := err.(*CommandError); ok {
 		switch cmdErr.Type {
 		case ErrUnknownCommand:
-			return fmt.Sprintf("Error: %s\n\nSuggestion: Use one of the available commands: contrib, summary, contributors, health\nExample: git-stats -contrib", cmdErr.Message)
+			return fmt.Sprintf("Error: %s\n\nSuggestion: Use one of the available commands: contrib, summary, contributors, files, health, compare, graph, punchcard, growth\nExample: git-stats -contrib", cmdErr.Message)
 		case ErrInvalidConfiguration:
 			return fmt.Sprintf("Error: %s\n\nSuggestion: Check your command line arguments and try again.\nFor help, run: git-stats -help", cmdErr.Message)
 		case ErrSystemRequirements:
@@ -303,6 +445,8 @@ func GetUserFriendlyMessage(err error) string {
 			return fmt.Sprintf("Error: %s\n\nSuggestion: This feature is coming soon. Try using -contrib or -summary commands instead.", cmdErr.Message)
 		case ErrExecutionFailed:
 			return fmt.Sprintf("Error: %s\n\nSuggestion: Please check the repository state and try again. If the problem persists, try with a smaller date range using --since and --until flags.", cmdErr.Message)
+		case ErrGUIUnavailable:
+			return fmt.Sprintf("Error: %s\n\nSuggestion: Build with GUI support (go build -tags gui) and run from an interactive terminal, or use terminal mode instead.\nExample: git-stats -contrib", cmdErr.Message)
 		default:
 			return fmt.Sprintf("Error: %s", cmdErr.Message)
 		}
@@ -0,0 +1,98 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - File statistics action
+
+package actions
+
+import (
+	"fmt"
+	"git-stats/analyzers"
+	"git-stats/cli"
+	"git-stats/git"
+	"git-stats/models"
+)
+
+// FilesWithConfig reports the most frequently modified files and the file types of the commits,
+// or with -by-directory the activity of each directory or module
+func FilesWithConfig(config *cli.Config) error {
+	repo, err := git.NewGitRepository(git.RepositoryConfig{Path: config.RepoPath})
+	if err != nil {
+		return NewCommandError(ErrRepositoryAccess, "Failed to open repository", err)
+	}
+
+	repoInfo, err := repo.GetRepositoryInfo()
+	if err != nil {
+		return NewCommandError(ErrRepositoryAccess, "Failed to read repository info", err)
+	}
+	if repoInfo.TotalCommits == 0 {
+		return NewCommandError(ErrExecutionFailed, "Repository has no commits yet", nil)
+	}
+
+	startDate := getStartTime(config.Since)
+	endDate := getEndTime(config.Until)
+
+	commits, err := repo.GetCommits(startDate, endDate, config.Author)
+	if err != nil {
+		return NewCommandError(ErrExecutionFailed, "Failed to read commits", err)
+	}
+
+	modelCommits := convertGitCommitsToModelCommits(commits)
+	modelCommits, teams, err := selectTeamCommits(config, modelCommits)
+	if err != nil {
+		return NewCommandError(ErrInvalidConfiguration, "Failed to load teams", err)
+	}
+	if config.Limit > 0 && len(modelCommits) > config.Limit {
+		modelCommits = modelCommits[:config.Limit]
+	}
+
+	analysisConfig := models.AnalysisConfig{
+		TimeRange: models.TimeRange{
+			Start: startDate,
+			End:   endDate,
+		},
+		AuthorFilter:  config.Author,
+		IncludeMerges: true,
+		Limit:         config.Limit,
+	}
+
+	statsAnalyzer := analyzers.NewStatisticsAnalyzer()
+	summary, err := statsAnalyzer.AnalyzeStatistics(modelCommits, analysisConfig)
+	if err != nil {
+		return NewCommandError(ErrExecutionFailed, "Failed to analyze commits", err)
+	}
+
+	analysisResult := &models.AnalysisResult{
+		Repository: &models.RepositoryInfo{
+			Path:         repoInfo.Path,
+			Name:         repoInfo.Name,
+			TotalCommits: repoInfo.TotalCommits,
+			FirstCommit:  repoInfo.FirstCommit,
+			LastCommit:   repoInfo.LastCommit,
+			Branches:     repoInfo.Branches,
+		},
+		Summary: summary,
+		TimeRange: models.TimeRange{
+			Start: startDate,
+			End:   endDate,
+		},
+	}
+
+	if config.ByDirectory {
+		options := models.DirectoryOptions{Depth: config.Depth}
+		if config.Modules {
+			files, err := repo.GetFiles()
+			if err != nil {
+				return NewCommandError(ErrExecutionFailed, "Failed to list repository files", err)
+			}
+			options.ModuleRoots = analyzers.ModuleRoots(files)
+		}
+		analysisResult.Directories = analyzers.NewDirectoryAnalyzer().AnalyzeDirectories(modelCommits, options)
+	}
+
+	analysisResult.Teams = teamRollups(config, teams, modelCommits)
+
+	if err := writeAnalysisOutput(analysisResult, config, "files"); err != nil {
+		return NewCommandError(ErrExecutionFailed, fmt.Sprintf("Failed to write %s output", config.Format), err)
+	}
+
+	return nil
+}
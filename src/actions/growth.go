@@ -0,0 +1,82 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Code growth action
+
+package actions
+
+import (
+	"fmt"
+	"git-stats/analyzers"
+	"git-stats/cli"
+	"git-stats/git"
+	"git-stats/models"
+	"time"
+)
+
+// GrowthWithConfig renders the cumulative net lines of code per day, week or month
+func GrowthWithConfig(config *cli.Config) error {
+	repo, err := git.NewGitRepository(git.RepositoryConfig{Path: config.RepoPath})
+	if err != nil {
+		return NewCommandError(ErrRepositoryAccess, "Failed to open repository", err)
+	}
+
+	repoInfo, err := repo.GetRepositoryInfo()
+	if err != nil {
+		return NewCommandError(ErrRepositoryAccess, "Failed to read repository info", err)
+	}
+	if repoInfo.TotalCommits == 0 {
+		return NewCommandError(ErrExecutionFailed, "Repository has no commits yet", nil)
+	}
+
+	// The running total approximates the code size only when it starts at the first commit, so
+	// without -since the whole history is read
+	var since time.Time
+	startDate := repoInfo.FirstCommit
+	if config.Since != nil {
+		since, startDate = *config.Since, *config.Since
+	}
+	endDate := getEndTime(config.Until)
+
+	commits, err := repo.GetCommits(since, endDate, config.Author)
+	if err != nil {
+		return NewCommandError(ErrExecutionFailed, "Failed to read commits", err)
+	}
+
+	modelCommits := convertGitCommitsToModelCommits(commits)
+	modelCommits, teams, err := selectTeamCommits(config, modelCommits)
+	if err != nil {
+		return NewCommandError(ErrInvalidConfiguration, "Failed to load teams", err)
+	}
+	if config.Limit > 0 && len(modelCommits) > config.Limit {
+		modelCommits = modelCommits[:config.Limit]
+	}
+
+	growth := analyzers.NewGrowthAnalyzer().AnalyzeGrowth(modelCommits, models.GrowthOptions{
+		Interval:  config.Interval,
+		Breakdown: config.Breakdown,
+		Exclude:   config.Exclude,
+	})
+
+	analysisResult := &models.AnalysisResult{
+		Repository: &models.RepositoryInfo{
+			Path:         repoInfo.Path,
+			Name:         repoInfo.Name,
+			TotalCommits: repoInfo.TotalCommits,
+			FirstCommit:  repoInfo.FirstCommit,
+			LastCommit:   repoInfo.LastCommit,
+			Branches:     repoInfo.Branches,
+		},
+		Growth: growth,
+		TimeRange: models.TimeRange{
+			Start: startDate,
+			End:   endDate,
+		},
+	}
+
+	analysisResult.Teams = teamRollups(config, teams, modelCommits)
+
+	if err := writeAnalysisOutput(analysisResult, config, "growth"); err != nil {
+		return NewCommandError(ErrExecutionFailed, fmt.Sprintf("Failed to write %s output", config.Format), err)
+	}
+
+	return nil
+}
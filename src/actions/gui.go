@@ -4,53 +4,261 @@
 package actions
 
 import (
+	"errors"
 	"fmt"
 	"git-stats/analyzers"
 	"git-stats/cli"
+	"git-stats/config"
+	"git-stats/filters"
+	"git-stats/formatters"
 	"git-stats/git"
 	"git-stats/models"
 	"git-stats/visualizers"
-	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 )
 
 // LaunchGUI launches the GUI interface with the specified configuration
-func LaunchGUI(config *cli.Config) {
-	// Initialize git repository
-	repo, err := git.NewRepository(config.RepoPath)
+func LaunchGUI(cliConfig *cli.Config) error {
+	configManager := config.NewConfigManager()
+	options, err := loadGUIOptions(configManager)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: Failed to open repository at %s: %v\n", config.RepoPath, err)
-		os.Exit(1)
+		return NewCommandError(ErrInvalidConfiguration, "Invalid GUI configuration", err)
 	}
 
-	// Perform analysis
-	fmt.Println("Analyzing repository...")
+	// The commit graph honors -collapse and -ascii like the -graph command
+	options.GraphCollapseRun = cliConfig.CollapseRun
+	options.GraphASCII = cliConfig.ASCII
+
+	// The contribution graph takes its colors from -theme or the configuration file
+	options.Theme, err = resolveColorTheme(cliConfig)
+	if err != nil {
+		return NewCommandError(ErrInvalidConfiguration, fmt.Sprintf("Invalid color theme: %v", err), err)
+	}
+
+	// -team narrows the commits the GUI reads to one team's
+	teams, err := loadTeams(cliConfig)
+	if err != nil {
+		return NewCommandError(ErrInvalidConfiguration, fmt.Sprintf("Invalid teams: %v", err), err)
+	}
+
+	gui := visualizers.NewGUIInterface()
+	analysis := &guiAnalysis{config: cliConfig, source: &guiCommitSource{}, teams: teams}
+	gui.SetOptions(options)
+	gui.SetCommitSource(analysis.source)
+	gui.SetFilterHandler(analysis.filter)
+	gui.SetRefreshHandler(analysis.refresh)
+	gui.SetHistoryHandler(analysis.history)
+	gui.SetCompareHandler(analysis.compare)
+	gui.SetExportHandler(analysis.export)
+	gui.SetPaneSizeHandler(configManager.SaveGUIPaneSize)
+
+	if err := gui.Initialize(); err != nil {
+		return NewCommandError(ErrGUIUnavailable, "Failed to initialize GUI", err)
+	}
+	defer func() {
+		_ = gui.Cleanup()
+	}()
+
+	// The analysis runs behind the loading screen; its errors are returned as-is
+	err = gui.RunWithLoader(analysis.load)
+	if err == nil {
+		return nil
+	}
+
+	if IsCommandError(err) {
+		return err
+	}
+	if errors.Is(err, visualizers.ErrGUIUnavailable) {
+		return NewCommandError(ErrGUIUnavailable, "GUI mode is not available in this build", err)
+	}
+	return NewCommandError(ErrExecutionFailed, "GUI execution failed", err)
+}
+
+// loadGUIOptions reads the key bindings, default view and refresh settings from the configuration file
+func loadGUIOptions(configManager *config.ConfigManager) (visualizers.GUIOptions, error) {
+	if err := configManager.Load(); err != nil {
+		return visualizers.GUIOptions{}, err
+	}
+	guiConfig := configManager.GetConfig().GUI
+
+	keyMap, err := visualizers.NewKeyMap(guiConfig.KeyBindings)
+	if err != nil {
+		return visualizers.GUIOptions{}, err
+	}
+
+	defaultView, err := visualizers.ParseViewName(guiConfig.DefaultView)
+	if err != nil {
+		return visualizers.GUIOptions{}, err
+	}
+
+	if guiConfig.RefreshInterval < 0 {
+		return visualizers.GUIOptions{}, fmt.Errorf("refresh interval cannot be negative: %d", guiConfig.RefreshInterval)
+	}
+
+	if guiConfig.GraphPaneSize < visualizers.MinGraphPaneSize || guiConfig.GraphPaneSize > visualizers.MaxGraphPaneSize {
+		return visualizers.GUIOptions{}, fmt.Errorf("graph pane size must be between %d and %d percent: %d",
+			visualizers.MinGraphPaneSize, visualizers.MaxGraphPaneSize, guiConfig.GraphPaneSize)
+	}
+
+	return visualizers.GUIOptions{
+		KeyMap:          keyMap,
+		DefaultView:     defaultView,
+		RefreshInterval: time.Duration(guiConfig.RefreshInterval) * time.Second,
+		ShowHelp:        guiConfig.ShowHelp,
+		GraphPaneSize:   guiConfig.GraphPaneSize,
+	}, nil
+}
+
+// guiAnalysis keeps the loaded commits so GUI filters can re-run the analyzers without reading the repository again
+type guiAnalysis struct {
+	config         *cli.Config
+	source         *guiCommitSource
+	repo           *git.GitRepository
+	repository     *models.RepositoryInfo
+	commits        []models.Commit
+	contributors   []models.Contributor
+	refs           map[string][]string // branch and tag names by commit hash
+	analysisConfig models.AnalysisConfig
+	loadedSince    time.Time    // start of the history loaded by paging back, zero until then
+	teams          models.Teams // team definitions of -team, nil without it
+
+	// mu guards commits and analysisConfig, which refreshes and history loads replace on the
+	// analysis goroutine while comparisons read them from the GUI
+	mu sync.RWMutex
+}
+
+// load reads the repository and returns the analysis result shown by the GUI, reporting each
+// stage to progress; the analyzed commits are also indexed into the commit source for drill-down
+func (a *guiAnalysis) load(progress func(stage string)) (*models.AnalysisResult, error) {
+	if err := a.read(progress); err != nil {
+		return nil, err
+	}
+	a.source.index(a.repo, a.commits)
+	return a.analyze(a.commits, a.contributors, progress)
+}
+
+// refresh re-reads the repository so new commits show up, then re-applies filter
+func (a *guiAnalysis) refresh(filter visualizers.GUIFilter) (*models.AnalysisResult, string, error) {
+	if err := a.read(func(string) {}); err != nil {
+		return nil, "", err
+	}
+	return a.filter(filter)
+}
+
+// history reads the commits from since up to the loaded ones, then re-applies filter; refreshes
+// keep reading back to since
+func (a *guiAnalysis) history(since time.Time, filter visualizers.GUIFilter) (*models.AnalysisResult, string, error) {
+	start := a.analysisConfig.TimeRange.Start
+	if !since.Before(start) {
+		return a.filter(filter)
+	}
+
+	commits, err := a.repo.GetCommits(since, start, a.config.Author)
+	if err != nil {
+		return nil, "", NewCommandError(ErrExecutionFailed, "Failed to get commits", err)
+	}
+
+	// Commits on the boundary are already loaded
+	combined := append([]models.Commit{}, a.commits...)
+	loaded := make(map[string]bool, len(a.commits))
+	for _, commit := range a.commits {
+		loaded[commit.Hash] = true
+	}
+	for _, commit := range a.teamCommits(convertGitCommitsToModelCommits(commits)) {
+		if !loaded[commit.Hash] {
+			combined = append(combined, commit)
+		}
+	}
+
+	// -limit caps every commit held, as when reading, so paging back stops at the limit
+	if a.config.Limit > 0 && len(combined) > a.config.Limit {
+		combined = combined[:a.config.Limit]
+	}
+
+	a.mu.Lock()
+	a.commits = combined
+	a.loadedSince = since
+	a.analysisConfig.TimeRange.Start = since
+	a.mu.Unlock()
+	return a.filter(filter)
+}
+
+// teamCommits keeps the commits of -team, all commits without it
+func (a *guiAnalysis) teamCommits(commits []models.Commit) []models.Commit {
+	if a.config.Team == "" {
+		return commits
+	}
+	return analyzers.TeamCommits(commits, a.teams, a.config.Team)
+}
+
+// read loads the repository info, commits and contributors, reporting each stage to progress
+func (a *guiAnalysis) read(progress func(stage string)) error {
+	config := a.config
+
+	progress("Opening repository...")
+	repo, err := git.NewGitRepository(git.RepositoryConfig{Path: config.RepoPath})
+	if err != nil {
+		return NewCommandError(ErrRepositoryAccess, fmt.Sprintf("Failed to open repository at %s", config.RepoPath), err)
+	}
+
+	repoInfo, err := repo.GetRepositoryInfo()
+	if err != nil {
+		return NewCommandError(ErrRepositoryAccess, "Failed to get repository info", err)
+	}
+
+	if repoInfo.TotalCommits == 0 {
+		return NewCommandError(ErrExecutionFailed, "Repository has no commits yet", nil)
+	}
 
-	// Get commits for analysis
 	startTime := getStartTime(config.Since)
 	endTime := getEndTime(config.Until)
+	if !a.loadedSince.IsZero() && a.loadedSince.Before(startTime) {
+		startTime = a.loadedSince
+	}
 
+	progress("Reading commits...")
 	commits, err := repo.GetCommits(startTime, endTime, config.Author)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: Failed to get commits: %v\n", err)
-		os.Exit(1)
+		return NewCommandError(ErrExecutionFailed, "Failed to get commits", err)
 	}
 
 	// Convert git.Commit to models.Commit
-	modelCommits := convertGitCommitsToModelCommits(commits)
+	modelCommits := a.teamCommits(convertGitCommitsToModelCommits(commits))
 
-	// Get contributors
+	// Apply limit if specified
+	if config.Limit > 0 && len(modelCommits) > config.Limit {
+		modelCommits = modelCommits[:config.Limit]
+	}
+
+	progress("Reading contributors...")
 	gitContributors, err := repo.GetContributors()
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: Failed to get contributors: %v\n", err)
-		os.Exit(1)
+		return NewCommandError(ErrExecutionFailed, "Failed to get contributors", err)
 	}
 
-	// Convert git.Contributor to models.Contributor
-	modelContributors := convertGitContributorsToModelContributors(gitContributors)
+	// Decorations are optional; without them the commit graph still shows every commit
+	refs, _ := repo.GetRefs()
 
-	// Create analysis configuration
-	analysisConfig := models.AnalysisConfig{
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.repo = repo
+	a.commits = modelCommits
+	a.refs = refs
+	// Convert git.Contributor to models.Contributor
+	a.contributors = teamContributors(config, convertGitContributorsToModelContributors(gitContributors), modelCommits)
+	a.repository = &models.RepositoryInfo{
+		Path:         repoInfo.Path,
+		Name:         repoInfo.Name,
+		TotalCommits: repoInfo.TotalCommits,
+		FirstCommit:  repoInfo.FirstCommit,
+		LastCommit:   repoInfo.LastCommit,
+		Branches:     repoInfo.Branches,
+	}
+	a.analysisConfig = models.AnalysisConfig{
 		TimeRange: models.TimeRange{
 			Start: startTime,
 			End:   endTime,
@@ -58,83 +266,256 @@ func LaunchGUI(config *cli.Config) {
 		AuthorFilter:  config.Author,
 		IncludeMerges: true,
 		Limit:         config.Limit,
+		Metric:        config.Metric,
+		Levels:        config.Levels,
+		Thresholds:    config.Thresholds,
 	}
 
-	// Create analyzers
-	contribAnalyzer := analyzers.NewContributionAnalyzer()
-	statsAnalyzer := analyzers.NewStatisticsAnalyzer()
-	healthAnalyzer := analyzers.NewHealthAnalyzer()
+	return nil
+}
 
-	// Get contribution graph
-	contribGraph, err := contribAnalyzer.AnalyzeContributions(modelCommits, analysisConfig)
+// analyze runs the analyzers over commits, reporting each stage to progress
+func (a *guiAnalysis) analyze(commits []models.Commit, contributors []models.Contributor, progress func(stage string)) (*models.AnalysisResult, error) {
+	progress("Building contribution graph...")
+	contribGraph, err := analyzers.NewContributionAnalyzer().AnalyzeContributions(commits, a.analysisConfig)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: Failed to generate contribution graph: %v\n", err)
-		os.Exit(1)
+		return nil, NewCommandError(ErrExecutionFailed, "Failed to generate contribution graph", err)
 	}
 
-	// Get statistics
-	summary, err := statsAnalyzer.AnalyzeStatistics(modelCommits, analysisConfig)
+	progress("Computing statistics...")
+	summary, err := analyzers.NewStatisticsAnalyzer().AnalyzeStatistics(commits, a.analysisConfig)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: Failed to generate statistics: %v\n", err)
-		os.Exit(1)
+		return nil, NewCommandError(ErrExecutionFailed, "Failed to generate statistics", err)
 	}
 
-	// Get health metrics
-	healthMetrics, err := healthAnalyzer.AnalyzeHealth(modelCommits, modelContributors, analysisConfig)
+	progress("Analyzing repository health...")
+	healthMetrics, err := analyzers.NewHealthAnalyzer().AnalyzeHealth(commits, contributors, a.analysisConfig)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: Failed to analyze health: %v\n", err)
-		os.Exit(1)
+		return nil, NewCommandError(ErrExecutionFailed, "Failed to analyze health", err)
 	}
 
-	// Get repository info
-	repoInfo, err := repo.GetRepositoryInfo()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: Failed to get repository info: %v\n", err)
-		os.Exit(1)
-	}
+	progress("Building file tree...")
+	fileTree := analyzers.NewFileTreeAnalyzer().BuildFileTree(commits)
 
-	// Create analysis result
-	analysisResult := &models.AnalysisResult{
-		Repository: &models.RepositoryInfo{
-			Path:         repoInfo.Path,
-			Name:         repoInfo.Name,
-			TotalCommits: repoInfo.TotalCommits,
-			FirstCommit:  repoInfo.FirstCommit,
-			LastCommit:   repoInfo.LastCommit,
-			Branches:     repoInfo.Branches,
-		},
+	progress("Building commit graph...")
+	commitGraph := analyzers.NewCommitGraphAnalyzer().BuildGraph(commits, a.refs)
+
+	progress("Measuring code growth...")
+	growth := analyzers.NewGrowthAnalyzer().AnalyzeGrowth(commits, models.GrowthOptions{
+		Interval:  a.config.Interval,
+		Breakdown: a.config.Breakdown,
+		Exclude:   a.config.Exclude,
+	})
+
+	return &models.AnalysisResult{
+		Repository:    a.repository,
 		Summary:       summary,
-		Contributors:  modelContributors,
+		Contributors:  withPunchCards(contributors, commits),
 		ContribGraph:  contribGraph,
 		HealthMetrics: healthMetrics,
-		TimeRange: models.TimeRange{
-			Start: getStartTime(config.Since),
-			End:   getEndTime(config.Until),
-		},
+		FileTree:      fileTree,
+		CommitGraph:   commitGraph,
+		Growth:        growth,
+		TimeRange:     a.analysisConfig.TimeRange,
+	}, nil
+}
+
+// filter re-analyzes the loaded commits that match filter and re-indexes the commit source;
+// an empty filter restores the unfiltered analysis
+func (a *guiAnalysis) filter(filter visualizers.GUIFilter) (*models.AnalysisResult, string, error) {
+	builder := filters.NewFilterBuilder(nil)
+	chain, err := buildGUIFilterChain(builder, filter)
+	if err != nil {
+		return nil, "", err
 	}
 
-	// Launch GUI
-	fmt.Println("Launching GUI interface...")
-	gui := visualizers.NewGUIInterface()
+	commits := chain.Apply(a.commits)
+	contributors := a.contributors
+	summary := ""
+	if !filter.IsEmpty() {
+		// Repository-wide contributor totals would ignore the filter, so derive them from the matches
+		contributors = analyzers.NewStatisticsAnalyzer().AnalyzeContributors(commits)
+		summary = builder.GetFilterSummary(chain)
+	}
 
-	err = gui.Initialize()
+	result, err := a.analyze(commits, contributors, func(string) {})
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: Failed to initialize GUI: %v\n", err)
-		os.Exit(1)
+		return nil, "", err
 	}
 
-	// Run the GUI
-	err = gui.Run(analysisResult)
+	a.source.index(a.repo, commits)
+	return result, summary, nil
+}
+
+// compare compares the contributors with the given emails over the commits currently shown
+func (a *guiAnalysis) compare(selectors []string) (*models.ContributorComparison, error) {
+	a.mu.RLock()
+	analysisConfig := a.analysisConfig
+	a.mu.RUnlock()
+	return analyzers.NewComparisonAnalyzer().CompareContributors(a.source.all(), selectors, analysisConfig)
+}
+
+// export writes a GUI export of the current view with the formatters and the file output handler
+func (a *guiAnalysis) export(export visualizers.GUIExport) (string, error) {
+	path, err := filepath.Abs(export.Path)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: GUI execution failed: %v\n", err)
-		os.Exit(1)
+		return "", err
+	}
+
+	overwriteMode := formatters.OverwriteModeBackup
+	switch export.IfExists {
+	case visualizers.ExportIfExistsOverwrite:
+		overwriteMode = formatters.OverwriteModeReplace
+	case visualizers.ExportIfExistsAppend:
+		overwriteMode = formatters.OverwriteModeAppend
+	case visualizers.ExportIfExistsFail:
+		overwriteMode = formatters.OverwriteModeError
+	}
+
+	handler := formatters.NewFileOutputHandler(overwriteMode == formatters.OverwriteModeBackup, overwriteMode)
+	outputConfig := formatters.FileOutputConfig{
+		OutputPath:    path,
+		BackupEnabled: overwriteMode == formatters.OverwriteModeBackup,
+		OverwriteMode: overwriteMode,
+		CreateDirs:    true,
+	}
+
+	if export.Format == visualizers.ExportFormatScreenshot {
+		return path, handler.WriteToFile([]byte(export.Screenshot), outputConfig)
+	}
+
+	// Markdown is the built-in markdown template
+	format, config := export.Format, *a.config
+	if format == visualizers.ExportFormatMarkdown {
+		format, config.Template = "template", "markdown"
+	}
+	formatter, formatConfig := formatterFor(format, &config, "")
+	return path, handler.WriteFormattedOutput(export.Data, formatter, formatConfig, outputConfig)
+}
+
+// buildGUIFilterChain converts GUI filter values into a filter chain
+func buildGUIFilterChain(builder *filters.FilterBuilder, filter visualizers.GUIFilter) (*filters.FilterChain, error) {
+	options := filters.AdvancedFilterOptions{
+		IncludeFiles:  filter.IncludePaths,
+		ExcludeFiles:  filter.ExcludePaths,
+		FileMatchType: filters.FileContainsMatch,
+		IncludeMerges: true,
+	}
+
+	// Paths are substrings unless any of them uses glob wildcards
+	for _, path := range append(append([]string{}, filter.IncludePaths...), filter.ExcludePaths...) {
+		if strings.ContainsAny(path, "*?[") {
+			options.FileMatchType = filters.FileGlobMatch
+			break
+		}
+	}
+
+	if filter.Since != "" {
+		since, err := builder.ParseDate(filter.Since)
+		if err != nil {
+			return nil, fmt.Errorf("invalid since date %q: %w", filter.Since, err)
+		}
+		options.Since = since
+	}
+
+	if filter.Until != "" {
+		until, err := builder.ParseDate(filter.Until)
+		if err != nil {
+			return nil, fmt.Errorf("invalid until date %q: %w", filter.Until, err)
+		}
+		// A plain date includes the whole day
+		if until.Hour() == 0 && until.Minute() == 0 && until.Second() == 0 {
+			endOfDay := until.AddDate(0, 0, 1).Add(-time.Nanosecond)
+			until = &endOfDay
+		}
+		options.Until = until
+	}
+
+	if filter.Author != "" {
+		options.Authors = []filters.AuthorFilterOptions{{Pattern: filter.Author, MatchType: filters.ContainsMatch}}
+	}
+
+	if filter.Message != "" {
+		options.MessageFilter = &filters.MessageFilterOptions{Pattern: filter.Message, MatchType: filters.MessageContainsMatch}
+	}
+
+	if filter.HasSizeBounds() {
+		options.SizeFilter = &filters.FileSizeFilterOptions{
+			MinInsertions: filter.MinInsertions,
+			MaxInsertions: filter.MaxInsertions,
+			MinDeletions:  filter.MinDeletions,
+			MaxDeletions:  filter.MaxDeletions,
+			MinFiles:      filter.MinFiles,
+			MaxFiles:      filter.MaxFiles,
+		}
+	}
+
+	return builder.BuildAdvancedFilter(options)
+}
+
+// guiCommitSource serves the analyzed commits by day and loads commit details from the repository
+type guiCommitSource struct {
+	mu      sync.RWMutex
+	repo    *git.GitRepository
+	commits []models.Commit
+	byDate  map[string][]models.Commit
+}
+
+// index groups commits by author date, oldest first within a day
+func (s *guiCommitSource) index(repo *git.GitRepository, commits []models.Commit) {
+	byDate := make(map[string][]models.Commit)
+	for _, commit := range commits {
+		dateKey := commit.AuthorDate.Format("2006-01-02")
+		byDate[dateKey] = append(byDate[dateKey], commit)
+	}
+
+	for _, dayCommits := range byDate {
+		sort.Slice(dayCommits, func(i, j int) bool {
+			return dayCommits[i].AuthorDate.Before(dayCommits[j].AuthorDate)
+		})
+	}
+
+	// Filters re-index from a background goroutine while the GUI reads
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.repo = repo
+	s.commits = commits
+	s.byDate = byDate
+}
+
+// all returns every indexed commit
+func (s *guiCommitSource) all() []models.Commit {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.commits
+}
+
+// CommitsForDate returns the commits authored on date
+func (s *guiCommitSource) CommitsForDate(date time.Time) []models.Commit {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.byDate[date.Format("2006-01-02")]
+}
+
+// CommitDetail loads the full message, file list and diff of a commit
+func (s *guiCommitSource) CommitDetail(hash string) (*models.CommitDetail, error) {
+	s.mu.RLock()
+	repo := s.repo
+	s.mu.RUnlock()
+	if repo == nil {
+		return nil, fmt.Errorf("repository not loaded")
 	}
 
-	// Cleanup
-	err = gui.Cleanup()
+	detail, err := repo.GetCommitDetail(hash)
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Warning: GUI cleanup failed: %v\n", err)
+		return nil, err
 	}
+
+	return &models.CommitDetail{
+		Commit:      convertGitCommitsToModelCommits([]git.Commit{detail.Commit})[0],
+		FullMessage: detail.FullMessage,
+		Diff:        detail.Diff,
+	}, nil
 }
 
 // Helper functions to handle time ranges
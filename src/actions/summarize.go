@@ -106,6 +106,11 @@ func SummarizeWithConfig(config *cli.Config) {
 
 	// Convert git.Commit to models.Commit
 	modelCommits := convertGitCommitsToModelCommits(commits)
+	modelCommits, teams, err := selectTeamCommits(config, modelCommits)
+	if err != nil {
+		fmt.Printf("Error loading teams: %v\n", err)
+		return
+	}
 
 	// Apply limit if specified
 	if config.Limit > 0 && len(modelCommits) > config.Limit {
@@ -118,7 +123,7 @@ func SummarizeWithConfig(config *cli.Config) {
 		fmt.Printf("Error getting contributors: %v\n", err)
 		return
 	}
-	modelContributors := convertGitContributorsToModelContributors(gitContributors)
+	modelContributors := withPunchCards(teamContributors(config, convertGitContributorsToModelContributors(gitContributors), modelCommits), modelCommits)
 
 	// Create analysis configuration
 	analysisConfig := models.AnalysisConfig{
@@ -129,6 +134,9 @@ func SummarizeWithConfig(config *cli.Config) {
 		AuthorFilter:  config.Author,
 		IncludeMerges: true,
 		Limit:         config.Limit,
+		Metric:        config.Metric,
+		Levels:        config.Levels,
+		Thresholds:    config.Thresholds,
 	}
 
 	// Analyze statistics
@@ -175,15 +183,10 @@ func SummarizeWithConfig(config *cli.Config) {
 		},
 	}
 
+	analysisResult.Teams = teamRollups(config, teams, modelCommits)
+
 	// Handle different output formats
-	switch config.Format {
-	case "json":
-		err = outputJSON(analysisResult, config)
-	case "csv":
-		err = outputCSV(analysisResult, config)
-	default:
-		err = outputTerminal(analysisResult, config, "summary")
-	}
+	err = writeAnalysisOutput(analysisResult, config, "summary")
 
 	if err != nil {
 		fmt.Printf("Error generating output: %v\n", err)
@@ -7,11 +7,13 @@ import (
 	"fmt"
 	"git-stats/analyzers"
 	"git-stats/cli"
+	"git-stats/config"
 	"git-stats/formatters"
 	"git-stats/git"
 	"git-stats/models"
 	"git-stats/visualizers"
 	"os"
+	"strings"
 )
 
 // convertGitCommitsToModelCommits converts git.Commit slice to models.Commit slice
@@ -82,6 +84,28 @@ func convertGitContributorsToModelContributors(gitContributors []git.Contributor
 	return modelContributors
 }
 
+// withPunchCards returns a copy of contributors with their punch cards counted from commits,
+// matching authors by email like the repository's contributor list
+func withPunchCards(contributors []models.Contributor, commits []models.Commit) []models.Contributor {
+	cards := make(map[string]*models.PunchCard)
+	for _, commit := range commits {
+		email := strings.ToLower(commit.Author.Email)
+		if cards[email] == nil {
+			cards[email] = &models.PunchCard{}
+		}
+		cards[email].Add(commit.AuthorDate)
+	}
+
+	result := make([]models.Contributor, len(contributors))
+	for i, contributor := range contributors {
+		result[i] = contributor
+		if card := cards[strings.ToLower(contributor.Email)]; card != nil {
+			result[i].PunchCard = *card
+		}
+	}
+	return result
+}
+
 // outputJSON outputs analysis results in JSON format
 func outputJSON(data *models.AnalysisResult, config *cli.Config) error {
 	formatter := formatters.NewJSONFormatter()
@@ -105,8 +129,13 @@ func outputCSV(data *models.AnalysisResult, config *cli.Config) error {
 	formatter := formatters.NewCSVFormatter()
 
 	formatConfig := models.FormatConfig{
-		Format:   "csv",
-		Metadata: true,
+		Format:     "csv",
+		Metadata:   true,
+		CSVDialect: config.CSVDialect,
+	}
+
+	if config.SplitsCSVOutput() {
+		return outputCSVTables(formatter, data, formatConfig, config.OutputFile)
 	}
 
 	output, err := formatter.Format(data, formatConfig)
@@ -117,210 +146,335 @@ func outputCSV(data *models.AnalysisResult, config *cli.Config) error {
 	return writeOutput(output, config.OutputFile)
 }
 
-// outputTerminal outputs analysis results in terminal format
-func outputTerminal(data *models.AnalysisResult, config *cli.Config, command string) error {
-	// Create render configuration
-	renderConfig := models.RenderConfig{
-		Width:       80,
-		Height:      25,
-		ColorScheme: "default",
-		ShowLegend:  true,
-		Interactive: false,
+// outputCSVTables writes one CSV file per table plus a manifest to a directory or zip archive
+func outputCSVTables(formatter *formatters.CSVFormatterImpl, data *models.AnalysisResult, formatConfig models.FormatConfig, outputPath string) error {
+	handler := formatters.NewFileOutputHandler(false, formatters.OverwriteModeReplace)
+	outputConfig := formatters.FileOutputConfig{
+		OutputPath:    outputPath,
+		OverwriteMode: formatters.OverwriteModeReplace,
 	}
 
-	// Configure colors based on CLI options
-	useColors := !config.NoColor
-	colorTheme := config.ColorTheme
-	if colorTheme == "" {
-		colorTheme = "github"
+	if cli.IsZipOutput(outputPath) {
+		archive, err := formatter.FormatCSVZip(data, formatConfig)
+		if err != nil {
+			return fmt.Errorf("failed to format CSV tables: %w", err)
+		}
+		return handler.WriteToFile(archive, outputConfig)
 	}
 
-	switch command {
-	case "contrib":
-		return outputContribTerminal(data, renderConfig, useColors, colorTheme)
-	case "summary":
-		return outputSummaryTerminal(data, renderConfig, useColors, colorTheme)
-	case "contributors":
-		return outputContributorsTerminal(data, renderConfig, useColors, colorTheme)
-	case "health":
-		return outputHealthTerminal(data, renderConfig, useColors, colorTheme)
-	default:
-		return fmt.Errorf("unknown command: %s", command)
+	files, err := formatter.FormatCSVTables(data, formatConfig)
+	if err != nil {
+		return fmt.Errorf("failed to format CSV tables: %w", err)
 	}
+
+	return handler.WriteFileSet(files, outputConfig)
 }
 
-// outputContribTerminal outputs contribution graph in terminal format
-func outputContribTerminal(data *models.AnalysisResult, renderConfig models.RenderConfig, useColors bool, colorTheme string) error {
-	fmt.Println("Git Contribution Graph")
-	fmt.Println("======================")
+// outputXLSX writes analysis results as an Excel workbook to the output file
+func outputXLSX(data *models.AnalysisResult, config *cli.Config) error {
+	handler := formatters.NewFileOutputHandler(false, formatters.OverwriteModeReplace)
 
-	if data.Repository != nil {
-		fmt.Printf("Repository: %s\n", data.Repository.Name)
-		fmt.Printf("Total Commits: %d\n\n", data.Repository.TotalCommits)
+	formatConfig := models.FormatConfig{
+		Format:     "xlsx",
+		OutputFile: config.OutputFile,
+	}
+	outputConfig := formatters.FileOutputConfig{
+		OutputPath:    config.OutputFile,
+		OverwriteMode: formatters.OverwriteModeReplace,
 	}
 
-	if data.ContribGraph == nil {
-		fmt.Println("No contribution data available.")
-		return nil
+	if err := handler.WriteFormattedOutput(data, formatters.NewXLSXFormatter(), formatConfig, outputConfig); err != nil {
+		return fmt.Errorf("failed to write XLSX: %w", err)
 	}
 
-	// Create contribution graph renderer
-	contribRenderer := visualizers.NewContributionGraphRenderer(renderConfig)
-	contribRenderer.SetColorOptions(useColors, colorTheme)
+	return nil
+}
 
-	// Render contribution graph
-	graphOutput, err := contribRenderer.RenderContributionGraph(data.ContribGraph, renderConfig)
-	if err != nil {
-		return fmt.Errorf("error rendering contribution graph: %w", err)
-	}
+// outputTemplate outputs analysis results using a user-supplied or built-in text/template
+func outputTemplate(data *models.AnalysisResult, config *cli.Config) error {
+	formatter := formatters.NewTemplateFormatter()
 
-	fmt.Print(graphOutput)
+	formatConfig := models.FormatConfig{
+		Format:   "template",
+		Template: config.Template,
+	}
 
-	// Display contribution summary
-	contribAnalyzer := analyzers.NewContributionAnalyzer()
-	contribSummary := contribAnalyzer.GetContributionSummary(data.ContribGraph)
+	output, err := formatter.Format(data, formatConfig)
+	if err != nil {
+		return fmt.Errorf("failed to render template: %w", err)
+	}
 
-	fmt.Printf("\nContribution Summary:\n")
-	fmt.Printf("====================\n")
-	fmt.Printf("Total Commits: %d\n", contribSummary.TotalCommits)
-	fmt.Printf("Active Days: %d out of %d days\n", contribSummary.ActiveDays, contribSummary.TotalDays)
-	fmt.Printf("Average Commits/Day: %.2f\n", contribSummary.AvgCommitsPerDay)
-	fmt.Printf("Max Commits in a Day: %d\n", contribSummary.MaxCommitsPerDay)
-	fmt.Printf("Current Streak: %d days\n", contribSummary.CurrentStreak)
-	fmt.Printf("Longest Streak: %d days\n", contribSummary.LongestStreak)
+	return writeOutput(output, config.OutputFile)
+}
 
-	// Show activity level distribution
-	fmt.Printf("\nActivity Level Distribution:\n")
-	fmt.Printf("============================\n")
+// outputSVG outputs the contribution graph or the punch card as an SVG image in the selected color theme
+func outputSVG(data *models.AnalysisResult, config *cli.Config, command string) error {
+	formatter := formatters.NewSVGFormatter()
 
-	levelCounts := make(map[int]int)
-	for _, level := range contribSummary.ActivityLevels {
-		levelCounts[level]++
+	theme, err := resolveColorTheme(config)
+	if err != nil {
+		return NewCommandError(ErrInvalidConfiguration, fmt.Sprintf("Invalid color theme: %v", err), err)
 	}
 
-	levelNames := []string{"No activity", "Low activity (1-3)", "Medium activity (4-9)", "High activity (10-19)", "Very high activity (20+)"}
-	for level := 0; level <= 4; level++ {
-		count := levelCounts[level]
-		percentage := float64(count) / float64(contribSummary.TotalDays) * 100
-		fmt.Printf("%s: %d days (%.1f%%)\n", levelNames[level], count, percentage)
+	output, err := formatter.Format(data, svgFormatConfig(config, command, theme))
+	if err != nil {
+		return fmt.Errorf("failed to format SVG output: %w", err)
 	}
 
-	fmt.Printf("\nNote: Showing activity for the specified time range\n")
-	return nil
+	return writeOutput(output, config.OutputFile)
 }
 
-// outputSummaryTerminal outputs summary statistics in terminal format
-func outputSummaryTerminal(data *models.AnalysisResult, renderConfig models.RenderConfig, useColors bool, colorTheme string) error {
-	fmt.Println("Git Repository Summary")
-	fmt.Println("======================")
+// outputTerminal outputs analysis results in terminal format
+func outputTerminal(data *models.AnalysisResult, config *cli.Config, command string) error {
+	if views := pagerViews(data, command); len(views) > 0 && usesPager(config) {
+		return visualizers.RunTablePager(views...)
+	}
 
-	if data.Repository != nil {
-		fmt.Printf("Repository: %s\n", data.Repository.Name)
-		fmt.Printf("Path: %s\n", data.Repository.Path)
-		fmt.Printf("Total Commits: %d\n", data.Repository.TotalCommits)
+	formatter := formatters.NewTerminalFormatter()
 
-		if !data.Repository.FirstCommit.IsZero() {
-			fmt.Printf("First Commit: %s\n", data.Repository.FirstCommit.Format("2006-01-02 15:04:05"))
-		}
-		if !data.Repository.LastCommit.IsZero() {
-			fmt.Printf("Last Commit: %s\n", data.Repository.LastCommit.Format("2006-01-02 15:04:05"))
+	formatConfig := terminalFormatConfig(config, command)
+	if !formatConfig.NoColor {
+		theme, err := resolveColorTheme(config)
+		if err != nil {
+			return NewCommandError(ErrInvalidConfiguration, fmt.Sprintf("Invalid color theme: %v", err), err)
 		}
-
-		fmt.Printf("Branches: %d\n\n", len(data.Repository.Branches))
+		formatConfig.ColorTheme = theme.Name
+		formatConfig.Theme = theme
 	}
 
-	if data.Summary == nil {
-		fmt.Println("No summary data available.")
-		return nil
+	output, err := formatter.Format(data, formatConfig)
+	if err != nil {
+		return fmt.Errorf("failed to format terminal output: %w", err)
 	}
 
-	// Create charts renderer
-	chartsRenderer := visualizers.NewChartsRenderer(renderConfig)
+	return writeOutput(output, config.OutputFile)
+}
+
+// usesPager reports whether tables go to the pager: only when reading keys from and writing to a
+// terminal, so piped and saved reports stay static
+func usesPager(config *cli.Config) bool {
+	return !config.NoPager && config.OutputFile == "" && visualizers.PagerAvailable()
+}
 
-	// Render summary statistics
-	summaryOutput, err := chartsRenderer.RenderSummaryStats(data.Summary, renderConfig)
-	if err != nil {
-		return fmt.Errorf("error rendering summary: %w", err)
+// pagerViews returns the tables the pager shows for a command, none when the command has no
+// table to page through. Under -by-team the team table follows the command's own table
+func pagerViews(data *models.AnalysisResult, command string) []visualizers.PagerView {
+	name := "repository"
+	if data.Repository != nil && data.Repository.Name != "" {
+		name = data.Repository.Name
 	}
 
-	fmt.Print(summaryOutput)
+	var views []visualizers.PagerView
+	switch {
+	case command == "contributors" && len(data.Contributors) > 0:
+		table := visualizers.NewInteractiveTable(visualizers.ContributorTable(data.Contributors))
+		table.SortColumn, table.SortAsc = 2, false // most commits first
+		views = append(views, visualizers.PagerView{Table: table, Title: fmt.Sprintf("Contributors of %s", name)})
+	case command == "files" && len(data.Directories) > 0:
+		table := visualizers.NewInteractiveTable(visualizers.DirectoryTable(data.Directories))
+		views = append(views, visualizers.PagerView{Table: table, Title: fmt.Sprintf("Directories of %s", name)})
+	case command == "files" && data.Summary != nil && len(data.Summary.TopFiles) > 0:
+		table := visualizers.NewInteractiveTable(visualizers.FileTable(data.Summary.TopFiles))
+		views = append(views, visualizers.PagerView{Table: table, Title: fmt.Sprintf("Most frequently modified files in %s", name)})
+	}
 
-	// Render contributor statistics if available
-	if len(data.Contributors) > 0 {
-		fmt.Println("\n")
-		contributorOutput, err := chartsRenderer.RenderContributorStats(data.Contributors, renderConfig)
-		if err != nil {
-			return fmt.Errorf("error rendering contributors: %w", err)
-		}
+	if (command == "contributors" || command == "files") && len(data.Teams) > 0 {
+		table := visualizers.NewInteractiveTable(visualizers.TeamTable(data.Teams))
+		table.SortColumn, table.SortAsc = 2, false // most commits first
+		views = append(views, visualizers.PagerView{Table: table, Title: fmt.Sprintf("Teams of %s", name)})
+	}
+	return views
+}
 
-		fmt.Print(contributorOutput)
+// terminalFormatConfig builds the terminal format configuration. Under -color auto only a terminal
+// gets colors, so reports piped or written to files are plain text. Only reports shown on a
+// terminal fit its width; pipes and files keep tables at their natural width
+func terminalFormatConfig(config *cli.Config, command string) models.FormatConfig {
+	toTerminal := config.OutputFile == "" && visualizers.IsTerminal(os.Stdout)
+	width := 0
+	if toTerminal {
+		width = visualizers.GetTerminalWidth()
 	}
 
-	// Render time-based analysis
-	fmt.Println("\n")
-	timeAnalysisOutput, err := chartsRenderer.RenderTimeBasedAnalysis(data.Summary, renderConfig)
-	if err != nil {
-		return fmt.Errorf("error rendering time analysis: %w", err)
+	colorTheme := config.ColorTheme
+	if colorTheme == "" {
+		colorTheme = "github"
 	}
 
-	fmt.Print(timeAnalysisOutput)
-	return nil
+	return models.FormatConfig{
+		Format:      "terminal",
+		OutputFile:  config.OutputFile,
+		Command:     command,
+		NoColor:     config.NoColor || !visualizers.ColorEnabled(config.Color, toTerminal),
+		ColorTheme:  colorTheme,
+		CollapseRun: config.CollapseRun,
+		ASCII:       config.ASCII,
+		Width:       width,
+		ByAuthor:    config.ByAuthor,
+		TopAuthors:  config.TopAuthors,
+	}
 }
 
-// outputContributorsTerminal outputs contributor statistics in terminal format
-func outputContributorsTerminal(data *models.AnalysisResult, renderConfig models.RenderConfig, useColors bool, colorTheme string) error {
-	fmt.Println("Git Contributors Analysis")
-	fmt.Println("=========================")
+// svgFormatConfig builds the SVG format configuration of a command's image
+func svgFormatConfig(config *cli.Config, command string, theme *models.Theme) models.FormatConfig {
+	return models.FormatConfig{
+		Format:     "svg",
+		Command:    command,
+		Theme:      theme,
+		ByAuthor:   config.ByAuthor,
+		TopAuthors: config.TopAuthors,
+	}
+}
 
-	if data.Repository != nil {
-		fmt.Printf("Repository: %s\n", data.Repository.Name)
-		fmt.Printf("Total Contributors: %d\n\n", len(data.Contributors))
+// resolveColorTheme resolves -theme, or else the configuration file's color theme, among the
+// built-in themes and those defined under output.themes in the configuration file
+func resolveColorTheme(cliConfig *cli.Config) (*models.Theme, error) {
+	configManager := config.NewConfigManager()
+	if err := configManager.Load(); err != nil {
+		return nil, err
 	}
+	output := configManager.GetConfig().Output
 
-	if len(data.Contributors) == 0 {
-		fmt.Println("No contributors found.")
-		return nil
+	name := cliConfig.ColorTheme
+	if name == "" {
+		name = output.ColorTheme
 	}
+	return models.ResolveTheme(name, output.Themes)
+}
 
-	// Create charts renderer
-	chartsRenderer := visualizers.NewChartsRenderer(renderConfig)
+// loadTeams reads the team definition file named in the configuration file when -team or
+// -by-team need it and checks that -team is one of its teams or models.NoTeam; without either
+// flag it returns no teams
+func loadTeams(cliConfig *cli.Config) (models.Teams, error) {
+	if cliConfig.Team == "" && !cliConfig.ByTeam {
+		return nil, nil
+	}
 
-	// Render contributor statistics
-	contributorOutput, err := chartsRenderer.RenderContributorStats(data.Contributors, renderConfig)
+	configManager := config.NewConfigManager()
+	if err := configManager.Load(); err != nil {
+		return nil, err
+	}
+	teams, err := configManager.LoadTeams()
 	if err != nil {
-		return fmt.Errorf("error rendering contributors: %w", err)
+		return nil, err
 	}
 
-	fmt.Print(contributorOutput)
-	return nil
+	if cliConfig.Team != "" && cliConfig.Team != models.NoTeam && teams.Find(cliConfig.Team) == nil {
+		return nil, fmt.Errorf("unknown -team '%s'. Teams: %s", cliConfig.Team, strings.Join(teams.Names(), ", "))
+	}
+	return teams, nil
 }
 
-// outputHealthTerminal outputs health metrics in terminal format
-func outputHealthTerminal(data *models.AnalysisResult, renderConfig models.RenderConfig, useColors bool, colorTheme string) error {
-	fmt.Println("Repository Health Analysis")
-	fmt.Println("==========================")
+// selectTeamCommits loads the teams of -team and -by-team and keeps only the commits of -team
+func selectTeamCommits(cliConfig *cli.Config, commits []models.Commit) ([]models.Commit, models.Teams, error) {
+	teams, err := loadTeams(cliConfig)
+	if err != nil || cliConfig.Team == "" {
+		return commits, teams, err
+	}
+	return analyzers.TeamCommits(commits, teams, cliConfig.Team), teams, nil
+}
 
-	if data.Repository != nil {
-		fmt.Printf("Repository: %s\n", data.Repository.Name)
-		fmt.Printf("Total Commits: %d\n\n", data.Repository.TotalCommits)
+// teamContributors keeps the contributors who made any of the -team commits
+func teamContributors(cliConfig *cli.Config, contributors []models.Contributor, commits []models.Commit) []models.Contributor {
+	if cliConfig.Team == "" {
+		return contributors
 	}
 
-	if data.HealthMetrics == nil {
-		fmt.Println("No health metrics available.")
+	authors := make(map[string]bool)
+	for _, commit := range commits {
+		authors[strings.ToLower(commit.Author.Email)] = true
+	}
+
+	var result []models.Contributor
+	for _, contributor := range contributors {
+		if authors[strings.ToLower(contributor.Email)] {
+			result = append(result, contributor)
+		}
+	}
+	return result
+}
+
+// teamRollups returns the team statistics of commits under -by-team and nil otherwise
+func teamRollups(cliConfig *cli.Config, teams models.Teams, commits []models.Commit) []models.TeamStats {
+	if !cliConfig.ByTeam {
 		return nil
 	}
+	return analyzers.NewTeamAnalyzer().AnalyzeTeams(commits, teams)
+}
 
-	// Create charts renderer
-	chartsRenderer := visualizers.NewChartsRenderer(renderConfig)
+// writeAnalysisOutput writes analysis results in the configured format(s)
+func writeAnalysisOutput(data *models.AnalysisResult, config *cli.Config, command string) error {
+	if formats := config.Formats(); len(formats) > 1 {
+		return outputMultipleFormats(data, config, command, formats)
+	}
 
-	// Render health metrics
-	healthOutput, err := chartsRenderer.RenderHealthMetrics(data.HealthMetrics, renderConfig)
-	if err != nil {
-		return fmt.Errorf("error rendering health metrics: %w", err)
+	switch config.Format {
+	case "json":
+		return outputJSON(data, config)
+	case "csv":
+		return outputCSV(data, config)
+	case "xlsx":
+		return outputXLSX(data, config)
+	case "template":
+		return outputTemplate(data, config)
+	case "svg":
+		return outputSVG(data, config, command)
+	default:
+		return outputTerminal(data, config, command)
 	}
+}
 
-	fmt.Print(healthOutput)
-	return nil
+// outputMultipleFormats writes one file per format next to the -output base path; split CSV
+// tables go to the -output directory or .zip itself
+func outputMultipleFormats(data *models.AnalysisResult, config *cli.Config, command string, formats []string) error {
+	handler := formatters.NewFileOutputHandler(false, formatters.OverwriteModeReplace)
+	outputs := make(map[string]formatters.FormatterConfig, len(formats))
+
+	for _, format := range formats {
+		if format == "csv" && config.SplitsCSVOutput() {
+			if err := outputCSV(data, config); err != nil {
+				return err
+			}
+			continue
+		}
+
+		formatter, formatConfig := formatterFor(format, config, command)
+		path := cli.MultiFormatOutputPath(config.OutputFile, format)
+		formatConfig.OutputFile = path
+
+		outputs[format] = formatters.FormatterConfig{
+			Formatter:    formatter,
+			FormatConfig: formatConfig,
+			OutputConfig: formatters.FileOutputConfig{
+				OutputPath:    path,
+				OverwriteMode: formatters.OverwriteModeReplace,
+			},
+		}
+	}
+
+	return handler.WriteMultipleFormats(data, outputs)
+}
+
+// formatterFor returns the formatter and format configuration for a single output format
+func formatterFor(format string, config *cli.Config, command string) (formatters.Formatter, models.FormatConfig) {
+	switch format {
+	case "json":
+		return formatters.NewJSONFormatter(), models.FormatConfig{Format: "json", Pretty: true, Metadata: true}
+	case "csv":
+		return formatters.NewCSVFormatter(), models.FormatConfig{Format: "csv", Metadata: true, CSVDialect: config.CSVDialect}
+	case "xlsx":
+		return formatters.NewXLSXFormatter(), models.FormatConfig{Format: "xlsx"}
+	case "template":
+		return formatters.NewTemplateFormatter(), models.FormatConfig{Format: "template", Template: config.Template}
+	case "svg":
+		// The theme was validated with the configuration
+		theme, _ := resolveColorTheme(config)
+		return formatters.NewSVGFormatter(), svgFormatConfig(config, command, theme)
+	default:
+		formatConfig := terminalFormatConfig(config, command)
+		formatConfig.NoColor = true
+		return formatters.NewTerminalFormatter(), formatConfig
+	}
 }
 
 // writeOutput writes data to file or stdout
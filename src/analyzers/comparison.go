@@ -0,0 +1,221 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Contributor comparison analysis
+
+package analyzers
+
+import (
+	"fmt"
+	"git-stats/models"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ComparisonAnalyzerImpl compares the activity of selected contributors
+type ComparisonAnalyzerImpl struct{}
+
+// NewComparisonAnalyzer creates a new comparison analyzer
+func NewComparisonAnalyzer() *ComparisonAnalyzerImpl {
+	return &ComparisonAnalyzerImpl{}
+}
+
+// CompareContributors compares the commits of each selector. A selector containing "@" matches
+// that email exactly; any other selector matches authors whose name or email contains it.
+// Both matches are case-insensitive. An author matched by two selectors, or a selector without
+// commits, is an error.
+func (ca *ComparisonAnalyzerImpl) CompareContributors(commits []models.Commit, selectors []string, config models.AnalysisConfig) (*models.ContributorComparison, error) {
+	if len(selectors) < 2 {
+		return nil, fmt.Errorf("at least two contributors are required for a comparison")
+	}
+
+	compared := make([]*models.ComparedContributor, len(selectors))
+	identityCommits := make([]map[models.Author]int, len(selectors))
+	fileCommits := make(map[string][]int)
+	for i, selector := range selectors {
+		compared[i] = &models.ComparedContributor{
+			Selector:     selector,
+			CommitsByDay: make(map[string]int),
+			FileTypes:    make(map[string]int),
+		}
+		identityCommits[i] = make(map[models.Author]int)
+	}
+
+	statistics := NewStatisticsAnalyzer()
+	start, end := config.TimeRange.Start, config.TimeRange.End
+	for _, commit := range commits {
+		if !start.IsZero() && commit.AuthorDate.Before(start) || !end.IsZero() && commit.AuthorDate.After(end) {
+			continue
+		}
+
+		index := -1
+		for i, selector := range selectors {
+			if !ca.matchesSelector(commit.Author, selector) {
+				continue
+			}
+			if index >= 0 {
+				return nil, fmt.Errorf("author %s <%s> matches both %q and %q",
+					commit.Author.Name, commit.Author.Email, selectors[index], selector)
+			}
+			index = i
+		}
+		if index < 0 {
+			continue
+		}
+
+		contributor := compared[index]
+		if contributor.TotalCommits == 0 || commit.AuthorDate.Before(contributor.FirstCommit) {
+			contributor.FirstCommit = commit.AuthorDate
+		}
+		if commit.AuthorDate.After(contributor.LastCommit) {
+			contributor.LastCommit = commit.AuthorDate
+		}
+		contributor.TotalCommits++
+		contributor.TotalInsertions += commit.Stats.Insertions
+		contributor.TotalDeletions += commit.Stats.Deletions
+		contributor.CommitsByDay[commit.AuthorDate.Format("2006-01-02")]++
+		contributor.CommitsByHour[commit.AuthorDate.Hour()]++
+		identityCommits[index][commit.Author]++
+
+		for _, file := range commit.Stats.Files {
+			if ext := statistics.getFileExtension(file.Path); ext != "" {
+				contributor.FileTypes[ext]++
+			}
+			if fileCommits[file.Path] == nil {
+				fileCommits[file.Path] = make([]int, len(selectors))
+			}
+			fileCommits[file.Path][index]++
+		}
+	}
+
+	for i, contributor := range compared {
+		if contributor.TotalCommits == 0 {
+			return nil, fmt.Errorf("no commits found for contributor %q", selectors[i])
+		}
+	}
+
+	comparison := &models.ContributorComparison{
+		TimeRange: ca.timeRange(compared, config.TimeRange),
+	}
+	comparison.Months = monthsBetween(comparison.TimeRange.Start, comparison.TimeRange.End)
+
+	for i, contributor := range compared {
+		ca.setIdentities(contributor, identityCommits[i])
+		contributor.ActiveDays = len(contributor.CommitsByDay)
+		contributor.CommitsByMonth = make([]int, len(comparison.Months))
+		for day, count := range contributor.CommitsByDay {
+			date, err := time.Parse("2006-01-02", day)
+			if err != nil {
+				continue
+			}
+			if month := monthIndex(comparison.Months, date); month >= 0 {
+				contributor.CommitsByMonth[month] += count
+			}
+		}
+	}
+
+	exclusive := make([]map[string]int, len(selectors))
+	for i := range exclusive {
+		exclusive[i] = make(map[string]int)
+	}
+	for path, counts := range fileCommits {
+		shared := models.SharedFile{Path: path, Commits: counts}
+		owner := -1
+		for i, count := range counts {
+			if count == 0 {
+				continue
+			}
+			compared[i].FilesTouched++
+			shared.TotalCommits += count
+			shared.Contributors++
+			owner = i
+		}
+
+		if shared.Contributors > 1 {
+			comparison.SharedFiles = append(comparison.SharedFiles, shared)
+		} else if owner >= 0 {
+			exclusive[owner][path] = counts[owner]
+		}
+	}
+
+	sort.Slice(comparison.SharedFiles, func(i, j int) bool {
+		a, b := comparison.SharedFiles[i], comparison.SharedFiles[j]
+		if a.Contributors != b.Contributors {
+			return a.Contributors > b.Contributors
+		}
+		if a.TotalCommits != b.TotalCommits {
+			return a.TotalCommits > b.TotalCommits
+		}
+		return a.Path < b.Path
+	})
+
+	for i, contributor := range compared {
+		contributor.ExclusiveFiles = topFilePaths(exclusive[i], 10)
+		comparison.Contributors = append(comparison.Contributors, *contributor)
+	}
+
+	return comparison, nil
+}
+
+// matchesSelector checks if an author is selected by selector
+func (ca *ComparisonAnalyzerImpl) matchesSelector(author models.Author, selector string) bool {
+	selector = strings.ToLower(strings.TrimSpace(selector))
+	if strings.Contains(selector, "@") {
+		return strings.ToLower(author.Email) == selector
+	}
+
+	return strings.Contains(strings.ToLower(author.Name), selector) ||
+		strings.Contains(strings.ToLower(author.Email), selector)
+}
+
+// setIdentities records the matched identities, most commits first, and names the contributor after the first
+func (ca *ComparisonAnalyzerImpl) setIdentities(contributor *models.ComparedContributor, commits map[models.Author]int) {
+	for author := range commits {
+		contributor.Identities = append(contributor.Identities, author)
+	}
+
+	sort.Slice(contributor.Identities, func(i, j int) bool {
+		a, b := contributor.Identities[i], contributor.Identities[j]
+		if commits[a] != commits[b] {
+			return commits[a] > commits[b]
+		}
+		return a.Email < b.Email
+	})
+
+	contributor.Name = contributor.Identities[0].Name
+	contributor.Email = contributor.Identities[0].Email
+}
+
+// timeRange returns the configured range, or the span of the compared commits when it is open-ended
+func (ca *ComparisonAnalyzerImpl) timeRange(compared []*models.ComparedContributor, configRange models.TimeRange) models.TimeRange {
+	result := configRange
+	for _, contributor := range compared {
+		if configRange.Start.IsZero() && (result.Start.IsZero() || contributor.FirstCommit.Before(result.Start)) {
+			result.Start = contributor.FirstCommit
+		}
+		if configRange.End.IsZero() && contributor.LastCommit.After(result.End) {
+			result.End = contributor.LastCommit
+		}
+	}
+	return result
+}
+
+// monthsBetween returns the first day of every month from start to end
+func monthsBetween(start, end time.Time) []time.Time {
+	var months []time.Time
+	month := time.Date(start.Year(), start.Month(), 1, 0, 0, 0, 0, start.Location())
+	for !month.After(end) {
+		months = append(months, month)
+		month = month.AddDate(0, 1, 0)
+	}
+	return months
+}
+
+// monthIndex returns the index of date's month in months, or -1
+func monthIndex(months []time.Time, date time.Time) int {
+	for i, month := range months {
+		if month.Year() == date.Year() && month.Month() == date.Month() {
+			return i
+		}
+	}
+	return -1
+}
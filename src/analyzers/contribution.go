@@ -5,7 +5,9 @@ package analyzers
 
 import (
 	"git-stats/models"
+	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -18,15 +20,29 @@ func NewContributionAnalyzer() *ContributionAnalyzerImpl {
 	return &ContributionAnalyzerImpl{}
 }
 
-// AnalyzeContributions analyzes commit data to generate a contribution graph
+// AnalyzeContributions analyzes commit data to generate a contribution graph counting
+// config.Metric per day, with activity level thresholds of the config.Levels strategy
 func (ca *ContributionAnalyzerImpl) AnalyzeContributions(commits []models.Commit, config models.AnalysisConfig) (*models.ContributionGraph, error) {
+	metric := config.Metric
+	if metric == "" {
+		metric = models.MetricCommits
+	}
+
+	levels := config.Levels
+	if levels == "" {
+		levels = models.LevelsLinear
+	}
+
 	if len(commits) == 0 {
 		return &models.ContributionGraph{
-			StartDate:    config.TimeRange.Start,
-			EndDate:      config.TimeRange.End,
-			DailyCommits: make(map[string]int),
-			MaxCommits:   0,
-			TotalCommits: 0,
+			StartDate:   config.TimeRange.Start,
+			EndDate:     config.TimeRange.End,
+			Metric:      metric,
+			DailyValues: make(map[string]int),
+			MaxValue:    0,
+			Total:       0,
+			Levels:      levels,
+			Thresholds:  ca.activityThresholds(nil, 0, levels, config.Thresholds),
 		}, nil
 	}
 
@@ -44,9 +60,8 @@ func (ca *ContributionAnalyzerImpl) AnalyzeContributions(commits []models.Commit
 		current = current.AddDate(0, 0, 1)
 	}
 
-	// Count commits per day
-	totalCommits := 0
-	maxCommits := 0
+	// Authors and files count once per day, whatever number of commits touch them
+	seen := make(map[string]map[string]bool)
 
 	for _, commit := range commits {
 		// Apply author filter if specified
@@ -65,36 +80,129 @@ func (ca *ContributionAnalyzerImpl) AnalyzeContributions(commits []models.Commit
 		}
 
 		dateKey := commit.AuthorDate.Format("2006-01-02")
-		dailyCommits[dateKey]++
-		totalCommits++
+		if seen[dateKey] == nil {
+			seen[dateKey] = make(map[string]bool)
+		}
+		dailyCommits[dateKey] += ca.metricValue(commit, metric, seen[dateKey])
+	}
 
-		if dailyCommits[dateKey] > maxCommits {
-			maxCommits = dailyCommits[dateKey]
+	totalCommits := 0
+	maxCommits := 0
+	for _, value := range dailyCommits {
+		totalCommits += value
+		if value > maxCommits {
+			maxCommits = value
 		}
 	}
 
 	return &models.ContributionGraph{
-		StartDate:    startDate,
-		EndDate:      endDate,
-		DailyCommits: dailyCommits,
-		MaxCommits:   maxCommits,
-		TotalCommits: totalCommits,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		Metric:      metric,
+		DailyValues: dailyCommits,
+		MaxValue:    maxCommits,
+		Total:       totalCommits,
+		Levels:      levels,
+		Thresholds:  ca.activityThresholds(dailyCommits, maxCommits, levels, config.Thresholds),
 	}, nil
 }
 
-// CalculateActivityLevels calculates activity levels for each day
+// metricValue returns what a commit adds to its day. Authors and files already in dayKeys
+// were counted by an earlier commit of the same day
+func (ca *ContributionAnalyzerImpl) metricValue(commit models.Commit, metric string, dayKeys map[string]bool) int {
+	var keys []string
+
+	switch metric {
+	case models.MetricLines:
+		return commit.Stats.Insertions + commit.Stats.Deletions
+	case models.MetricInsertions:
+		return commit.Stats.Insertions
+	case models.MetricDeletions:
+		return commit.Stats.Deletions
+	case models.MetricFiles:
+		if len(commit.Stats.Files) == 0 {
+			return commit.Stats.FilesChanged
+		}
+		for _, file := range commit.Stats.Files {
+			keys = append(keys, file.Path)
+		}
+	case models.MetricAuthors:
+		keys = []string{strings.ToLower(commit.Author.Email)}
+	default:
+		return 1
+	}
+
+	added := 0
+	for _, key := range keys {
+		if !dayKeys[key] {
+			dayKeys[key] = true
+			added++
+		}
+	}
+	return added
+}
+
+// CalculateActivityLevels calculates activity levels for each day from commit counts,
+// splitting the busiest day into quarters as a graph without thresholds does
 // Returns a map of date -> activity level (0, 1, 2, 3, 4)
 func (ca *ContributionAnalyzerImpl) CalculateActivityLevels(dailyCommits map[string]int) map[string]int {
+	graph := models.ContributionGraph{}
+	for _, commits := range dailyCommits {
+		if commits > graph.MaxValue {
+			graph.MaxValue = commits
+		}
+	}
+	return ca.calculateActivityLevels(dailyCommits, graph.LevelThresholds())
+}
+
+// calculateActivityLevels maps each day to its activity level under thresholds
+func (ca *ContributionAnalyzerImpl) calculateActivityLevels(daily map[string]int, thresholds []int) map[string]int {
 	activityLevels := make(map[string]int)
 
-	for date, commits := range dailyCommits {
-		level := ca.getActivityLevel(commits)
-		activityLevels[date] = level
+	for date, value := range daily {
+		activityLevels[date] = models.ActivityLevel(value, thresholds)
 	}
 
 	return activityLevels
 }
 
+// activityThresholds returns the highest daily value of activity levels 1 to 3 under
+// strategy; higher values are level 4. The fixed strategy uses the given thresholds
+func (ca *ContributionAnalyzerImpl) activityThresholds(daily map[string]int, maxValue int, strategy string, fixed []int) []int {
+	thresholds := make([]int, 3)
+
+	switch strategy {
+	case models.LevelsFixed:
+		copy(thresholds, fixed)
+	case models.LevelsQuantile:
+		// Quartiles of the active days, so one outlier does not wash out the rest
+		values := make([]int, 0, len(daily))
+		for _, value := range daily {
+			if value > 0 {
+				values = append(values, value)
+			}
+		}
+		if len(values) == 0 {
+			return thresholds
+		}
+		sort.Ints(values)
+		for i := range thresholds {
+			rank := int(math.Ceil(float64(len(values)*(i+1))/4)) - 1
+			thresholds[i] = values[rank]
+		}
+	case models.LevelsLog:
+		for i := range thresholds {
+			thresholds[i] = int(math.Pow(float64(maxValue), float64(i+1)/4))
+		}
+	default:
+		for i := range thresholds {
+			thresholds[i] = maxValue * (i + 1) / 4
+		}
+	}
+
+	return thresholds
+}
+
 // CalculateStreaks calculates current and longest commit streaks
 func (ca *ContributionAnalyzerImpl) CalculateStreaks(dailyCommits map[string]int) (current int, longest int) {
 	if len(dailyCommits) == 0 {
@@ -147,27 +255,27 @@ func (ca *ContributionAnalyzerImpl) GetContributionSummary(graph *models.Contrib
 		return &ContributionSummary{}
 	}
 
-	activityLevels := ca.CalculateActivityLevels(graph.DailyCommits)
-	currentStreak, longestStreak := ca.CalculateStreaks(graph.DailyCommits)
+	activityLevels := ca.calculateActivityLevels(graph.DailyValues, graph.LevelThresholds())
+	currentStreak, longestStreak := ca.CalculateStreaks(graph.DailyValues)
 
 	// Calculate average commits per day
-	totalDays := len(graph.DailyCommits)
+	totalDays := len(graph.DailyValues)
 	avgCommitsPerDay := 0.0
 	if totalDays > 0 {
-		avgCommitsPerDay = float64(graph.TotalCommits) / float64(totalDays)
+		avgCommitsPerDay = float64(graph.Total) / float64(totalDays)
 	}
 
 	// Count active days
 	activeDays := 0
-	for _, commits := range graph.DailyCommits {
+	for _, commits := range graph.DailyValues {
 		if commits > 0 {
 			activeDays++
 		}
 	}
 
 	return &ContributionSummary{
-		TotalCommits:     graph.TotalCommits,
-		MaxCommitsPerDay: graph.MaxCommits,
+		TotalCommits:     graph.Total,
+		MaxCommitsPerDay: graph.MaxValue,
 		ActiveDays:       activeDays,
 		TotalDays:        totalDays,
 		CurrentStreak:    currentStreak,
@@ -177,6 +285,123 @@ func (ca *ContributionAnalyzerImpl) GetContributionSummary(graph *models.Contrib
 	}
 }
 
+// SummarizeYears returns the totals and longest streak of each calendar year from to to of
+// graph, newest year first
+func (ca *ContributionAnalyzerImpl) SummarizeYears(graph *models.ContributionGraph, from, to int) []models.ContributionYear {
+	if graph == nil || from > to {
+		return nil
+	}
+
+	byYear := make(map[string]map[string]int)
+	for date, commits := range graph.DailyValues {
+		year := date[:4]
+		if byYear[year] == nil {
+			byYear[year] = make(map[string]int)
+		}
+		byYear[year][date] = commits
+	}
+
+	years := make([]models.ContributionYear, 0, to-from+1)
+	for year := to; year >= from; year-- {
+		summary := models.ContributionYear{Year: year}
+		days := byYear[strconv.Itoa(year)]
+		for _, commits := range days {
+			summary.Total += commits
+			if commits > 0 {
+				summary.ActiveDays++
+			}
+			if commits > summary.MaxValue {
+				summary.MaxValue = commits
+			}
+		}
+		_, summary.LongestStreak = ca.CalculateStreaks(days)
+		years = append(years, summary)
+	}
+
+	return years
+}
+
+// AnalyzeAuthors fills graph.Authors with each author's daily values of the graph's metric,
+// busiest author first. Authors are told apart by email, case insensitively, and commits are
+// filtered as for the graph itself
+func (ca *ContributionAnalyzerImpl) AnalyzeAuthors(graph *models.ContributionGraph, commits []models.Commit, config models.AnalysisConfig) {
+	if graph == nil {
+		return
+	}
+
+	ca.analyzeGroups(graph, commits, config, func(commit models.Commit) (string, string, string) {
+		return strings.ToLower(commit.Author.Email), commit.Author.Name, commit.Author.Email
+	})
+}
+
+// AnalyzeTeams fills graph.Authors with each team's daily values of the graph's metric, busiest
+// team first. Commits count for the team their author was on that day; the commits of authors
+// outside every team make up models.NoTeam
+func (ca *ContributionAnalyzerImpl) AnalyzeTeams(graph *models.ContributionGraph, commits []models.Commit, config models.AnalysisConfig, teams models.Teams) {
+	if graph == nil {
+		return
+	}
+
+	ca.analyzeGroups(graph, commits, config, func(commit models.Commit) (string, string, string) {
+		team := teams.TeamOf(commit.Author.Email, commit.AuthorDate)
+		return team, team, ""
+	})
+	graph.ByTeam = true
+}
+
+// analyzeGroups fills graph.Authors with the daily values of the groups commits fall into; group
+// returns a commit's group key, with the name and email the group is shown with
+func (ca *ContributionAnalyzerImpl) analyzeGroups(graph *models.ContributionGraph, commits []models.Commit, config models.AnalysisConfig,
+	group func(commit models.Commit) (string, string, string)) {
+	byKey := make(map[string]*models.AuthorContributions)
+	seen := make(map[string]map[string]bool) // group and date -> files already counted
+
+	for _, commit := range commits {
+		if config.AuthorFilter != "" && !ca.matchesAuthor(commit.Author, config.AuthorFilter) {
+			continue
+		}
+		if !config.IncludeMerges && commit.IsMergeCommit() {
+			continue
+		}
+		if !ca.isWithinTimeRange(commit.AuthorDate, graph.StartDate, graph.EndDate) {
+			continue
+		}
+
+		key, name, email := group(commit)
+		author := byKey[key]
+		if author == nil {
+			// Commits come newest first, so authors keep their latest name
+			author = &models.AuthorContributions{Name: name, Email: email, Daily: make(map[string]int)}
+			byKey[key] = author
+		}
+
+		dateKey := commit.AuthorDate.Format("2006-01-02")
+		dayKey := key + " " + dateKey
+		if seen[dayKey] == nil {
+			seen[dayKey] = make(map[string]bool)
+		}
+		if value := ca.metricValue(commit, graph.Metric, seen[dayKey]); value > 0 {
+			author.Daily[dateKey] += value
+			author.Total += value
+		}
+	}
+
+	authors := make([]models.AuthorContributions, 0, len(byKey))
+	for _, author := range byKey {
+		if author.Total > 0 {
+			authors = append(authors, *author)
+		}
+	}
+	sort.Slice(authors, func(i, j int) bool {
+		if authors[i].Total != authors[j].Total {
+			return authors[i].Total > authors[j].Total
+		}
+		return authors[i].Name < authors[j].Name
+	})
+
+	graph.Authors = authors
+}
+
 // determineTimeRange determines the appropriate time range for analysis
 func (ca *ContributionAnalyzerImpl) determineTimeRange(commits []models.Commit, configRange models.TimeRange) (time.Time, time.Time) {
 	// If explicit time range is provided, use it
@@ -234,21 +459,6 @@ func (ca *ContributionAnalyzerImpl) determineTimeRange(commits []models.Commit,
 	return startDate, endDate
 }
 
-// getActivityLevel returns activity level based on commit count
-// 0: no commits, 1: 1-3 commits, 2: 4-9 commits, 3: 10-19 commits, 4: 20+ commits
-func (ca *ContributionAnalyzerImpl) getActivityLevel(commits int) int {
-	if commits == 0 {
-		return 0
-	} else if commits <= 3 {
-		return 1
-	} else if commits <= 9 {
-		return 2
-	} else if commits <= 19 {
-		return 3
-	}
-	return 4
-}
-
 // matchesAuthor checks if a commit author matches the filter
 func (ca *ContributionAnalyzerImpl) matchesAuthor(author models.Author, filter string) bool {
 	if filter == "" {
@@ -0,0 +1,207 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Directory and module statistics analysis
+
+package analyzers
+
+import (
+	"git-stats/models"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// directoryTopAuthors is how many authors each directory lists
+const directoryTopAuthors = 3
+
+// directoryTrendWeeks is how many weeks the activity sparkline of a directory covers
+const directoryTrendWeeks = 12
+
+// DirectoryAnalyzerImpl aggregates commit activity by directory or module
+type DirectoryAnalyzerImpl struct{}
+
+// NewDirectoryAnalyzer creates a new directory analyzer
+func NewDirectoryAnalyzer() *DirectoryAnalyzerImpl {
+	return &DirectoryAnalyzerImpl{}
+}
+
+// directoryCounts collects the per-directory counts that are reduced once every commit is added
+type directoryCounts struct {
+	stats         *models.DirectoryStats
+	files         map[string]bool
+	lastCommit    int // index of the last commit counted, so a commit touching several files counts once
+	commits       []models.Commit
+	authorCommits map[string]int
+	authorNames   map[string]string
+}
+
+// ModuleRoots returns the directories of files that hold a module manifest, keyed by directory
+// ("" for the repository root) with the manifest's name; manifests of vendored code are skipped
+func ModuleRoots(files []string) map[string]string {
+	roots := make(map[string]string)
+	for _, file := range files {
+		base := path.Base(file)
+		isManifest := false
+		for _, manifest := range models.ModuleManifests {
+			if base == manifest {
+				isManifest = true
+				break
+			}
+		}
+		if !isManifest || growthExcluded(file, []string{models.ExcludeVendored}) {
+			continue
+		}
+
+		dir := path.Dir(file)
+		if dir == "." {
+			dir = ""
+		}
+		if _, seen := roots[dir]; !seen {
+			roots[dir] = base
+		}
+	}
+	return roots
+}
+
+// AnalyzeDirectories aggregates the files changed by commits into the deepest module root that
+// contains them or, outside modules, into their directory cut to options.Depth segments. The
+// result is sorted by commits, most first
+func (da *DirectoryAnalyzerImpl) AnalyzeDirectories(commits []models.Commit, options models.DirectoryOptions) []models.DirectoryStats {
+	depth := options.Depth
+	if depth < 1 {
+		depth = 1
+	}
+
+	var end time.Time
+	for _, commit := range commits {
+		if commit.AuthorDate.After(end) {
+			end = commit.AuthorDate
+		}
+	}
+	endDay := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+
+	byPath := make(map[string]*directoryCounts)
+	for i, commit := range commits {
+		email := strings.ToLower(commit.Author.Email)
+		for _, file := range commit.Stats.Files {
+			filePath := renamedPath(file.Path)
+			if filePath == "" {
+				continue
+			}
+
+			dir, manifest := directoryOf(filePath, depth, options.ModuleRoots)
+			counts := byPath[dir]
+			if counts == nil {
+				counts = &directoryCounts{
+					stats:         &models.DirectoryStats{Path: dir, Manifest: manifest, WeeklyCommits: make([]int, directoryTrendWeeks)},
+					files:         make(map[string]bool),
+					lastCommit:    -1,
+					authorCommits: make(map[string]int),
+					authorNames:   make(map[string]string),
+				}
+				byPath[dir] = counts
+			}
+
+			stats := counts.stats
+			counts.files[filePath] = true
+			stats.Insertions += file.Insertions
+			stats.Deletions += file.Deletions
+			if counts.lastCommit == i {
+				continue
+			}
+
+			counts.lastCommit = i
+			counts.commits = append(counts.commits, commit)
+			stats.Commits++
+			counts.authorCommits[email]++
+			if counts.authorNames[email] == "" {
+				counts.authorNames[email] = commit.Author.Name
+			}
+			if stats.FirstChange.IsZero() || commit.AuthorDate.Before(stats.FirstChange) {
+				stats.FirstChange = commit.AuthorDate
+			}
+			if commit.AuthorDate.After(stats.LastChange) {
+				stats.LastChange = commit.AuthorDate
+			}
+
+			day := time.Date(commit.AuthorDate.Year(), commit.AuthorDate.Month(), commit.AuthorDate.Day(), 0, 0, 0, 0, time.UTC)
+			if daysBefore := int(endDay.Sub(day).Hours() / 24); daysBefore >= 0 && daysBefore < directoryTrendWeeks*7 {
+				stats.WeeklyCommits[directoryTrendWeeks-1-daysBefore/7]++
+			}
+		}
+	}
+
+	health := NewHealthAnalyzer()
+	directories := make([]models.DirectoryStats, 0, len(byPath))
+	for _, counts := range byPath {
+		stats := counts.stats
+		stats.Files = len(counts.files)
+		stats.Authors = len(counts.authorCommits)
+		stats.TopAuthors = directoryAuthors(counts, stats.Commits)
+		stats.Trend = health.CalculateActivityTrend(counts.commits)
+		directories = append(directories, *stats)
+	}
+
+	sort.Slice(directories, func(i, j int) bool {
+		if directories[i].Commits != directories[j].Commits {
+			return directories[i].Commits > directories[j].Commits
+		}
+		return directories[i].Path < directories[j].Path
+	})
+	return directories
+}
+
+// directoryOf returns the module root containing filePath with its manifest or, outside modules,
+// the directory of filePath cut to depth segments; files at the root belong to RootDirectory
+func directoryOf(filePath string, depth int, moduleRoots map[string]string) (string, string) {
+	// The deepest module root wins, so nested modules get their own statistics
+	best, manifest, found := "", "", false
+	for root, rootManifest := range moduleRoots {
+		if root != "" && !strings.HasPrefix(filePath, root+"/") {
+			continue
+		}
+		if !found || len(root) > len(best) {
+			best, manifest, found = root, rootManifest, true
+		}
+	}
+	if found {
+		if best == "" {
+			return models.RootDirectory, manifest
+		}
+		return best, manifest
+	}
+
+	dir := path.Dir(filePath)
+	if dir == "." {
+		return models.RootDirectory, ""
+	}
+	segments := strings.Split(dir, "/")
+	if len(segments) > depth {
+		segments = segments[:depth]
+	}
+	return strings.Join(segments, "/"), ""
+}
+
+// directoryAuthors returns the authors with the most commits in a directory and their share of
+// its commits; ties go to the name first in alphabetical order
+func directoryAuthors(counts *directoryCounts, commits int) []models.ContributorSummary {
+	authors := make([]models.ContributorSummary, 0, len(counts.authorCommits))
+	for email, authorCommits := range counts.authorCommits {
+		summary := models.ContributorSummary{Name: counts.authorNames[email], Email: email, Commits: authorCommits}
+		if commits > 0 {
+			summary.Percentage = float64(authorCommits) / float64(commits) * 100
+		}
+		authors = append(authors, summary)
+	}
+
+	sort.Slice(authors, func(i, j int) bool {
+		if authors[i].Commits != authors[j].Commits {
+			return authors[i].Commits > authors[j].Commits
+		}
+		return authors[i].Name < authors[j].Name
+	})
+	if len(authors) > directoryTopAuthors {
+		authors = authors[:directoryTopAuthors]
+	}
+	return authors
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - File tree analysis
+
+package analyzers
+
+import (
+	"git-stats/models"
+	"sort"
+	"strings"
+)
+
+// FileTreeAnalyzerImpl aggregates commit activity by file and directory
+type FileTreeAnalyzerImpl struct{}
+
+// NewFileTreeAnalyzer creates a new file tree analyzer
+func NewFileTreeAnalyzer() *FileTreeAnalyzerImpl {
+	return &FileTreeAnalyzerImpl{}
+}
+
+// treeNodeStats collects the per-node counts that are reduced once every commit is added
+type treeNodeStats struct {
+	lastCommit    int // index of the last commit counted, so a commit touching several files counts once
+	authorCommits map[string]int
+	authorNames   map[string]string
+}
+
+// BuildFileTree builds the directory tree of every file changed by commits; children are
+// sorted by name with directories first
+func (fa *FileTreeAnalyzerImpl) BuildFileTree(commits []models.Commit) *models.FileTreeNode {
+	root := &models.FileTreeNode{IsDir: true}
+	stats := map[*models.FileTreeNode]*treeNodeStats{}
+	children := map[*models.FileTreeNode]map[string]*models.FileTreeNode{}
+
+	for i, commit := range commits {
+		email := strings.ToLower(commit.Author.Email)
+		for _, file := range commit.Stats.Files {
+			path := renamedPath(file.Path)
+			if path == "" {
+				continue
+			}
+
+			// Count the change on the root and every directory down to the file
+			node := root
+			names := strings.Split(path, "/")
+			for depth := 0; ; depth++ {
+				nodeStats := stats[node]
+				if nodeStats == nil {
+					nodeStats = &treeNodeStats{lastCommit: -1, authorCommits: map[string]int{}, authorNames: map[string]string{}}
+					stats[node] = nodeStats
+				}
+
+				node.Insertions += file.Insertions
+				node.Deletions += file.Deletions
+				if nodeStats.lastCommit != i {
+					nodeStats.lastCommit = i
+					node.Commits++
+					nodeStats.authorCommits[email]++
+					nodeStats.authorNames[email] = commit.Author.Name
+				}
+				if commit.AuthorDate.After(node.LastModified) {
+					node.LastModified = commit.AuthorDate
+				}
+
+				if depth == len(names) {
+					break
+				}
+
+				if children[node] == nil {
+					children[node] = map[string]*models.FileTreeNode{}
+				}
+				child := children[node][names[depth]]
+				if child == nil {
+					child = &models.FileTreeNode{
+						Name:  names[depth],
+						Path:  strings.Join(names[:depth+1], "/"),
+						IsDir: depth < len(names)-1,
+					}
+					children[node][names[depth]] = child
+					node.Children = append(node.Children, child)
+				}
+				node = child
+			}
+		}
+	}
+
+	for node, nodeStats := range stats {
+		node.Authors = len(nodeStats.authorCommits)
+		top := ""
+		for email, count := range nodeStats.authorCommits {
+			if top == "" || count > nodeStats.authorCommits[top] || count == nodeStats.authorCommits[top] && email < top {
+				top = email
+			}
+		}
+		node.TopAuthor = nodeStats.authorNames[top]
+
+		sort.Slice(node.Children, func(i, j int) bool {
+			a, b := node.Children[i], node.Children[j]
+			if a.IsDir != b.IsDir {
+				return a.IsDir
+			}
+			return a.Name < b.Name
+		})
+	}
+
+	return root
+}
+
+// renamedPath returns the new path of a rename reported as "old => new" or "dir/{old => new}/file"
+func renamedPath(path string) string {
+	path = strings.TrimSpace(path)
+	if !strings.Contains(path, " => ") {
+		return path
+	}
+
+	open, end := strings.Index(path, "{"), strings.Index(path, "}")
+	if open >= 0 && end > open {
+		inner := path[open+1 : end]
+		newName := strings.TrimSpace(inner[strings.Index(inner, " => ")+4:])
+		joined := path[:open] + newName + path[end+1:]
+		// An empty side such as "{ => lib}/x.go" leaves a doubled or leading slash
+		return strings.TrimPrefix(strings.ReplaceAll(joined, "//", "/"), "/")
+	}
+
+	return strings.TrimSpace(path[strings.Index(path, " => ")+4:])
+}
@@ -0,0 +1,284 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Commit graph (DAG) layout
+
+package analyzers
+
+import (
+	"container/heap"
+	"git-stats/models"
+	"strings"
+)
+
+// CommitGraphAnalyzerImpl lays out the commit DAG in lanes like git log --graph
+type CommitGraphAnalyzerImpl struct{}
+
+// NewCommitGraphAnalyzer creates a new commit graph analyzer
+func NewCommitGraphAnalyzer() *CommitGraphAnalyzerImpl {
+	return &CommitGraphAnalyzerImpl{}
+}
+
+// Directions a lane glyph connects to
+const (
+	laneUp = 1 << iota
+	laneDown
+	laneLeft
+	laneRight
+)
+
+// laneGlyphs maps the connected directions of a graph cell to its box-drawing glyph
+var laneGlyphs = map[int]rune{
+	laneUp:                                   '│',
+	laneDown:                                 '│',
+	laneUp | laneDown:                        '│',
+	laneLeft:                                 '─',
+	laneRight:                                '─',
+	laneLeft | laneRight:                     '─',
+	laneUp | laneLeft:                        '┘',
+	laneUp | laneRight:                       '└',
+	laneDown | laneLeft:                      '╮',
+	laneDown | laneRight:                     '╭',
+	laneUp | laneDown | laneLeft:             '┤',
+	laneUp | laneDown | laneRight:            '├',
+	laneUp | laneLeft | laneRight:            '┴',
+	laneDown | laneLeft | laneRight:          '┬',
+	laneUp | laneDown | laneLeft | laneRight: '┼',
+}
+
+// graphNode is the glyph of a commit
+const graphNode = '●'
+
+// BuildGraph orders commits children first, newest first among unrelated commits, and assigns
+// each a lane; refs maps full hashes to their branch and tag names. Parents that are not in
+// commits (outside the time range or limit) end their lane
+func (ga *CommitGraphAnalyzerImpl) BuildGraph(commits []models.Commit, refs map[string][]string) *models.CommitGraph {
+	ordered := topologicalOrder(commits)
+	present := make(map[string]bool, len(ordered))
+	for _, commit := range ordered {
+		present[commit.Hash] = true
+	}
+
+	graph := &models.CommitGraph{Rows: make([]models.CommitGraphRow, 0, len(ordered))}
+	var lanes []string // the commit each lane leads to, "" for a free lane
+
+	for _, commit := range ordered {
+		before := append([]string(nil), lanes...)
+
+		// Every lane leading to the commit ends here; the leftmost carries on to its first parent
+		var incoming []int
+		for i, hash := range lanes {
+			if hash == commit.Hash {
+				incoming = append(incoming, i)
+			}
+		}
+		used := make(map[int]bool)
+		node := -1
+		if len(incoming) > 0 {
+			node = incoming[0]
+		} else {
+			lanes, node = freeLane(lanes, used)
+		}
+		used[node] = true
+
+		cells := make(map[int]int)
+		gaps := make(map[int]bool) // gap right of lane i carries a horizontal line
+		connect := func(lane int) {
+			from, to := node, lane
+			if from > to {
+				from, to = to, from
+			}
+			for i := from + 1; i < to; i++ {
+				cells[i] |= laneLeft | laneRight
+			}
+			for i := from; i < to; i++ {
+				gaps[i] = true
+			}
+			if lane > node {
+				cells[lane] |= laneLeft
+			} else {
+				cells[lane] |= laneRight
+			}
+		}
+
+		for _, lane := range incoming {
+			if lane == node {
+				continue
+			}
+			lanes[lane] = ""
+			used[lane] = true
+			cells[lane] |= laneUp
+			connect(lane)
+		}
+
+		// Lanes not involved pass straight through
+		for i, hash := range before {
+			if hash != "" && hash != commit.Hash {
+				cells[i] |= laneUp | laneDown
+			}
+		}
+
+		parents := presentParents(commit, present)
+		lanes[node] = ""
+		if len(parents) > 0 {
+			lanes[node] = parents[0]
+		}
+
+		// Further parents of a merge join the lane already leading to them or fork a new one
+		forked := false
+		for p, parent := range parents {
+			if p == 0 {
+				continue
+			}
+			lane := -1
+			for i, hash := range lanes {
+				if hash == parent && i != node {
+					lane = i
+					break
+				}
+			}
+			if lane < 0 {
+				lanes, lane = freeLane(lanes, used)
+				lanes[lane] = parent
+				cells[lane] |= laneDown
+				forked = true
+			}
+			used[lane] = true
+			connect(lane)
+		}
+
+		width := len(lanes)
+		if len(before) > width {
+			width = len(before)
+		}
+		var glyphs strings.Builder
+		for i := 0; i < width; i++ {
+			switch {
+			case i == node:
+				glyphs.WriteRune(graphNode)
+			case cells[i] != 0:
+				glyphs.WriteRune(laneGlyphs[cells[i]])
+			default:
+				glyphs.WriteRune(' ')
+			}
+			if gaps[i] {
+				glyphs.WriteRune('─')
+			} else {
+				glyphs.WriteRune(' ')
+			}
+		}
+
+		// Free lanes at the right edge are dropped so the graph stays narrow
+		for len(lanes) > 0 && lanes[len(lanes)-1] == "" {
+			lanes = lanes[:len(lanes)-1]
+		}
+		if width > graph.MaxLanes {
+			graph.MaxLanes = width
+		}
+
+		row := models.CommitGraphRow{
+			Commit: commit,
+			Lane:   node,
+			Graph:  strings.TrimRight(glyphs.String(), " "),
+			Refs:   refs[commit.Hash],
+		}
+		row.Linear = len(commit.ParentHashes) == 1 && len(parents) == 1 && len(row.Refs) == 0 &&
+			len(incoming) == 1 && !forked && len(gaps) == 0
+		graph.Rows = append(graph.Rows, row)
+	}
+
+	return graph
+}
+
+// freeLane returns the leftmost free lane not used on the current row, adding one when needed
+func freeLane(lanes []string, used map[int]bool) ([]string, int) {
+	for i, hash := range lanes {
+		if hash == "" && !used[i] {
+			return lanes, i
+		}
+	}
+	return append(lanes, ""), len(lanes)
+}
+
+// presentParents returns the distinct parents of commit that are part of the graph
+func presentParents(commit models.Commit, present map[string]bool) []string {
+	var parents []string
+	seen := make(map[string]bool)
+	for _, parent := range commit.ParentHashes {
+		if present[parent] && !seen[parent] {
+			seen[parent] = true
+			parents = append(parents, parent)
+		}
+	}
+	return parents
+}
+
+// commitQueue orders commit indices newest committer date first, then by input order
+type commitQueue struct {
+	commits []models.Commit
+	indices []int
+}
+
+func (q *commitQueue) Len() int { return len(q.indices) }
+
+func (q *commitQueue) Less(i, j int) bool {
+	a, b := q.commits[q.indices[i]], q.commits[q.indices[j]]
+	if !a.CommitterDate.Equal(b.CommitterDate) {
+		return a.CommitterDate.After(b.CommitterDate)
+	}
+	return q.indices[i] < q.indices[j]
+}
+
+func (q *commitQueue) Swap(i, j int) { q.indices[i], q.indices[j] = q.indices[j], q.indices[i] }
+
+func (q *commitQueue) Push(x interface{}) { q.indices = append(q.indices, x.(int)) }
+
+func (q *commitQueue) Pop() interface{} {
+	last := q.indices[len(q.indices)-1]
+	q.indices = q.indices[:len(q.indices)-1]
+	return last
+}
+
+// topologicalOrder sorts commits so every commit comes before its parents, like
+// git log --date-order; duplicate hashes are dropped
+func topologicalOrder(commits []models.Commit) []models.Commit {
+	index := make(map[string]int, len(commits))
+	unique := make([]models.Commit, 0, len(commits))
+	for _, commit := range commits {
+		if _, ok := index[commit.Hash]; !ok {
+			index[commit.Hash] = len(unique)
+			unique = append(unique, commit)
+		}
+	}
+
+	present := make(map[string]bool, len(index))
+	for hash := range index {
+		present[hash] = true
+	}
+
+	children := make([]int, len(unique))
+	for _, commit := range unique {
+		for _, parent := range presentParents(commit, present) {
+			children[index[parent]]++
+		}
+	}
+
+	queue := &commitQueue{commits: unique}
+	for i := range unique {
+		if children[i] == 0 {
+			queue.indices = append(queue.indices, i)
+		}
+	}
+	heap.Init(queue)
+
+	ordered := make([]models.Commit, 0, len(unique))
+	for queue.Len() > 0 {
+		commit := unique[heap.Pop(queue).(int)]
+		ordered = append(ordered, commit)
+		for _, parent := range presentParents(commit, present) {
+			if children[index[parent]]--; children[index[parent]] == 0 {
+				heap.Push(queue, index[parent])
+			}
+		}
+	}
+
+	return ordered
+}
@@ -0,0 +1,195 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Lines of code growth analysis
+
+package analyzers
+
+import (
+	"git-stats/models"
+	"path"
+	"sort"
+	"strings"
+	"time"
+)
+
+// generatedPatterns match the base names of files written by code generators and minifiers
+var generatedPatterns = []string{
+	"*.pb.go", "*.pb.gw.go", "*_generated.go", "*.gen.go", "*_gen.go", "*.generated.*",
+	"*_pb2.py", "*_pb2_grpc.py", "*.pb.cc", "*.pb.h", "*.min.js", "*.min.css", "*.map",
+}
+
+// vendoredDirectories are directory names whose contents are third-party code
+var vendoredDirectories = map[string]bool{
+	"vendor": true, "node_modules": true, "third_party": true, "bower_components": true, "Pods": true,
+}
+
+// lockfiles are the base names of dependency lock files
+var lockfiles = map[string]bool{
+	"go.sum": true, "package-lock.json": true, "npm-shrinkwrap.json": true, "yarn.lock": true,
+	"pnpm-lock.yaml": true, "Cargo.lock": true, "Gemfile.lock": true, "poetry.lock": true,
+	"Pipfile.lock": true, "composer.lock": true, "mix.lock": true, "Podfile.lock": true, "flake.lock": true,
+}
+
+// GrowthAnalyzerImpl computes the net lines of code over time from commit numstat
+type GrowthAnalyzerImpl struct{}
+
+// NewGrowthAnalyzer creates a new code growth analyzer
+func NewGrowthAnalyzer() *GrowthAnalyzerImpl {
+	return &GrowthAnalyzerImpl{}
+}
+
+// AnalyzeGrowth returns the cumulative net lines per period from the first commit's period to the
+// last one's, with a series per file extension or top-level directory when options ask for one.
+// Totals start at zero, so they match the code size only when commits reach back to the first one
+func (ga *GrowthAnalyzerImpl) AnalyzeGrowth(commits []models.Commit, options models.GrowthOptions) *models.CodeGrowth {
+	interval := options.Interval
+	if interval == "" {
+		interval = models.GrowthWeek
+	}
+
+	growth := &models.CodeGrowth{
+		Interval:  interval,
+		Breakdown: options.Breakdown,
+		Exclude:   options.Exclude,
+		Total:     models.GrowthSeries{Name: "Total"},
+	}
+	if len(commits) == 0 {
+		return growth
+	}
+
+	type change struct{ insertions, deletions int }
+	total := make(map[time.Time]*change)
+	groups := make(map[string]map[time.Time]*change)
+	excluded := make(map[string]bool)
+	first, last := models.PeriodStart(commits[0].AuthorDate, interval), models.PeriodStart(commits[0].AuthorDate, interval)
+
+	add := func(periods map[time.Time]*change, period time.Time, file models.FileChange) {
+		if periods[period] == nil {
+			periods[period] = &change{}
+		}
+		periods[period].insertions += file.Insertions
+		periods[period].deletions += file.Deletions
+	}
+
+	for _, commit := range commits {
+		period := models.PeriodStart(commit.AuthorDate, interval)
+		if period.Before(first) {
+			first = period
+		}
+		if period.After(last) {
+			last = period
+		}
+
+		for _, file := range commit.Stats.Files {
+			filePath := renamedPath(file.Path)
+			if filePath == "" {
+				continue
+			}
+			if growthExcluded(filePath, options.Exclude) {
+				excluded[filePath] = true
+				growth.ExcludedLines += file.Insertions + file.Deletions
+				continue
+			}
+
+			add(total, period, file)
+			if group := growthGroup(filePath, options.Breakdown); group != "" {
+				if groups[group] == nil {
+					groups[group] = make(map[time.Time]*change)
+				}
+				add(groups[group], period, file)
+			}
+		}
+	}
+	growth.ExcludedFiles = len(excluded)
+
+	// Every series gets a point for every period, so they line up in charts and tables
+	series := func(name string, periods map[time.Time]*change) models.GrowthSeries {
+		s := models.GrowthSeries{Name: name}
+		running := 0
+		for period := first; !period.After(last); period = models.NextPeriod(period, interval) {
+			point := models.GrowthPoint{Period: period}
+			if c := periods[period]; c != nil {
+				point.Insertions, point.Deletions = c.insertions, c.deletions
+			}
+			point.Net = point.Insertions - point.Deletions
+			running += point.Net
+			point.Total = running
+			s.Points = append(s.Points, point)
+		}
+		return s
+	}
+
+	growth.Total = series("Total", total)
+	for name, periods := range groups {
+		growth.Groups = append(growth.Groups, series(name, periods))
+	}
+	sort.Slice(growth.Groups, func(i, j int) bool {
+		if a, b := growth.Groups[i].Final(), growth.Groups[j].Final(); a != b {
+			return a > b
+		}
+		return growth.Groups[i].Name < growth.Groups[j].Name
+	})
+
+	return growth
+}
+
+// growthGroup returns the extension or top-level directory filePath counts toward, or "" without
+// a breakdown
+func growthGroup(filePath, breakdown string) string {
+	switch breakdown {
+	case models.GrowthByExtension:
+		if ext := path.Ext(filePath); len(ext) > 1 {
+			return ext[1:]
+		}
+		return "(no extension)"
+	case models.GrowthByDirectory:
+		if slash := strings.Index(filePath, "/"); slash > 0 {
+			return filePath[:slash]
+		}
+		return "(root)"
+	}
+	return ""
+}
+
+// growthExcluded reports whether filePath matches an exclusion class or glob. Globs without a
+// slash match the base name, others the whole path; a glob ending in a slash excludes a directory
+func growthExcluded(filePath string, exclude []string) bool {
+	base := path.Base(filePath)
+	dirs := strings.Split(path.Dir(filePath), "/")
+
+	for _, pattern := range exclude {
+		switch pattern {
+		case models.ExcludeGenerated:
+			for _, generated := range generatedPatterns {
+				if matched, _ := path.Match(generated, base); matched {
+					return true
+				}
+			}
+		case models.ExcludeVendored:
+			for _, dir := range dirs {
+				if vendoredDirectories[dir] {
+					return true
+				}
+			}
+		case models.ExcludeLockfiles:
+			if lockfiles[base] {
+				return true
+			}
+		default:
+			if strings.HasSuffix(pattern, "/") {
+				dir := strings.TrimSuffix(pattern, "/")
+				if filePath == dir || strings.HasPrefix(filePath, dir+"/") {
+					return true
+				}
+				continue
+			}
+			target := base
+			if strings.Contains(pattern, "/") {
+				target = filePath
+			}
+			if matched, _ := path.Match(pattern, target); matched {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -26,6 +26,7 @@ func (sa *StatisticsAnalyzerImpl) AnalyzeStatistics(commits []models.Commit, con
 		return &models.StatsSummary{
 			CommitsByHour:    make(map[int]int),
 			CommitsByWeekday: make(map[time.Weekday]int),
+			CommitsByWeek:    []models.WeeklyStats{},
 			TopFiles:         []models.FileStats{},
 			TopFileTypes:     []models.FileTypeStats{},
 		}, nil
@@ -64,6 +65,8 @@ func (sa *StatisticsAnalyzerImpl) AnalyzeStatistics(commits []models.Commit, con
 
 	// Analyze commit patterns (hours and weekdays)
 	summary.CommitsByHour, summary.CommitsByWeekday = sa.AnalyzeCommitPatterns(filteredCommits)
+	summary.PunchCard = sa.AnalyzePunchCard(filteredCommits)
+	summary.CommitsByWeek = sa.AnalyzeWeeklyActivity(filteredCommits)
 
 	// Analyze file statistics
 	summary.TopFiles, summary.TopFileTypes = sa.AnalyzeFileStatistics(filteredCommits)
@@ -242,6 +245,139 @@ func (sa *StatisticsAnalyzerImpl) GetTimeBasedPatterns(commits []models.Commit)
 	return patterns
 }
 
+// AnalyzePunchCard counts commits by weekday and hour of their author date
+func (sa *StatisticsAnalyzerImpl) AnalyzePunchCard(commits []models.Commit) models.PunchCard {
+	var card models.PunchCard
+	for _, commit := range commits {
+		card.Add(commit.AuthorDate)
+	}
+	return card
+}
+
+// AnalyzeWeeklyActivity totals commits and lines per week, Monday first, including the quiet
+// weeks between the first commit and the last
+func (sa *StatisticsAnalyzerImpl) AnalyzeWeeklyActivity(commits []models.Commit) []models.WeeklyStats {
+	if len(commits) == 0 {
+		return []models.WeeklyStats{}
+	}
+
+	byWeek := make(map[time.Time]*models.WeeklyStats)
+	first, last := models.WeekStart(commits[0].AuthorDate), models.WeekStart(commits[0].AuthorDate)
+	for _, commit := range commits {
+		week := models.WeekStart(commit.AuthorDate)
+		if byWeek[week] == nil {
+			byWeek[week] = &models.WeeklyStats{Week: week}
+		}
+		byWeek[week].Commits++
+		byWeek[week].Insertions += commit.Stats.Insertions
+		byWeek[week].Deletions += commit.Stats.Deletions
+
+		if week.Before(first) {
+			first = week
+		}
+		if week.After(last) {
+			last = week
+		}
+	}
+
+	var weeks []models.WeeklyStats
+	for week := first; !week.After(last); week = week.AddDate(0, 0, 7) {
+		if stats := byWeek[week]; stats != nil {
+			weeks = append(weeks, *stats)
+		} else {
+			weeks = append(weeks, models.WeeklyStats{Week: week})
+		}
+	}
+	return weeks
+}
+
+// AnalyzeContributors derives per-author statistics from commits, most active first
+func (sa *StatisticsAnalyzerImpl) AnalyzeContributors(commits []models.Commit) []models.Contributor {
+	byEmail := make(map[string]*models.Contributor)
+	var order []string
+	fileCounts := make(map[string]map[string]int)
+
+	for _, commit := range commits {
+		key := strings.ToLower(commit.Author.Email)
+		if key == "" {
+			key = commit.Author.Name
+		}
+
+		contributor, exists := byEmail[key]
+		if !exists {
+			contributor = &models.Contributor{
+				Name:             commit.Author.Name,
+				Email:            commit.Author.Email,
+				FirstCommit:      commit.AuthorDate,
+				LastCommit:       commit.AuthorDate,
+				CommitsByDay:     make(map[string]int),
+				CommitsByHour:    make(map[int]int),
+				CommitsByWeekday: make(map[int]int),
+				FileTypes:        make(map[string]int),
+			}
+			byEmail[key] = contributor
+			fileCounts[key] = make(map[string]int)
+			order = append(order, key)
+		}
+
+		contributor.TotalCommits++
+		contributor.TotalInsertions += commit.Stats.Insertions
+		contributor.TotalDeletions += commit.Stats.Deletions
+		if commit.AuthorDate.Before(contributor.FirstCommit) {
+			contributor.FirstCommit = commit.AuthorDate
+		}
+		if commit.AuthorDate.After(contributor.LastCommit) {
+			contributor.LastCommit = commit.AuthorDate
+		}
+
+		contributor.CommitsByDay[commit.AuthorDate.Format("2006-01-02")]++
+		contributor.CommitsByHour[commit.AuthorDate.Hour()]++
+		contributor.CommitsByWeekday[int(commit.AuthorDate.Weekday())]++
+		contributor.PunchCard.Add(commit.AuthorDate)
+
+		for _, file := range commit.Stats.Files {
+			if ext := sa.getFileExtension(file.Path); ext != "" {
+				contributor.FileTypes[ext]++
+			}
+			fileCounts[key][file.Path]++
+		}
+	}
+
+	contributors := make([]models.Contributor, 0, len(order))
+	for _, key := range order {
+		contributor := byEmail[key]
+		contributor.ActiveDays = len(contributor.CommitsByDay)
+		contributor.TopFiles = topFilePaths(fileCounts[key], 5)
+		contributors = append(contributors, *contributor)
+	}
+
+	sort.SliceStable(contributors, func(i, j int) bool {
+		return contributors[i].TotalCommits > contributors[j].TotalCommits
+	})
+
+	return contributors
+}
+
+// topFilePaths returns up to limit paths with the highest counts
+func topFilePaths(counts map[string]int, limit int) []string {
+	paths := make([]string, 0, len(counts))
+	for path := range counts {
+		paths = append(paths, path)
+	}
+
+	sort.Slice(paths, func(i, j int) bool {
+		if counts[paths[i]] != counts[paths[j]] {
+			return counts[paths[i]] > counts[paths[j]]
+		}
+		return paths[i] < paths[j]
+	})
+
+	if len(paths) > limit {
+		paths = paths[:limit]
+	}
+	return paths
+}
+
 // filterCommits applies configuration filters to commits
 func (sa *StatisticsAnalyzerImpl) filterCommits(commits []models.Commit, config models.AnalysisConfig) []models.Commit {
 	var filtered []models.Commit
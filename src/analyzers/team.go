@@ -0,0 +1,112 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Team statistics analysis
+
+package analyzers
+
+import (
+	"git-stats/models"
+	"sort"
+	"strings"
+)
+
+// TeamAnalyzerImpl rolls commit activity up by team
+type TeamAnalyzerImpl struct{}
+
+// NewTeamAnalyzer creates a new team analyzer
+func NewTeamAnalyzer() *TeamAnalyzerImpl {
+	return &TeamAnalyzerImpl{}
+}
+
+// TeamCommits returns the commits that count for the team named name: those whose author was a
+// member on the commit's day. models.NoTeam selects the commits of authors outside every team
+func TeamCommits(commits []models.Commit, teams models.Teams, name string) []models.Commit {
+	if team := teams.Find(name); team != nil {
+		name = team.Name
+	}
+
+	var result []models.Commit
+	for _, commit := range commits {
+		if teams.TeamOf(commit.Author.Email, commit.AuthorDate) == name {
+			result = append(result, commit)
+		}
+	}
+	return result
+}
+
+// AnalyzeTeams rolls up the commits of each team, authors outside every team under
+// models.NoTeam. A team owns the changed files it made the most commits to; ties go to the team
+// first in alphabetical order. The result is sorted by commits, most first
+func (ta *TeamAnalyzerImpl) AnalyzeTeams(commits []models.Commit, teams models.Teams) []models.TeamStats {
+	byName := make(map[string]*models.TeamStats)
+	members := make(map[string]map[string]bool)
+	days := make(map[string]map[string]bool)
+	files := make(map[string]map[string]bool)
+	fileCommits := make(map[string]map[string]int) // file -> team -> commits
+
+	for _, commit := range commits {
+		name := teams.TeamOf(commit.Author.Email, commit.AuthorDate)
+		stats := byName[name]
+		if stats == nil {
+			stats = &models.TeamStats{Name: name}
+			byName[name] = stats
+			members[name] = make(map[string]bool)
+			days[name] = make(map[string]bool)
+			files[name] = make(map[string]bool)
+		}
+
+		stats.Commits++
+		members[name][strings.ToLower(commit.Author.Email)] = true
+		days[name][commit.AuthorDate.Format("2006-01-02")] = true
+		if stats.FirstCommit.IsZero() || commit.AuthorDate.Before(stats.FirstCommit) {
+			stats.FirstCommit = commit.AuthorDate
+		}
+		if commit.AuthorDate.After(stats.LastCommit) {
+			stats.LastCommit = commit.AuthorDate
+		}
+
+		for _, file := range commit.Stats.Files {
+			stats.Insertions += file.Insertions
+			stats.Deletions += file.Deletions
+
+			filePath := renamedPath(file.Path)
+			if filePath == "" {
+				continue
+			}
+			files[name][filePath] = true
+			if fileCommits[filePath] == nil {
+				fileCommits[filePath] = make(map[string]int)
+			}
+			fileCommits[filePath][name]++
+		}
+	}
+
+	for _, byTeam := range fileCommits {
+		owner, best := "", 0
+		for name, count := range byTeam {
+			if count > best || (count == best && name < owner) {
+				owner, best = name, count
+			}
+		}
+		byName[owner].OwnedFiles++
+	}
+
+	result := make([]models.TeamStats, 0, len(byName))
+	for name, stats := range byName {
+		stats.Members = len(members[name])
+		stats.ActiveDays = len(days[name])
+		stats.Files = len(files[name])
+		stats.CommitShare = float64(stats.Commits) / float64(len(commits)) * 100
+		if len(fileCommits) > 0 {
+			stats.Ownership = float64(stats.OwnedFiles) / float64(len(fileCommits)) * 100
+		}
+		result = append(result, *stats)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Commits != result[j].Commits {
+			return result[i].Commits > result[j].Commits
+		}
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
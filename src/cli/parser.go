@@ -7,6 +7,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -14,19 +15,94 @@ import (
 
 // Config represents the configuration for the git-stats tool
 type Config struct {
-	Command      string     // contrib, summary, contributors, health, gui
+	Command      string     // contrib, summary, contributors, files, health, compare, graph, punchcard, growth, gui
 	Since        *time.Time // --since flag
 	Until        *time.Time // --until flag
 	Author       string     // --author flag
-	Format       string     // json, csv, terminal
+	Format       string     // json, csv, xlsx, terminal, template
 	OutputFile   string     // --output flag
 	RepoPath     string     // repository path
 	ShowProgress bool       // --progress flag
 	Limit        int        // --limit flag for large repos
 	GUIMode      bool       // --gui flag for ncurses interface
 	ShowHelp     bool       // --help flag
-	NoColor      bool       // --no-color flag to disable colors
-	ColorTheme   string     // --theme flag for color theme (github, blue, fire)
+	NoColor      bool       // --no-color flag to disable colors, the same as --color never
+	Color        string     // --color flag: auto, always or never
+	ColorTheme   string     // --theme flag for color theme; empty uses the configuration file's theme
+	Template     string     // --template flag (built-in name or file) for template format
+	CSVDialect   string     // --csv-dialect flag (default, rfc4180, excel)
+	CSVSplit     bool       // --csv-split flag to write one CSV file per table
+	Compare      []string   // --compare flag: the contributors to compare
+	CollapseRun  int        // --collapse flag: shortest run of linear commits the graph collapses
+	ASCII        bool       // --ascii flag to draw the commit graph with ASCII characters
+	FromYear     int        // --year/--years flags: first calendar year, 0 when unset
+	ToYear       int        // --year/--years flags: last calendar year, 0 when unset
+	Metric       string     // --metric flag: what the contribution graph counts per day
+	Levels       string     // --levels flag: activity level strategy (linear, quantile, log, fixed)
+	Thresholds   []int      // --levels flag: thresholds of the fixed strategy
+	NoPager      bool       // --no-pager flag to print tables instead of paging through them
+	ByAuthor     string     // --by-author flag: per-author contribution graph mode (dominant, multiples)
+	TopAuthors   int        // --top flag: authors with their own color or calendar in -by-author graphs
+	Interval     string     // --interval flag: period of the -growth series (day, week, month)
+	Breakdown    string     // --breakdown flag: -growth series per extension or directory
+	Exclude      []string   // --exclude flag: path classes (generated, vendored, lockfiles) and globs left out of -growth
+	ByDirectory  bool       // --by-directory flag: -files statistics per directory or module
+	Depth        int        // --depth flag: path segments of the -by-directory directories
+	Modules      bool       // --modules flag: group -by-directory by the module roots of go.mod, package.json and Cargo.toml
+	Team         string     // --team flag: only the commits of a team from the team definition file
+	ByTeam       bool       // --by-team flag: add team rollups to the report
+}
+
+// HasYears reports whether -year or -years selected calendar years
+func (c *Config) HasYears() bool {
+	return c.FromYear > 0
+}
+
+// SplitsCSVOutput reports whether CSV output goes to a directory or .zip of separate tables. In a
+// multi-format run the tables go to the -output path itself, beside the other formats' files
+func (c *Config) SplitsCSVOutput() bool {
+	if !containsString(c.Formats(), "csv") || c.OutputFile == "" {
+		return false
+	}
+
+	if c.CSVSplit || IsZipOutput(c.OutputFile) || strings.HasSuffix(c.OutputFile, "/") ||
+		strings.HasSuffix(c.OutputFile, string(os.PathSeparator)) {
+		return true
+	}
+
+	info, err := os.Stat(c.OutputFile)
+	return err == nil && info.IsDir()
+}
+
+// Formats returns the requested output formats; -format accepts a comma-separated list
+func (c *Config) Formats() []string {
+	var formats []string
+	for _, format := range strings.Split(c.Format, ",") {
+		if format = strings.TrimSpace(format); format != "" {
+			formats = append(formats, format)
+		}
+	}
+	return formats
+}
+
+// MultiFormatOutputPath derives the file for one format of a multi-format run from the -output base path
+func MultiFormatOutputPath(base, format string) string {
+	extensions := map[string]string{
+		"json":     ".json",
+		"csv":      ".csv",
+		"xlsx":     ".xlsx",
+		"terminal": ".txt",
+		"template": ".template.txt",
+		"svg":      ".svg",
+	}
+
+	stem := strings.TrimSuffix(base, filepath.Ext(base))
+	return stem + extensions[format]
+}
+
+// IsZipOutput reports whether the output path names a zip archive
+func IsZipOutput(path string) bool {
+	return strings.HasSuffix(strings.ToLower(path), ".zip")
 }
 
 // Parser interface for command line parsing
@@ -52,9 +128,15 @@ func NewCLIParser(validator Validator) *CLIParser {
 // Parse parses command line arguments and returns a Config
 func (p *CLIParser) Parse(args []string) (*Config, error) {
 	config := &Config{
-		Format:   "terminal", // default format
-		RepoPath: ".",        // default to current directory
-		Limit:    10000,      // default limit
+		Format:      "terminal", // default format
+		RepoPath:    ".",        // default to current directory
+		Limit:       10000,      // default limit
+		CollapseRun: 5,          // default collapsed run length
+		Metric:      "commits",  // default contribution metric
+		Levels:      "linear",   // default activity level strategy
+		Color:       "auto",     // default color mode
+		TopAuthors:  5,          // default authors of per-author graphs
+		Depth:       1,          // default directory depth
 	}
 
 	// Create a new flag set to avoid conflicts with global flags
@@ -68,19 +150,45 @@ func (p *CLIParser) Parse(args []string) (*Config, error) {
 		contrib      = fs.Bool("contrib", false, "Show git contribution graph (GitHub-style)")
 		summary      = fs.Bool("summary", false, "Show detailed repository statistics")
 		contributors = fs.Bool("contributors", false, "Show contributor statistics")
+		files        = fs.Bool("files", false, "Show file and file type statistics")
 		health       = fs.Bool("health", false, "Show repository health metrics")
+		compare      = fs.String("compare", "", "Compare contributors side by side (comma-separated names or emails)")
+		graph        = fs.Bool("graph", false, "Show the commit graph with branches and merges")
+		punchcard    = fs.Bool("punchcard", false, "Show commits by hour and weekday as a punch card")
+		growth       = fs.Bool("growth", false, "Show the net lines of code over time")
 		gui          = fs.Bool("gui", false, "Launch interactive ncurses GUI")
 		since        = fs.String("since", "", "Show commits since date (YYYY-MM-DD or relative like '1 week ago')")
 		until        = fs.String("until", "", "Show commits until date (YYYY-MM-DD or relative like '1 week ago')")
 		author       = fs.String("author", "", "Filter commits by author (name or email, supports partial matching)")
-		format       = fs.String("format", "terminal", "Output format: terminal, json, csv")
+		team         = fs.String("team", "", "Filter commits by team from the team definition file")
+		format       = fs.String("format", "terminal", "Output format: terminal, json, csv, xlsx, template, svg")
+		tmpl         = fs.String("template", "", "Template for -format template: built-in name or path to a Go text/template file")
+		csvDialect   = fs.String("csv-dialect", "default", "CSV dialect: default, rfc4180 (strict), excel (BOM, semicolons)")
+		csvSplit     = fs.Bool("csv-split", false, "Write each CSV table to its own file in the -output directory")
 		output       = fs.String("output", "", "Output file path (default: stdout)")
 		progress     = fs.Bool("progress", false, "Show progress indicators for long operations")
 		limit        = fs.Int("limit", 10000, "Limit number of commits to process (for large repositories)")
 		help         = fs.Bool("help", false, "Show help information")
 		h            = fs.Bool("h", false, "Show help information (short form)")
-		noColor      = fs.Bool("no-color", false, "Disable colored output")
-		colorTheme   = fs.String("theme", "github", "Color theme for contribution graph: github, blue, fire")
+		noColor      = fs.Bool("no-color", false, "Disable colored output (same as -color never)")
+		color        = fs.String("color", "auto", "Colored output: auto (terminals only, honors NO_COLOR and CLICOLOR_FORCE), always, never")
+		colorTheme   = fs.String("theme", "", "Color theme for contribution graph: github, blue, fire, mono, viridis, cividis, or one from the config file")
+		collapse     = fs.Int("collapse", 5, "Collapse runs of at least this many linear commits in -graph (0 shows every commit)")
+		ascii        = fs.Bool("ascii", false, "Draw the -graph lanes with ASCII instead of box-drawing characters")
+		year         = fs.Int("year", 0, "Analyze one calendar year, e.g. 2023")
+		years        = fs.String("years", "", "Analyze a range of calendar years, e.g. 2021-2024 (one calendar per year)")
+		metric       = fs.String("metric", "commits", "Contribution graph metric: commits, lines, insertions, deletions, files, authors")
+		levels       = fs.String("levels", "linear", "Activity levels: linear, quantile, log, or fixed thresholds like 3,9,19")
+		byAuthor     = fs.String("by-author", "", "Per-author contribution graph: dominant (days colored by their top author) or multiples (one calendar per author)")
+		top          = fs.Int("top", 5, "Authors shown with their own color or calendar in -by-author graphs")
+		interval     = fs.String("interval", "", "Period of the -growth series: day, week or month (default week)")
+		breakdown    = fs.String("breakdown", "", "Break the -growth series down by extension or directory")
+		exclude      = fs.String("exclude", "", "Leave paths out of -growth: generated, vendored, lockfiles or globs, comma-separated")
+		byDirectory  = fs.Bool("by-directory", false, "Show -files statistics per directory or module")
+		depth        = fs.Int("depth", 1, "Path segments of the -by-directory directories")
+		modules      = fs.Bool("modules", false, "Group -by-directory by module roots (go.mod, package.json, Cargo.toml)")
+		byTeam       = fs.Bool("by-team", false, "Add team rollups: commits, churn, active days, ownership and per-team contribution graphs")
+		noPager      = fs.Bool("no-pager", false, "Print -contributors and -files tables instead of opening the pager on a terminal")
 	)
 
 	// Parse arguments
@@ -111,10 +219,31 @@ func (p *CLIParser) Parse(args []string) (*Config, error) {
 		config.Command = "contributors"
 		commandCount++
 	}
+	if *files {
+		config.Command = "files"
+		commandCount++
+	}
 	if *health {
 		config.Command = "health"
 		commandCount++
 	}
+	if *compare != "" {
+		config.Command = "compare"
+		config.Compare = parseList(*compare)
+		commandCount++
+	}
+	if *graph {
+		config.Command = "graph"
+		commandCount++
+	}
+	if *punchcard {
+		config.Command = "punchcard"
+		commandCount++
+	}
+	if *growth {
+		config.Command = "growth"
+		commandCount++
+	}
 	if *gui {
 		config.GUIMode = true
 		if config.Command == "" {
@@ -130,6 +259,24 @@ func (p *CLIParser) Parse(args []string) (*Config, error) {
 		return nil, fmt.Errorf("only one command can be specified at a time")
 	}
 
+	// Calendar years replace -since and -until
+	if *year != 0 || *years != "" {
+		if *year != 0 && *years != "" {
+			return nil, fmt.Errorf("-year and -years cannot be used together")
+		}
+		if *since != "" || *until != "" {
+			return nil, fmt.Errorf("-year and -years cannot be combined with -since or -until")
+		}
+
+		config.FromYear, config.ToYear = *year, *year
+		if *years != "" {
+			if config.FromYear, config.ToYear, err = parseYearRange(*years); err != nil {
+				return nil, err
+			}
+		}
+		config.Since, config.Until = yearRange(config.FromYear, config.ToYear)
+	}
+
 	// Parse date flags
 	if *since != "" {
 		sinceTime, err := parseDate(*since)
@@ -149,12 +296,36 @@ func (p *CLIParser) Parse(args []string) (*Config, error) {
 
 	// Set other configuration values
 	config.Author = strings.TrimSpace(*author)
-	config.Format = strings.ToLower(strings.TrimSpace(*format))
+	config.Format = strings.ToLower(strings.Join(strings.Fields(*format), ""))
 	config.OutputFile = strings.TrimSpace(*output)
 	config.ShowProgress = *progress
 	config.Limit = *limit
 	config.NoColor = *noColor
+	config.Color = strings.ToLower(strings.TrimSpace(*color))
+	if config.NoColor {
+		config.Color = "never"
+	}
 	config.ColorTheme = strings.ToLower(strings.TrimSpace(*colorTheme))
+	config.Template = strings.TrimSpace(*tmpl)
+	config.CSVDialect = strings.ToLower(strings.TrimSpace(*csvDialect))
+	config.CSVSplit = *csvSplit
+	config.CollapseRun = *collapse
+	config.ASCII = *ascii
+	config.NoPager = *noPager
+	config.ByAuthor = strings.ToLower(strings.TrimSpace(*byAuthor))
+	config.TopAuthors = *top
+	config.Interval = strings.ToLower(strings.TrimSpace(*interval))
+	config.Breakdown = strings.ToLower(strings.TrimSpace(*breakdown))
+	config.Exclude = parseList(*exclude)
+	config.ByDirectory = *byDirectory
+	config.Depth = *depth
+	config.Modules = *modules
+	config.Team = strings.TrimSpace(*team)
+	config.ByTeam = *byTeam
+	config.Metric = strings.ToLower(strings.TrimSpace(*metric))
+	if config.Levels, config.Thresholds, err = parseLevels(*levels); err != nil {
+		return nil, err
+	}
 
 	// Get repository path from remaining arguments or use current directory
 	remainingArgs := fs.Args()
@@ -172,6 +343,68 @@ func (p *CLIParser) Parse(args []string) (*Config, error) {
 	return config, nil
 }
 
+// parseList splits a comma-separated list, such as the contributors of -compare, dropping empty entries
+func parseList(list string) []string {
+	var selectors []string
+	for _, selector := range strings.Split(list, ",") {
+		if selector = strings.TrimSpace(selector); selector != "" {
+			selectors = append(selectors, selector)
+		}
+	}
+	return selectors
+}
+
+// parseLevels parses an activity level strategy, or comma-separated thresholds that select
+// the fixed strategy
+func parseLevels(levels string) (string, []int, error) {
+	levels = strings.ToLower(strings.TrimSpace(levels))
+	if levels == "" || !strings.ContainsAny(levels, "0123456789") {
+		return levels, nil, nil
+	}
+
+	var thresholds []int
+	for _, part := range strings.Split(levels, ",") {
+		threshold, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid levels '%s': expected a strategy or thresholds like 3,9,19", levels)
+		}
+		thresholds = append(thresholds, threshold)
+	}
+	return "fixed", thresholds, nil
+}
+
+// parseYearRange parses a year range like "2021-2024"; a single year is a range of one
+func parseYearRange(years string) (int, int, error) {
+	parts := strings.Split(strings.TrimSpace(years), "-")
+	if len(parts) > 2 {
+		return 0, 0, fmt.Errorf("invalid year range '%s': expected YYYY or YYYY-YYYY", years)
+	}
+
+	from, err := strconv.Atoi(strings.TrimSpace(parts[0]))
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid year range '%s': expected YYYY or YYYY-YYYY", years)
+	}
+	to := from
+	if len(parts) == 2 {
+		if to, err = strconv.Atoi(strings.TrimSpace(parts[1])); err != nil {
+			return 0, 0, fmt.Errorf("invalid year range '%s': expected YYYY or YYYY-YYYY", years)
+		}
+	}
+
+	return from, to, nil
+}
+
+// yearRange returns the first and last instant of the calendar years from to to; the current
+// year ends now
+func yearRange(from, to int) (*time.Time, *time.Time) {
+	since := time.Date(from, time.January, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(to+1, time.January, 1, 0, 0, 0, 0, time.UTC).Add(-time.Second)
+	if now := time.Now(); until.After(now) {
+		until = now
+	}
+	return &since, &until
+}
+
 // parseDate parses various date formats
 func parseDate(dateStr string) (*time.Time, error) {
 	dateStr = strings.TrimSpace(dateStr)
@@ -261,15 +494,52 @@ func (p *CLIParser) PrintUsage() {
 	fmt.Fprintf(os.Stderr, "  -contrib         Show git contribution graph (GitHub-style) [default]\n")
 	fmt.Fprintf(os.Stderr, "  -summary         Show detailed repository statistics\n")
 	fmt.Fprintf(os.Stderr, "  -contributors    Show contributor statistics\n")
+	fmt.Fprintf(os.Stderr, "  -files           Show file and file type statistics\n")
 	fmt.Fprintf(os.Stderr, "  -health          Show repository health metrics\n")
+	fmt.Fprintf(os.Stderr, "  -compare <a,b>   Compare two or more contributors side by side\n")
+	fmt.Fprintf(os.Stderr, "  -graph           Show the commit graph with branches, merges and tags\n")
+	fmt.Fprintf(os.Stderr, "  -punchcard       Show commits by hour of day and weekday\n")
+	fmt.Fprintf(os.Stderr, "  -growth          Show the net lines of code over time\n")
 	fmt.Fprintf(os.Stderr, "  -gui             Launch interactive ncurses GUI\n\n")
 	fmt.Fprintf(os.Stderr, "Filtering Options:\n")
 	fmt.Fprintf(os.Stderr, "  -since <date>    Show commits since date (YYYY-MM-DD or relative)\n")
 	fmt.Fprintf(os.Stderr, "  -until <date>    Show commits until date (YYYY-MM-DD or relative)\n")
-	fmt.Fprintf(os.Stderr, "  -author <name>   Filter commits by author (supports partial matching)\n\n")
+	fmt.Fprintf(os.Stderr, "  -year <yyyy>     Show one calendar year (instead of -since/-until)\n")
+	fmt.Fprintf(os.Stderr, "  -years <a-b>     Show calendar years a to b, one contribution calendar per year\n")
+	fmt.Fprintf(os.Stderr, "  -author <name>   Filter commits by author (supports partial matching)\n")
+	fmt.Fprintf(os.Stderr, "  -team <name>     Filter commits by team from the team definition file\n\n")
 	fmt.Fprintf(os.Stderr, "Output Options:\n")
-	fmt.Fprintf(os.Stderr, "  -format <fmt>    Output format: terminal, json, csv [default: terminal]\n")
+	fmt.Fprintf(os.Stderr, "  -format <fmt>    Output format: terminal, json, csv, xlsx, template, svg [default: terminal]\n")
+	fmt.Fprintf(os.Stderr, "                   Combine formats with commas, e.g. json,terminal,csv (requires -output)\n")
+	fmt.Fprintf(os.Stderr, "  -template <t>    Template for -format template (built-in name or .tmpl file)\n")
 	fmt.Fprintf(os.Stderr, "  -output <file>   Output file path [default: stdout]\n")
+	fmt.Fprintf(os.Stderr, "  -csv-split       Write one CSV per table into the -output directory\n")
+	fmt.Fprintf(os.Stderr, "                   (implied when -output is a directory or ends in .zip)\n")
+	fmt.Fprintf(os.Stderr, "  -csv-dialect <d> CSV dialect: default, rfc4180, excel [default: default]\n")
+	fmt.Fprintf(os.Stderr, "  -metric <m>      Contribution graph metric: commits, lines, insertions, deletions,\n")
+	fmt.Fprintf(os.Stderr, "                   files, authors [default: commits]\n")
+	fmt.Fprintf(os.Stderr, "  -levels <l>      Activity levels: linear, quantile, log, or thresholds like 3,9,19\n")
+	fmt.Fprintf(os.Stderr, "                   [default: linear]\n")
+	fmt.Fprintf(os.Stderr, "  -by-author <m>   Per-author contribution graph: dominant colors each day by its top\n")
+	fmt.Fprintf(os.Stderr, "                   author, multiples draws one calendar per author\n")
+	fmt.Fprintf(os.Stderr, "  -top <n>         Authors with their own color or calendar in -by-author graphs [default: 5]\n")
+	fmt.Fprintf(os.Stderr, "  -interval <i>    Period of the -growth series: day, week, month [default: week]\n")
+	fmt.Fprintf(os.Stderr, "  -breakdown <b>   Break the -growth series down by extension or directory\n")
+	fmt.Fprintf(os.Stderr, "  -exclude <list>  Leave paths out of -growth: generated, vendored, lockfiles or globs\n")
+	fmt.Fprintf(os.Stderr, "                   like docs/ or *.svg, comma-separated\n")
+	fmt.Fprintf(os.Stderr, "  -by-directory    Show -files statistics per directory: commits, churn, authors, trend\n")
+	fmt.Fprintf(os.Stderr, "  -depth <n>       Path segments of the -by-directory directories [default: 1]\n")
+	fmt.Fprintf(os.Stderr, "  -modules         Group -by-directory by module roots (go.mod, package.json, Cargo.toml)\n")
+	fmt.Fprintf(os.Stderr, "  -by-team         Add team rollups to the report: commits, churn, active days, ownership;\n")
+	fmt.Fprintf(os.Stderr, "                   -contrib draws one calendar per team\n")
+	fmt.Fprintf(os.Stderr, "  -collapse <n>    Collapse runs of n or more linear commits in -graph [default: 5, 0: off]\n")
+	fmt.Fprintf(os.Stderr, "  -ascii           Draw -graph lanes with ASCII characters\n")
+	fmt.Fprintf(os.Stderr, "  -color <when>    Colored output: auto, always, never [default: auto]\n")
+	fmt.Fprintf(os.Stderr, "                   auto colors terminals only and honors NO_COLOR and CLICOLOR_FORCE\n")
+	fmt.Fprintf(os.Stderr, "  -no-color        Disable colored output (same as -color never)\n")
+	fmt.Fprintf(os.Stderr, "  -theme <name>    Contribution graph colors: github, blue, fire, mono, viridis, cividis\n")
+	fmt.Fprintf(os.Stderr, "                   or a theme from the configuration file\n")
+	fmt.Fprintf(os.Stderr, "  -no-pager        Print -contributors and -files tables instead of paging through them\n")
 	fmt.Fprintf(os.Stderr, "  -progress        Show progress indicators for long operations\n\n")
 	fmt.Fprintf(os.Stderr, "Performance Options:\n")
 	fmt.Fprintf(os.Stderr, "  -limit <n>       Limit number of commits to process [default: 10000]\n\n")
@@ -290,14 +560,56 @@ func (p *CLIParser) PrintHelp() {
 	fmt.Fprintf(os.Stderr, "  Date Filtering:\n")
 	fmt.Fprintf(os.Stderr, "    git-stats -contrib -since \"2024-01-01\"       # Show contributions since Jan 1, 2024\n")
 	fmt.Fprintf(os.Stderr, "    git-stats -summary -since \"1 month ago\"       # Show stats for last month\n")
-	fmt.Fprintf(os.Stderr, "    git-stats -health -since \"yesterday\" -until \"today\"  # Show health for yesterday\n\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -health -since \"yesterday\" -until \"today\"  # Show health for yesterday\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -contrib -year 2023                # Contribution calendar for 2023\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -contrib -years 2021-2024          # One calendar per year with totals and streaks\n\n")
+	fmt.Fprintf(os.Stderr, "  Contribution Metrics:\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -contrib -metric lines             # Shade days by lines changed\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -contrib -metric authors -years 2022-2024  # Active authors per day\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -contrib -levels quantile          # Levels from quartiles of active days\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -contrib -levels 1,5,10            # Fixed levels: 1, 2-5, 6-10, 11+\n\n")
+	fmt.Fprintf(os.Stderr, "  Contributions by Author:\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -contrib -by-author dominant       # Color each day by its busiest author\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -contrib -by-author multiples -top 3  # One small calendar per top author\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -contrib -by-author dominant -format svg -output team.svg  # As an SVG image\n\n")
 	fmt.Fprintf(os.Stderr, "  Author Filtering:\n")
 	fmt.Fprintf(os.Stderr, "    git-stats -contributors -author \"john\"        # Show stats for authors matching 'john'\n")
 	fmt.Fprintf(os.Stderr, "    git-stats -contrib -author \"john@example.com\" # Filter by email\n\n")
+	fmt.Fprintf(os.Stderr, "  Contributor Comparison:\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -compare alice,bob                 # Heatmaps, activity and shared files side by side\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -compare alice@example.com,bob -format json  # Comparison as JSON\n\n")
+	fmt.Fprintf(os.Stderr, "  Commit Graph:\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -graph -since \"1 month ago\"        # Branches and merges like git log --graph\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -graph -collapse 0 -ascii          # Every commit, ASCII lanes\n\n")
+	fmt.Fprintf(os.Stderr, "  Table Pager:\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -contributors                      # On a terminal, page, sort and filter the table\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -files -no-pager                   # Print the file statistics report instead\n\n")
+	fmt.Fprintf(os.Stderr, "  Directories and Modules:\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -files -by-directory -depth 2      # Commits, churn and authors per directory\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -files -by-directory -modules -format json  # Per go.mod, package.json or Cargo.toml module\n\n")
+	fmt.Fprintf(os.Stderr, "  Teams (\"teams\" in the configuration file names the team definition file):\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -contributors -by-team             # Commits, churn and ownership per team\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -contrib -by-team -by-author dominant  # Days colored by their busiest team\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -summary -team platform -format json   # Only the platform team's commits\n\n")
+	fmt.Fprintf(os.Stderr, "  Punch Card:\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -punchcard                         # Commits by hour and weekday, overall and per author\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -punchcard -format svg -output punchcard.svg  # Punch card as an SVG image\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -contrib -format svg -output contrib.svg      # Contribution graph as an SVG image\n\n")
+	fmt.Fprintf(os.Stderr, "  Code Growth:\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -growth                            # Net lines of code per week since the first commit\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -growth -interval month -breakdown directory  # Monthly, per top-level directory\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -growth -exclude generated,vendored,lockfiles -format csv  # Without generated code\n\n")
 	fmt.Fprintf(os.Stderr, "  Output Formats:\n")
 	fmt.Fprintf(os.Stderr, "    git-stats -summary -format json              # Output as JSON\n")
 	fmt.Fprintf(os.Stderr, "    git-stats -contributors -format csv          # Output as CSV\n")
-	fmt.Fprintf(os.Stderr, "    git-stats -summary -format json -output report.json  # Save to file\n\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -summary -format json -output report.json  # Save to file\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -summary -format xlsx -output report.xlsx  # Excel workbook\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -summary -format terminal -output report.txt  # Plain-text report\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -summary -format json,terminal,csv -output report  # report.json, report.txt, report.csv\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -summary -format csv -output tables/ # One CSV per table plus manifest\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -summary -format csv -csv-dialect excel -output report.zip  # Zipped, Excel-friendly\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -summary -format template -template slack  # Built-in template\n")
+	fmt.Fprintf(os.Stderr, "    git-stats -summary -format template -template status.tmpl  # Custom template\n\n")
 	fmt.Fprintf(os.Stderr, "  Advanced Options:\n")
 	fmt.Fprintf(os.Stderr, "    git-stats -summary -progress -limit 5000     # Show progress, limit commits\n")
 	fmt.Fprintf(os.Stderr, "    git-stats -contrib /path/to/repo              # Analyze specific repository\n")
@@ -305,6 +617,11 @@ func (p *CLIParser) PrintHelp() {
 	fmt.Fprintf(os.Stderr, "Date Formats:\n")
 	fmt.Fprintf(os.Stderr, "  Absolute: 2024-01-15, 2024-01-15 14:30:00, 01/15/2024, 15-01-2024\n")
 	fmt.Fprintf(os.Stderr, "  Relative: today, yesterday, 1 week ago, 2 months ago, 1 year ago\n\n")
+	fmt.Fprintf(os.Stderr, "Templates:\n")
+	fmt.Fprintf(os.Stderr, "  Built-in: oneline, statusbar, slack, markdown\n")
+	fmt.Fprintf(os.Stderr, "  Custom templates use Go text/template syntax against the analysis result\n")
+	fmt.Fprintf(os.Stderr, "  Functions: humanizeDuration, percent, padLeft, padRight, truncate, sortContributors,\n")
+	fmt.Fprintf(os.Stderr, "             topN, formatDate, add, sub, join, upper, lower, repeat\n\n")
 	fmt.Fprintf(os.Stderr, "Author Matching:\n")
 	fmt.Fprintf(os.Stderr, "  Supports partial name matching and email matching\n")
 	fmt.Fprintf(os.Stderr, "  Examples: \"john\", \"john@example.com\", \"John Doe\"\n\n")
@@ -334,12 +651,42 @@ func (p *CLIParser) PrintErrorWithSuggestion(err error) {
 		fmt.Fprintf(os.Stderr, "  - Relative: today, yesterday, 1 week ago, 2 months ago\n")
 		fmt.Fprintf(os.Stderr, "Example: git-stats -since \"2024-01-01\" -until \"2024-12-31\"\n\n")
 	} else if strings.Contains(errorMsg, "invalid format") {
-		fmt.Fprintf(os.Stderr, "Suggestion: Use one of the supported output formats: terminal, json, csv\n")
+		fmt.Fprintf(os.Stderr, "Suggestion: Use one of the supported output formats: terminal, json, csv, xlsx, template, svg\n")
 		fmt.Fprintf(os.Stderr, "Example: git-stats -format json\n\n")
+	} else if strings.Contains(errorMsg, "template") {
+		fmt.Fprintf(os.Stderr, "Suggestion: Pass a built-in template name or a template file with -template.\n")
+		fmt.Fprintf(os.Stderr, "Example: git-stats -summary -format template -template oneline\n\n")
 	} else if strings.Contains(errorMsg, "only one command can be specified") {
 		fmt.Fprintf(os.Stderr, "Suggestion: Choose only one command at a time:\n")
-		fmt.Fprintf(os.Stderr, "  -contrib, -summary, -contributors, or -health\n")
+		fmt.Fprintf(os.Stderr, "  -contrib, -summary, -contributors, -files, -health, -compare, -graph, -punchcard, or -growth\n")
 		fmt.Fprintf(os.Stderr, "Example: git-stats -summary (not git-stats -summary -contrib)\n\n")
+	} else if strings.Contains(errorMsg, "-compare") {
+		fmt.Fprintf(os.Stderr, "Suggestion: List at least two contributors by name or email, separated by commas.\n")
+		fmt.Fprintf(os.Stderr, "Example: git-stats -compare \"alice,bob@example.com\"\n\n")
+	} else if strings.Contains(errorMsg, "-by-author") || strings.Contains(errorMsg, "-top") {
+		fmt.Fprintf(os.Stderr, "Suggestion: Use -by-author dominant or multiples with -contrib, and -top between 1 and 9.\n")
+		fmt.Fprintf(os.Stderr, "Example: git-stats -contrib -by-author multiples -top 3\n\n")
+	} else if strings.Contains(errorMsg, "-interval") || strings.Contains(errorMsg, "-breakdown") || strings.Contains(errorMsg, "-exclude") {
+		fmt.Fprintf(os.Stderr, "Suggestion: Use -interval day, week or month, -breakdown extension or directory, and -exclude with -growth.\n")
+		fmt.Fprintf(os.Stderr, "Example: git-stats -growth -interval month -exclude vendored,lockfiles\n\n")
+	} else if strings.Contains(errorMsg, "-by-directory") || strings.Contains(errorMsg, "-depth") || strings.Contains(errorMsg, "-modules") {
+		fmt.Fprintf(os.Stderr, "Suggestion: Use -by-directory with -files, optionally with -depth 1 or more and -modules.\n")
+		fmt.Fprintf(os.Stderr, "Example: git-stats -files -by-directory -depth 2\n\n")
+	} else if strings.Contains(errorMsg, "-team") || strings.Contains(errorMsg, "-by-team") {
+		fmt.Fprintf(os.Stderr, "Suggestion: Define teams in the file named by \"teams\" in the configuration file.\n")
+		fmt.Fprintf(os.Stderr, "Example: git-stats -contributors -by-team\n\n")
+	} else if strings.Contains(errorMsg, "-year") || strings.Contains(errorMsg, "year range") {
+		fmt.Fprintf(os.Stderr, "Suggestion: Use -year YYYY or -years YYYY-YYYY instead of -since and -until.\n")
+		fmt.Fprintf(os.Stderr, "Example: git-stats -contrib -years 2021-2024\n\n")
+	} else if strings.Contains(errorMsg, "invalid color mode") {
+		fmt.Fprintf(os.Stderr, "Suggestion: Use -color auto, always or never.\n")
+		fmt.Fprintf(os.Stderr, "Example: git-stats -summary -color always | less -R\n\n")
+	} else if strings.Contains(errorMsg, "invalid metric") {
+		fmt.Fprintf(os.Stderr, "Suggestion: Use one of the contribution metrics: commits, lines, insertions, deletions, files, authors\n")
+		fmt.Fprintf(os.Stderr, "Example: git-stats -contrib -metric lines\n\n")
+	} else if strings.Contains(errorMsg, "levels") {
+		fmt.Fprintf(os.Stderr, "Suggestion: Use -levels linear, quantile or log, or three ascending thresholds.\n")
+		fmt.Fprintf(os.Stderr, "Example: git-stats -contrib -levels 3,9,19\n\n")
 	} else if strings.Contains(errorMsg, "limit must be greater than 0") {
 		fmt.Fprintf(os.Stderr, "Suggestion: Use a positive number for the limit option.\n")
 		fmt.Fprintf(os.Stderr, "Example: git-stats -limit 5000\n\n")
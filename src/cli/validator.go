@@ -5,7 +5,9 @@ package cli
 
 import (
 	"fmt"
+	"git-stats/models"
 	"os"
+	"path"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -21,6 +23,18 @@ type Validator interface {
 	ValidateOutputFile(filename string) error
 	ValidateRepositoryPath(path string) error
 	ValidateLimit(limit int) error
+	ValidateTemplate(format, template string) error
+	ValidateCSVOptions(config *Config) error
+	ValidateCompare(config *Config) error
+	ValidateGraph(config *Config) error
+	ValidateYears(config *Config) error
+	ValidateMetric(metric string) error
+	ValidateColorMode(mode string) error
+	ValidateLevels(config *Config) error
+	ValidateByAuthor(config *Config) error
+	ValidateGrowth(config *Config) error
+	ValidateByDirectory(config *Config) error
+	ValidateTeam(config *Config) error
 }
 
 // CLIValidator implements the Validator interface
@@ -47,11 +61,41 @@ func (v *CLIValidator) ValidateConfig(config *Config) error {
 		return err
 	}
 
+	// Validate calendar years before the date range they set
+	if err := v.ValidateYears(config); err != nil {
+		return err
+	}
+
 	// Validate date range
 	if err := v.ValidateDateRange(config.Since, config.Until); err != nil {
 		return err
 	}
 
+	// Validate contribution metric and activity levels
+	if err := v.ValidateMetric(config.Metric); err != nil {
+		return err
+	}
+	if err := v.ValidateLevels(config); err != nil {
+		return err
+	}
+	if err := v.ValidateByAuthor(config); err != nil {
+		return err
+	}
+	if err := v.ValidateGrowth(config); err != nil {
+		return err
+	}
+	if err := v.ValidateByDirectory(config); err != nil {
+		return err
+	}
+	if err := v.ValidateTeam(config); err != nil {
+		return err
+	}
+
+	// Validate color mode
+	if err := v.ValidateColorMode(config.Color); err != nil {
+		return err
+	}
+
 	// Validate author
 	if config.Author != "" {
 		if err := v.ValidateAuthor(config.Author); err != nil {
@@ -64,8 +108,44 @@ func (v *CLIValidator) ValidateConfig(config *Config) error {
 		return err
 	}
 
-	// Validate output file
-	if config.OutputFile != "" {
+	// Validate template
+	if err := v.ValidateTemplate(config.Format, config.Template); err != nil {
+		return err
+	}
+
+	if err := v.validateMultiFormat(config); err != nil {
+		return err
+	}
+
+	// Validate CSV options
+	if err := v.ValidateCSVOptions(config); err != nil {
+		return err
+	}
+
+	// Validate contributor comparison
+	if err := v.ValidateCompare(config); err != nil {
+		return err
+	}
+
+	// Validate commit graph options
+	if err := v.ValidateGraph(config); err != nil {
+		return err
+	}
+
+	// SVG output draws the contribution graph and the punch card only
+	for _, format := range config.Formats() {
+		if format == "svg" && (config.Command != "contrib" && config.Command != "punchcard" || config.GUIMode) {
+			return fmt.Errorf("svg format is only available with -contrib and -punchcard")
+		}
+	}
+
+	// Binary workbook output cannot go to the terminal
+	if config.Format == "xlsx" && config.OutputFile == "" {
+		return fmt.Errorf("xlsx format requires -output <file.xlsx>")
+	}
+
+	// Validate output file (split CSV output names a directory or archive, checked above)
+	if config.OutputFile != "" && !config.SplitsCSVOutput() {
 		if err := v.ValidateOutputFile(config.OutputFile); err != nil {
 			return err
 		}
@@ -84,7 +164,7 @@ func (v *CLIValidator) ValidateConfig(config *Config) error {
 
 // validateCommand validates the command
 func (v *CLIValidator) validateCommand(command string) error {
-	validCommands := []string{"contrib", "summary", "contributors", "health"}
+	validCommands := []string{"contrib", "summary", "contributors", "files", "health", "compare", "graph", "punchcard", "growth"}
 
 	for _, valid := range validCommands {
 		if command == valid {
@@ -167,16 +247,389 @@ func (v *CLIValidator) ValidateFormat(format string) error {
 		return fmt.Errorf("format cannot be empty")
 	}
 
-	validFormats := []string{"terminal", "json", "csv"}
+	validFormats := []string{"terminal", "json", "csv", "xlsx", "template", "svg"}
 	format = strings.ToLower(strings.TrimSpace(format))
 
-	for _, valid := range validFormats {
-		if format == valid {
+	seen := make(map[string]bool)
+	for _, part := range strings.Split(format, ",") {
+		part = strings.TrimSpace(part)
+
+		valid := false
+		for _, candidate := range validFormats {
+			if part == candidate {
+				valid = true
+				break
+			}
+		}
+		if !valid {
+			return fmt.Errorf("invalid format '%s'. Valid formats: %s", part, strings.Join(validFormats, ", "))
+		}
+
+		if seen[part] {
+			return fmt.Errorf("format '%s' listed more than once", part)
+		}
+		seen[part] = true
+	}
+
+	return nil
+}
+
+// validateMultiFormat checks that a comma-separated format list has an output base path
+func (v *CLIValidator) validateMultiFormat(config *Config) error {
+	if len(config.Formats()) > 1 && config.OutputFile == "" {
+		return fmt.Errorf("multiple formats require -output <base path> (e.g. -output report writes report.json, report.txt, ...)")
+	}
+	return nil
+}
+
+// ValidateTemplate validates the template option against the selected format
+func (v *CLIValidator) ValidateTemplate(format, template string) error {
+	usesTemplate := false
+	for _, part := range strings.Split(format, ",") {
+		if strings.TrimSpace(part) == "template" {
+			usesTemplate = true
+		}
+	}
+
+	if !usesTemplate {
+		if template != "" {
+			return fmt.Errorf("-template can only be used with -format template")
+		}
+		return nil
+	}
+
+	if template == "" {
+		return fmt.Errorf("template format requires -template <name|file>")
+	}
+
+	if strings.ContainsAny(template, "\n\r\t") {
+		return fmt.Errorf("template cannot contain newline or tab characters")
+	}
+
+	// Bare names refer to built-in templates and are resolved by the formatter
+	if !strings.ContainsAny(template, "/\\.") {
+		return nil
+	}
+
+	info, err := os.Stat(template)
+	if os.IsNotExist(err) {
+		return fmt.Errorf("template file does not exist: %s", template)
+	} else if err != nil {
+		return fmt.Errorf("cannot access template file: %v", err)
+	}
+	if info.IsDir() {
+		return fmt.Errorf("template path is a directory: %s", template)
+	}
+
+	return nil
+}
+
+// ValidateCompare validates the contributor comparison options
+func (v *CLIValidator) ValidateCompare(config *Config) error {
+	if config.Command != "compare" {
+		if len(config.Compare) > 0 {
+			return fmt.Errorf("-compare cannot be combined with -%s", config.Command)
+		}
+		return nil
+	}
+
+	if len(config.Compare) < 2 {
+		return fmt.Errorf("-compare requires at least two contributors separated by commas")
+	}
+
+	seen := make(map[string]bool, len(config.Compare))
+	for _, selector := range config.Compare {
+		if err := v.ValidateAuthor(selector); err != nil {
+			return err
+		}
+		key := strings.ToLower(selector)
+		if seen[key] {
+			return fmt.Errorf("-compare lists '%s' more than once", selector)
+		}
+		seen[key] = true
+	}
+
+	if config.Author != "" {
+		return fmt.Errorf("-compare cannot be combined with -author")
+	}
+
+	if config.GUIMode {
+		return fmt.Errorf("-compare cannot be combined with -gui; mark contributors in the GUI's contributors view instead")
+	}
+
+	for _, format := range config.Formats() {
+		if format != "terminal" && format != "json" {
+			return fmt.Errorf("-compare supports only the terminal and json formats, not %s", format)
+		}
+	}
+
+	return nil
+}
+
+// ValidateGraph validates the commit graph options; the GUI's graph view honors them as well
+func (v *CLIValidator) ValidateGraph(config *Config) error {
+	if config.CollapseRun < 0 {
+		return fmt.Errorf("-collapse must be 0 or greater, got %d", config.CollapseRun)
+	}
+
+	if config.Command != "graph" || config.GUIMode {
+		return nil
+	}
+
+	for _, format := range config.Formats() {
+		if format != "terminal" && format != "json" {
+			return fmt.Errorf("-graph supports only the terminal and json formats, not %s", format)
+		}
+	}
+
+	return nil
+}
+
+// ValidateYears validates the calendar years selected with -year or -years
+func (v *CLIValidator) ValidateYears(config *Config) error {
+	if !config.HasYears() {
+		return nil
+	}
+
+	if config.FromYear < 1970 {
+		return fmt.Errorf("-year must be 1970 or later, got %d", config.FromYear)
+	}
+	if config.FromYear > config.ToYear {
+		return fmt.Errorf("invalid year range %d-%d: the first year cannot be after the last", config.FromYear, config.ToYear)
+	}
+	if now := time.Now().Year(); config.ToYear > now {
+		return fmt.Errorf("-year %d is in the future (current year: %d)", config.ToYear, now)
+	}
+
+	return nil
+}
+
+// ValidateMetric validates the contribution graph metric; empty means commits
+func (v *CLIValidator) ValidateMetric(metric string) error {
+	if metric == "" {
+		return nil
+	}
+
+	for _, candidate := range models.ContributionMetrics {
+		if metric == candidate {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid metric '%s'. Valid metrics: %s", metric, strings.Join(models.ContributionMetrics, ", "))
+}
+
+// ValidateColorMode validates the -color mode; empty means auto
+func (v *CLIValidator) ValidateColorMode(mode string) error {
+	if mode == "" {
+		return nil
+	}
+
+	for _, candidate := range models.ColorModes {
+		if mode == candidate {
 			return nil
 		}
 	}
 
-	return fmt.Errorf("invalid format '%s'. Valid formats: %s", format, strings.Join(validFormats, ", "))
+	return fmt.Errorf("invalid color mode '%s'. Valid modes: %s", mode, strings.Join(models.ColorModes, ", "))
+}
+
+// ValidateLevels validates the activity level strategy; fixed levels need three ascending
+// positive thresholds
+func (v *CLIValidator) ValidateLevels(config *Config) error {
+	if config.Levels == "" {
+		return nil
+	}
+
+	valid := false
+	for _, candidate := range models.LevelStrategies {
+		if config.Levels == candidate {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		// Fixed levels are chosen by giving thresholds, not by name
+		var names []string
+		for _, candidate := range models.LevelStrategies {
+			if candidate != models.LevelsFixed {
+				names = append(names, candidate)
+			}
+		}
+		return fmt.Errorf("invalid levels '%s'. Valid levels: %s, or thresholds like 3,9,19",
+			config.Levels, strings.Join(names, ", "))
+	}
+
+	if config.Levels != models.LevelsFixed {
+		return nil
+	}
+	if len(config.Thresholds) != 3 {
+		return fmt.Errorf("fixed levels need three thresholds, e.g. -levels 3,9,19")
+	}
+	for i, threshold := range config.Thresholds {
+		if threshold < 1 || (i > 0 && threshold <= config.Thresholds[i-1]) {
+			return fmt.Errorf("invalid levels %v: thresholds must be positive and ascending", config.Thresholds)
+		}
+	}
+
+	return nil
+}
+
+// ValidateByAuthor validates the per-author contribution graph options
+func (v *CLIValidator) ValidateByAuthor(config *Config) error {
+	if config.ByAuthor == "" {
+		return nil
+	}
+
+	valid := false
+	for _, candidate := range models.ByAuthorModes {
+		if config.ByAuthor == candidate {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return fmt.Errorf("invalid -by-author mode '%s'. Valid modes: %s", config.ByAuthor, strings.Join(models.ByAuthorModes, ", "))
+	}
+
+	if config.TopAuthors < 1 || config.TopAuthors > models.MaxTopAuthors {
+		return fmt.Errorf("-top must be between 1 and %d, got %d", models.MaxTopAuthors, config.TopAuthors)
+	}
+	if config.Command != "contrib" || config.GUIMode {
+		return fmt.Errorf("-by-author is only available with -contrib")
+	}
+	if config.HasYears() {
+		return fmt.Errorf("-by-author cannot be combined with -year or -years")
+	}
+
+	return nil
+}
+
+// ValidateGrowth validates the code growth interval, breakdown and exclusions
+func (v *CLIValidator) ValidateGrowth(config *Config) error {
+	if config.Interval == "" && config.Breakdown == "" && len(config.Exclude) == 0 {
+		return nil
+	}
+
+	if config.Command != "growth" {
+		return fmt.Errorf("-interval, -breakdown and -exclude are only available with -growth")
+	}
+
+	if config.Interval != "" && !containsString(models.GrowthIntervals, config.Interval) {
+		return fmt.Errorf("invalid -interval '%s'. Valid intervals: %s", config.Interval, strings.Join(models.GrowthIntervals, ", "))
+	}
+	if config.Breakdown != "" && !containsString(models.GrowthBreakdowns, config.Breakdown) {
+		return fmt.Errorf("invalid -breakdown '%s'. Valid breakdowns: %s", config.Breakdown, strings.Join(models.GrowthBreakdowns, ", "))
+	}
+
+	for _, pattern := range config.Exclude {
+		if strings.ContainsAny(pattern, "\n\r\t") {
+			return fmt.Errorf("-exclude patterns cannot contain newline or tab characters")
+		}
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid -exclude pattern '%s': %v", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateByDirectory validates the directory and module statistics options
+func (v *CLIValidator) ValidateByDirectory(config *Config) error {
+	if !config.ByDirectory {
+		if config.Modules {
+			return fmt.Errorf("-modules requires -by-directory")
+		}
+		return nil
+	}
+
+	if config.Command != "files" || config.GUIMode {
+		return fmt.Errorf("-by-directory is only available with -files")
+	}
+	if config.Depth < 1 {
+		return fmt.Errorf("-depth must be 1 or greater, got %d", config.Depth)
+	}
+
+	return nil
+}
+
+// ValidateTeam validates the team filter and grouping; the teams themselves are checked when the
+// team definition file is read
+func (v *CLIValidator) ValidateTeam(config *Config) error {
+	if strings.ContainsAny(config.Team, "\n\r\t") {
+		return fmt.Errorf("-team cannot contain newline or tab characters")
+	}
+	if !config.ByTeam {
+		return nil
+	}
+
+	if config.GUIMode {
+		return fmt.Errorf("-by-team is not available in the GUI")
+	}
+	if config.Command == "contrib" && config.HasYears() {
+		return fmt.Errorf("-by-team cannot be combined with -year or -years in -contrib")
+	}
+
+	return nil
+}
+
+// containsString reports whether values contains value
+func containsString(values []string, value string) bool {
+	for _, candidate := range values {
+		if candidate == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateCSVOptions validates the CSV dialect and split output settings
+func (v *CLIValidator) ValidateCSVOptions(config *Config) error {
+	usesCSV := containsString(config.Formats(), "csv")
+
+	switch config.CSVDialect {
+	case "", "default", "rfc4180", "excel":
+	default:
+		return fmt.Errorf("invalid CSV dialect '%s'. Valid dialects: default, rfc4180, excel", config.CSVDialect)
+	}
+	if config.CSVDialect != "" && config.CSVDialect != "default" && !usesCSV {
+		return fmt.Errorf("-csv-dialect can only be used with -format csv")
+	}
+
+	if config.CSVSplit && (!usesCSV || config.OutputFile == "") {
+		return fmt.Errorf("-csv-split requires -format csv and an -output directory")
+	}
+
+	if usesCSV && config.CSVDialect == "rfc4180" && !config.SplitsCSVOutput() {
+		return fmt.Errorf("the rfc4180 CSV dialect requires split output; use an -output directory or .zip file")
+	}
+
+	if !config.SplitsCSVOutput() {
+		return nil
+	}
+
+	target := filepath.Clean(config.OutputFile)
+	if strings.ContainsAny(target, "\n\r\t") {
+		return fmt.Errorf("output path cannot contain newline or tab characters")
+	}
+
+	if IsZipOutput(target) {
+		return v.ValidateOutputFile(target)
+	}
+
+	if info, err := os.Stat(target); err == nil {
+		if !info.IsDir() {
+			return fmt.Errorf("CSV output path is not a directory: %s", target)
+		}
+		return nil
+	}
+
+	parent := filepath.Dir(target)
+	if _, err := os.Stat(parent); os.IsNotExist(err) {
+		return fmt.Errorf("output directory does not exist: %s", parent)
+	}
+
+	return nil
 }
 
 // ValidateOutputFile validates the output file path
@@ -9,6 +9,8 @@ import (
 	"os"
 	"path/filepath"
 	"time"
+
+	"git-stats/models"
 )
 
 // Config represents the complete application configuration
@@ -27,6 +29,9 @@ type Config struct {
 
 	// GUI settings
 	GUI GUIConfig `json:"gui"`
+
+	// Team definition file; a relative path is relative to the configuration file
+	Teams string `json:"teams,omitempty"`
 }
 
 // DefaultConfig contains default application settings
@@ -66,6 +71,7 @@ type OutputConfig struct {
 	IncludeMetadata bool `json:"include_metadata"` // Include metadata in output
 	DateFormat    string `json:"date_format"`    // Default date format
 	TimeFormat    string `json:"time_format"`    // Default time format
+	Themes        map[string][]string `json:"themes,omitempty"` // Custom color themes: name -> hex colors, no activity first
 }
 
 // PerformanceConfig contains performance-related settings
@@ -85,6 +91,7 @@ type GUIConfig struct {
 	KeyBindings       map[string]string `json:"key_bindings"` // Custom key bindings
 	ShowHelp          bool   `json:"show_help"`           // Show help on startup
 	ContribGraphWidth int    `json:"contrib_graph_width"` // Contribution graph width
+	GraphPaneSize     int    `json:"graph_pane_size"`     // Contribution graph pane width (percent of the window)
 }
 
 // ConfigManager manages application configuration
@@ -158,6 +165,7 @@ func getDefaultConfig() *Config {
 			KeyBindings:       getDefaultKeyBindings(),
 			ShowHelp:          false,
 			ContribGraphWidth: 53, // Standard GitHub width
+			GraphPaneSize:     67,
 		},
 	}
 }
@@ -223,6 +231,51 @@ func (cm *ConfigManager) Save() error {
 	return nil
 }
 
+// SaveGUIPaneSize writes the contribution graph pane width to the configuration file. Only
+// gui.graph_pane_size changes; the file's other entries stay as written, without the defaults
+// Load merges in
+func (cm *ConfigManager) SaveGUIPaneSize(size int) error {
+	cm.config.GUI.GraphPaneSize = size
+	configPath := cm.getConfigPath()
+
+	file := make(map[string]json.RawMessage)
+	data, err := os.ReadFile(configPath)
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read config file: %w", err)
+	}
+	if err == nil {
+		if err := json.Unmarshal(data, &file); err != nil {
+			return fmt.Errorf("failed to parse config file: %w", err)
+		}
+	}
+
+	var gui map[string]json.RawMessage
+	if raw, ok := file["gui"]; ok {
+		if err := json.Unmarshal(raw, &gui); err != nil {
+			return fmt.Errorf("failed to parse gui settings: %w", err)
+		}
+	}
+	if gui == nil {
+		gui = make(map[string]json.RawMessage)
+	}
+	gui["graph_pane_size"] = json.RawMessage(fmt.Sprintf("%d", size))
+	if file["gui"], err = json.Marshal(gui); err != nil {
+		return fmt.Errorf("failed to marshal gui settings: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		return fmt.Errorf("failed to create config directory: %w", err)
+	}
+	if data, err = json.MarshalIndent(file, "", "  "); err != nil {
+		return fmt.Errorf("failed to marshal config: %w", err)
+	}
+	if err := os.WriteFile(configPath, data, 0644); err != nil {
+		return fmt.Errorf("failed to write config file: %w", err)
+	}
+
+	return nil
+}
+
 // GetConfig returns the current configuration
 func (cm *ConfigManager) GetConfig() *Config {
 	return cm.config
@@ -355,6 +408,9 @@ func (cm *ConfigManager) mergeWithDefaults(loaded *Config) *Config {
 	if loaded.GUI.ContribGraphWidth == 0 {
 		loaded.GUI.ContribGraphWidth = defaults.GUI.ContribGraphWidth
 	}
+	if loaded.GUI.GraphPaneSize == 0 {
+		loaded.GUI.GraphPaneSize = defaults.GUI.GraphPaneSize
+	}
 
 	return loaded
 }
@@ -422,8 +478,12 @@ func (cm *ConfigManager) Validate() error {
 	}
 
 	// Validate output settings
-	validThemes := []string{"github", "blue", "fire", "mono"}
-	if !contains(validThemes, config.Output.ColorTheme) {
+	for name, colors := range config.Output.Themes {
+		if _, err := models.NewTheme(name, colors); err != nil {
+			return fmt.Errorf("invalid color theme: %w", err)
+		}
+	}
+	if _, err := models.ResolveTheme(config.Output.ColorTheme, config.Output.Themes); err != nil || config.Output.ColorTheme == "" {
 		return fmt.Errorf("invalid color theme: %s", config.Output.ColorTheme)
 	}
 
@@ -439,7 +499,7 @@ func (cm *ConfigManager) Validate() error {
 	}
 
 	// Validate GUI settings
-	validViews := []string{"contrib", "summary", "contributors", "health"}
+	validViews := []string{"contrib", "summary", "contributors", "health", "files", "graph"}
 	if !contains(validViews, config.GUI.DefaultView) {
 		return fmt.Errorf("invalid default GUI view: %s", config.GUI.DefaultView)
 	}
@@ -448,6 +508,10 @@ func (cm *ConfigManager) Validate() error {
 		return fmt.Errorf("contribution graph width must be positive: %d", config.GUI.ContribGraphWidth)
 	}
 
+	if config.GUI.GraphPaneSize < 10 || config.GUI.GraphPaneSize > 90 {
+		return fmt.Errorf("graph pane size must be between 10 and 90 percent: %d", config.GUI.GraphPaneSize)
+	}
+
 	return nil
 }
 
@@ -0,0 +1,126 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Team definition file
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"git-stats/models"
+)
+
+// TeamsFile is the JSON team definition file the configuration's "teams" entry points to
+type TeamsFile struct {
+	Teams []TeamDefinition `json:"teams"`
+}
+
+// TeamDefinition names a team and its members
+type TeamDefinition struct {
+	Name    string             `json:"name"`
+	Members []MemberDefinition `json:"members"`
+}
+
+// MemberDefinition is an email or email glob, on the team from Since to Until when they are set
+type MemberDefinition struct {
+	Email string `json:"email"`           // e.g. alice@example.com or *@infra.example.com
+	Since string `json:"since,omitempty"` // first day on the team (YYYY-MM-DD)
+	Until string `json:"until,omitempty"` // last day on the team (YYYY-MM-DD)
+}
+
+// LoadTeams reads the team definition file the configuration points to
+func (cm *ConfigManager) LoadTeams() (models.Teams, error) {
+	teamsPath := cm.config.Teams
+	if teamsPath == "" {
+		return nil, fmt.Errorf("no team definition file configured; set \"teams\" in %s", cm.getConfigPath())
+	}
+	if !filepath.IsAbs(teamsPath) {
+		teamsPath = filepath.Join(filepath.Dir(cm.getConfigPath()), teamsPath)
+	}
+	return LoadTeams(teamsPath)
+}
+
+// LoadTeams reads and validates a team definition file
+func LoadTeams(teamsPath string) (models.Teams, error) {
+	data, err := os.ReadFile(teamsPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read team definition file: %w", err)
+	}
+
+	teams, err := ParseTeams(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid team definition file %s: %w", teamsPath, err)
+	}
+	return teams, nil
+}
+
+// ParseTeams parses and validates the JSON of a team definition file. Teams keep the file's
+// order, which decides the team of an author matching several
+func ParseTeams(data []byte) (models.Teams, error) {
+	var file TeamsFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse teams: %w", err)
+	}
+	if len(file.Teams) == 0 {
+		return nil, fmt.Errorf("no teams defined")
+	}
+
+	teams := make(models.Teams, 0, len(file.Teams))
+	for _, definition := range file.Teams {
+		name := strings.TrimSpace(definition.Name)
+		if name == "" {
+			return nil, fmt.Errorf("every team needs a name")
+		}
+		if name == models.NoTeam || teams.Find(name) != nil {
+			return nil, fmt.Errorf("team name '%s' is reserved or used twice", name)
+		}
+		if len(definition.Members) == 0 {
+			return nil, fmt.Errorf("team '%s' has no members", name)
+		}
+
+		team := models.Team{Name: name}
+		for _, member := range definition.Members {
+			parsed, err := parseMember(member)
+			if err != nil {
+				return nil, fmt.Errorf("team '%s': %w", name, err)
+			}
+			team.Members = append(team.Members, parsed)
+		}
+		teams = append(teams, team)
+	}
+
+	return teams, nil
+}
+
+// parseMember validates a member's email pattern and membership dates
+func parseMember(member MemberDefinition) (models.TeamMember, error) {
+	parsed := models.TeamMember{Pattern: strings.TrimSpace(member.Email)}
+	if parsed.Pattern == "" {
+		return parsed, fmt.Errorf("every member needs an email")
+	}
+	if _, err := path.Match(parsed.Pattern, ""); err != nil {
+		return parsed, fmt.Errorf("invalid email pattern '%s': %v", parsed.Pattern, err)
+	}
+
+	var err error
+	if member.Since != "" {
+		if parsed.Since, err = time.Parse("2006-01-02", member.Since); err != nil {
+			return parsed, fmt.Errorf("invalid since date '%s' of %s, expected YYYY-MM-DD", member.Since, parsed.Pattern)
+		}
+	}
+	if member.Until != "" {
+		if parsed.Until, err = time.Parse("2006-01-02", member.Until); err != nil {
+			return parsed, fmt.Errorf("invalid until date '%s' of %s, expected YYYY-MM-DD", member.Until, parsed.Pattern)
+		}
+	}
+	if !parsed.Since.IsZero() && !parsed.Until.IsZero() && parsed.Until.Before(parsed.Since) {
+		return parsed, fmt.Errorf("membership of %s ends before it starts", parsed.Pattern)
+	}
+
+	return parsed, nil
+}
@@ -140,6 +140,11 @@ func (fb *FilterBuilder) buildAuthorFilter(authorPattern string) (*AuthorFilter,
 	)
 }
 
+// ParseDate parses an absolute or relative date such as "2024-01-31", "last month" or "6 months ago"
+func (fb *FilterBuilder) ParseDate(value string) (*time.Time, error) {
+	return fb.parseDateRange(value)
+}
+
 // parseDateRange parses relative date ranges like "1 year ago", "6 months ago"
 func (fb *FilterBuilder) parseDateRange(dateRange string) (*time.Time, error) {
 	dateRange = strings.ToLower(strings.TrimSpace(dateRange))
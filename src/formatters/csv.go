@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"encoding/csv"
 	"fmt"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -32,78 +33,405 @@ func (cf *CSVFormatterImpl) Format(data *models.AnalysisResult, config models.Fo
 	return cf.FormatCSV(data, config)
 }
 
-// FormatCSV formats analysis results as CSV
+// FormatCSV formats analysis results as a single CSV stream with commented section headers
 func (cf *CSVFormatterImpl) FormatCSV(data *models.AnalysisResult, config models.FormatConfig) ([]byte, error) {
+	dialect, err := GetCSVDialect(config.CSVDialect)
+	if err != nil {
+		return nil, err
+	}
+	if dialect.Strict {
+		return nil, NewFormatterOperationError("csv", "the rfc4180 dialect cannot hold several tables in one stream; write to a directory or .zip instead")
+	}
+
 	var result bytes.Buffer
+	newline := dialect.lineEnding()
+
+	if dialect.BOM {
+		result.WriteString(utf8BOM)
+	}
 
 	// Write metadata header if requested
 	if config.Metadata {
-		if err := cf.writeMetadata(&result, data); err != nil {
+		if err := cf.writeMetadata(&result, data, newline); err != nil {
 			return nil, NewFormatterOperationError("metadata", err.Error())
 		}
-		result.WriteString("\n")
+		result.WriteString(newline)
 	}
 
-	// Write contributors CSV
-	if len(data.Contributors) > 0 {
-		result.WriteString("# Contributors\n")
-		contributorsCSV, err := cf.FormatContributorsCSV(data.Contributors)
+	for _, section := range cf.buildSections(data) {
+		if section.table == nil {
+			if section.emptyNote != "" {
+				result.WriteString("# " + section.title + newline + "# " + section.emptyNote + newline + newline)
+			}
+			continue
+		}
+
+		result.WriteString("# " + section.title + newline)
+		sectionCSV, err := cf.encodeTable(*section.table, dialect)
 		if err != nil {
-			return nil, NewFormatterOperationError("contributors", err.Error())
+			return nil, NewFormatterOperationError(section.table.Name, err.Error())
+		}
+		result.Write(sectionCSV)
+		result.WriteString(newline)
+	}
+
+	return result.Bytes(), nil
+}
+
+// csvSection describes one section of the single-stream CSV output
+type csvSection struct {
+	title     string
+	table     *CSVTable
+	emptyNote string
+}
+
+// buildSections returns the sections of the single-stream CSV output in order
+func (cf *CSVFormatterImpl) buildSections(data *models.AnalysisResult) []csvSection {
+	var sections []csvSection
+
+	if data.Selection != nil {
+		table := cf.selectionTable(data.Selection)
+		sections = append(sections, csvSection{title: "Selection", table: &table})
+
+		if len(data.Selection.Commits) > 0 {
+			commits := cf.selectedCommitsTable(data.Selection.Commits)
+			sections = append(sections, csvSection{title: "Selected Commits", table: &commits})
 		}
-		result.Write(contributorsCSV)
-		result.WriteString("\n")
+	}
+
+	if len(data.Contributors) > 0 {
+		table := cf.contributorsTable(data.Contributors)
+		sections = append(sections, csvSection{title: "Contributors", table: &table})
 	} else {
-		result.WriteString("# Contributors\n# No contributors data available\n\n")
+		sections = append(sections, csvSection{title: "Contributors", emptyNote: "No contributors data available"})
 	}
 
-	// Write summary statistics CSV
 	if data.Summary != nil {
-		result.WriteString("# Summary Statistics\n")
-		summaryCSV, err := cf.formatSummaryCSV(data.Summary)
-		if err != nil {
-			return nil, NewFormatterOperationError("summary", err.Error())
-		}
-		result.Write(summaryCSV)
-		result.WriteString("\n")
+		table := cf.summaryTable(data.Summary)
+		sections = append(sections, csvSection{title: "Summary Statistics", table: &table})
 	} else {
-		result.WriteString("# Summary Statistics\n# No summary data available\n\n")
+		sections = append(sections, csvSection{title: "Summary Statistics", emptyNote: "No summary data available"})
 	}
 
-	// Write file statistics CSV
 	if data.Summary != nil && len(data.Summary.TopFiles) > 0 {
-		result.WriteString("# File Statistics\n")
-		filesCSV, err := cf.formatFilesCSV(data.Summary.TopFiles)
-		if err != nil {
-			return nil, NewFormatterOperationError("files", err.Error())
-		}
-		result.Write(filesCSV)
-		result.WriteString("\n")
+		table := cf.filesTable(data.Summary.TopFiles)
+		sections = append(sections, csvSection{title: "File Statistics", table: &table})
 	}
 
-	// Write file type statistics CSV
 	if data.Summary != nil && len(data.Summary.TopFileTypes) > 0 {
-		result.WriteString("# File Type Statistics\n")
-		fileTypesCSV, err := cf.formatFileTypesCSV(data.Summary.TopFileTypes)
-		if err != nil {
-			return nil, NewFormatterOperationError("file_types", err.Error())
-		}
-		result.Write(fileTypesCSV)
-		result.WriteString("\n")
+		table := cf.fileTypesTable(data.Summary.TopFileTypes)
+		sections = append(sections, csvSection{title: "File Type Statistics", table: &table})
 	}
 
-	// Write contribution graph CSV
 	if data.ContribGraph != nil {
-		result.WriteString("# Daily Contributions\n")
-		contribCSV, err := cf.formatContributionGraphCSV(data.ContribGraph)
-		if err != nil {
-			return nil, NewFormatterOperationError("contribution_graph", err.Error())
+		table := cf.contributionGraphTable(data.ContribGraph)
+		sections = append(sections, csvSection{title: "Daily Contributions", table: &table})
+	}
+
+	if data.Summary != nil && data.Summary.PunchCard.Total() > 0 {
+		table := cf.punchCardTable(&data.Summary.PunchCard)
+		sections = append(sections, csvSection{title: "Punch Card", table: &table})
+	}
+
+	if cf.hasContributorPunchCards(data.Contributors) {
+		table := cf.contributorPunchCardsTable(data.Contributors)
+		sections = append(sections, csvSection{title: "Contributor Punch Cards", table: &table})
+	}
+
+	if len(data.Directories) > 0 {
+		table := cf.directoriesTable(data.Directories)
+		sections = append(sections, csvSection{title: "Directory Statistics", table: &table})
+	}
+
+	if len(data.Teams) > 0 {
+		table := cf.teamsTable(data.Teams)
+		sections = append(sections, csvSection{title: "Team Statistics", table: &table})
+	}
+
+	if data.Growth != nil {
+		table := cf.codeGrowthTable(data.Growth)
+		sections = append(sections, csvSection{title: "Code Growth", table: &table})
+	}
+
+	if data.FileTree != nil {
+		table := cf.fileTreeTable(data.FileTree)
+		sections = append(sections, csvSection{title: "File Tree", table: &table})
+	}
+
+	if data.CommitGraph != nil {
+		table := cf.commitGraphTable(data.CommitGraph)
+		sections = append(sections, csvSection{title: "Commit Graph", table: &table})
+	}
+
+	return sections
+}
+
+// selectionTable builds the key/value table describing the GUI selection
+func (cf *CSVFormatterImpl) selectionTable(selection *models.ViewSelection) CSVTable {
+	table := CSVTable{
+		Name:        "selection",
+		FileName:    "selection.csv",
+		Description: "GUI view and selection the report was exported from",
+		Headers:     []string{"Key", "Value"},
+		Rows:        [][]string{{"View", selection.View}},
+	}
+
+	if !selection.Date.IsZero() {
+		table.Rows = append(table.Rows, []string{"Date", selection.Date.Format("2006-01-02")})
+	}
+	if selection.Contributor != "" {
+		table.Rows = append(table.Rows, []string{"Contributor", selection.Contributor})
+	}
+	if selection.Path != "" {
+		table.Rows = append(table.Rows, []string{"Path", selection.Path})
+	}
+	if selection.Filters != "" {
+		table.Rows = append(table.Rows, []string{"Filters", selection.Filters})
+	}
+
+	return table
+}
+
+// selectedCommitsTable builds the table of the commits of the selected day
+func (cf *CSVFormatterImpl) selectedCommitsTable(commits []models.Commit) CSVTable {
+	table := CSVTable{
+		Name:        "selected_commits",
+		FileName:    "selected_commits.csv",
+		Description: "Commits selected in the GUI",
+		Headers:     []string{"Hash", "Author", "Email", "Date", "Message", "Files Changed", "Insertions", "Deletions"},
+	}
+
+	for _, commit := range commits {
+		table.Rows = append(table.Rows, []string{
+			commit.Hash,
+			commit.Author.Name,
+			commit.Author.Email,
+			cf.formatTimeForCSV(commit.AuthorDate),
+			strings.SplitN(commit.Message, "\n", 2)[0],
+			strconv.Itoa(commit.Stats.FilesChanged),
+			strconv.Itoa(commit.Stats.Insertions),
+			strconv.Itoa(commit.Stats.Deletions),
+		})
+	}
+
+	return table
+}
+
+// fileTreeTable builds one row per file and directory of the tree, parents before their children
+func (cf *CSVFormatterImpl) fileTreeTable(root *models.FileTreeNode) CSVTable {
+	table := CSVTable{
+		Name:        "file_tree",
+		FileName:    "file_tree.csv",
+		Description: "Commits, churn and authors per file and directory",
+		Headers:     []string{"Path", "Type", "Commits", "Insertions", "Deletions", "Authors", "Top Author", "Last Modified"},
+	}
+
+	var walk func(node *models.FileTreeNode)
+	walk = func(node *models.FileTreeNode) {
+		path, kind := node.Path, "file"
+		if node.IsDir {
+			path, kind = node.Path+"/", "directory"
+		}
+		table.Rows = append(table.Rows, []string{
+			path,
+			kind,
+			strconv.Itoa(node.Commits),
+			strconv.Itoa(node.Insertions),
+			strconv.Itoa(node.Deletions),
+			strconv.Itoa(node.Authors),
+			node.TopAuthor,
+			cf.formatTimeForCSV(node.LastModified),
+		})
+		for _, child := range node.Children {
+			walk(child)
 		}
-		result.Write(contribCSV)
-		result.WriteString("\n")
 	}
+	walk(root)
 
-	return result.Bytes(), nil
+	return table
+}
+
+// commitGraphTable builds one row per commit of the graph, children before their parents
+func (cf *CSVFormatterImpl) commitGraphTable(graph *models.CommitGraph) CSVTable {
+	table := CSVTable{
+		Name:        "commit_graph",
+		FileName:    "commit_graph.csv",
+		Description: "Commits with their lane, parents and branch and tag decorations",
+		Headers:     []string{"Hash", "Parents", "Lane", "Graph", "Refs", "Author", "Email", "Date", "Message"},
+	}
+
+	for _, row := range graph.Rows {
+		table.Rows = append(table.Rows, []string{
+			row.Commit.Hash,
+			strings.Join(row.Commit.ParentHashes, " "),
+			strconv.Itoa(row.Lane),
+			row.Graph,
+			strings.Join(row.Refs, ", "),
+			row.Commit.Author.Name,
+			row.Commit.Author.Email,
+			cf.formatTimeForCSV(row.Commit.AuthorDate),
+			strings.SplitN(row.Commit.Message, "\n", 2)[0],
+		})
+	}
+
+	return table
+}
+
+// punchCardHeaders returns the weekday column, one column per hour and the weekday total
+func punchCardHeaders() []string {
+	headers := []string{"Weekday"}
+	for hour := 0; hour < 24; hour++ {
+		headers = append(headers, fmt.Sprintf("%02d", hour))
+	}
+	return append(headers, "Total")
+}
+
+// punchCardRows returns one row per weekday, Sunday first, with the commits of each hour
+func punchCardRows(card *models.PunchCard) [][]string {
+	rows := make([][]string, 0, len(card))
+	for weekday, hours := range card {
+		row := []string{time.Weekday(weekday).String()}
+		for _, commits := range hours {
+			row = append(row, strconv.Itoa(commits))
+		}
+		rows = append(rows, append(row, strconv.Itoa(card.WeekdayTotal(time.Weekday(weekday)))))
+	}
+	return rows
+}
+
+// punchCardTable builds the hour by weekday table of the repository's commits
+func (cf *CSVFormatterImpl) punchCardTable(card *models.PunchCard) CSVTable {
+	return CSVTable{
+		Name:        "punch_card",
+		FileName:    "punch_card.csv",
+		Description: "Commits by weekday and hour of day",
+		Headers:     punchCardHeaders(),
+		Rows:        punchCardRows(card),
+	}
+}
+
+// hasContributorPunchCards reports whether any contributor has commits counted by hour and weekday
+func (cf *CSVFormatterImpl) hasContributorPunchCards(contributors []models.Contributor) bool {
+	for _, contributor := range contributors {
+		if contributor.PunchCard.Total() > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// contributorPunchCardsTable builds seven weekday rows per contributor with a punch card
+func (cf *CSVFormatterImpl) contributorPunchCardsTable(contributors []models.Contributor) CSVTable {
+	table := CSVTable{
+		Name:        "contributor_punch_cards",
+		FileName:    "contributor_punch_cards.csv",
+		Description: "Commits by weekday and hour of day per contributor",
+		Headers:     append([]string{"Name", "Email"}, punchCardHeaders()...),
+	}
+
+	for i := range contributors {
+		contributor := &contributors[i]
+		if contributor.PunchCard.Total() == 0 {
+			continue
+		}
+		for _, row := range punchCardRows(&contributor.PunchCard) {
+			table.Rows = append(table.Rows, append([]string{contributor.Name, contributor.Email}, row...))
+		}
+	}
+
+	return table
+}
+
+// directoriesTable builds one row per directory or module with its top authors joined by semicolons
+func (cf *CSVFormatterImpl) directoriesTable(directories []models.DirectoryStats) CSVTable {
+	table := CSVTable{
+		Name:        "directories",
+		FileName:    "directories.csv",
+		Description: "Commits, churn and authors per directory or module",
+		Headers: []string{"Directory", "Manifest", "Files", "Commits", "Insertions", "Deletions", "Authors",
+			"Top Authors", "First Change", "Last Change", "Activity Trend"},
+	}
+
+	for i := range directories {
+		directory := &directories[i]
+
+		authors := make([]string, len(directory.TopAuthors))
+		for j, author := range directory.TopAuthors {
+			authors[j] = fmt.Sprintf("%s <%s> (%d)", author.Name, author.Email, author.Commits)
+		}
+
+		table.Rows = append(table.Rows, []string{
+			directory.Path,
+			directory.Manifest,
+			strconv.Itoa(directory.Files),
+			strconv.Itoa(directory.Commits),
+			strconv.Itoa(directory.Insertions),
+			strconv.Itoa(directory.Deletions),
+			strconv.Itoa(directory.Authors),
+			strings.Join(authors, "; "),
+			cf.formatTimeForCSV(directory.FirstChange),
+			cf.formatTimeForCSV(directory.LastChange),
+			directory.Trend,
+		})
+	}
+
+	return table
+}
+
+// teamsTable builds one row per team with its commits, churn, active days and ownership
+func (cf *CSVFormatterImpl) teamsTable(teams []models.TeamStats) CSVTable {
+	table := CSVTable{
+		Name:        "teams",
+		FileName:    "teams.csv",
+		Description: "Commits, churn, active days and file ownership per team",
+		Headers: []string{"Team", "Members", "Commits", "Commit Share", "Insertions", "Deletions", "Active Days",
+			"Files", "Owned Files", "Ownership", "First Commit", "Last Commit"},
+	}
+
+	for i := range teams {
+		team := &teams[i]
+		table.Rows = append(table.Rows, []string{
+			team.Name,
+			strconv.Itoa(team.Members),
+			strconv.Itoa(team.Commits),
+			fmt.Sprintf("%.2f", team.CommitShare),
+			strconv.Itoa(team.Insertions),
+			strconv.Itoa(team.Deletions),
+			strconv.Itoa(team.ActiveDays),
+			strconv.Itoa(team.Files),
+			strconv.Itoa(team.OwnedFiles),
+			fmt.Sprintf("%.2f", team.Ownership),
+			cf.formatTimeForCSV(team.FirstCommit),
+			cf.formatTimeForCSV(team.LastCommit),
+		})
+	}
+
+	return table
+}
+
+// codeGrowthTable builds one row per period of the total and, after it, of each group
+func (cf *CSVFormatterImpl) codeGrowthTable(growth *models.CodeGrowth) CSVTable {
+	table := CSVTable{
+		Name:        "code_growth",
+		FileName:    "code_growth.csv",
+		Description: "Lines added, deleted and cumulative net lines of code per " + growth.Interval,
+		Headers:     []string{"Period", "Group", "Insertions", "Deletions", "Net", "Total"},
+	}
+
+	for _, series := range append([]models.GrowthSeries{growth.Total}, growth.Groups...) {
+		for _, point := range series.Points {
+			table.Rows = append(table.Rows, []string{
+				point.Period.Format("2006-01-02"),
+				series.Name,
+				strconv.Itoa(point.Insertions),
+				strconv.Itoa(point.Deletions),
+				strconv.Itoa(point.Net),
+				strconv.Itoa(point.Total),
+			})
+		}
+	}
+
+	return table
 }
 
 // FormatCommitsCSV formats commits as CSV
@@ -151,22 +479,24 @@ func (cf *CSVFormatterImpl) FormatCommitsCSV(commits []git.Commit) ([]byte, erro
 
 // FormatContributorsCSV formats contributors as CSV
 func (cf *CSVFormatterImpl) FormatContributorsCSV(contributors []models.Contributor) ([]byte, error) {
-	var buf bytes.Buffer
-	writer := csv.NewWriter(&buf)
+	return cf.encodeTable(cf.contributorsTable(contributors), DefaultCSVDialect())
+}
 
-	// Write header
-	headers := []string{
-		"Name", "Email", "Total Commits", "Total Insertions", "Total Deletions",
-		"First Commit", "Last Commit", "Active Days", "Activity Level",
-		"Avg Commits Per Day", "Most Active Hour", "Most Active Weekday", "Top File Type",
-	}
-	if err := writer.Write(headers); err != nil {
-		return nil, fmt.Errorf("failed to write CSV header: %w", err)
+// contributorsTable builds the contributors table
+func (cf *CSVFormatterImpl) contributorsTable(contributors []models.Contributor) CSVTable {
+	table := CSVTable{
+		Name:        "contributors",
+		FileName:    "contributors.csv",
+		Description: "One row per contributor with commit and line totals",
+		Headers: []string{
+			"Name", "Email", "Total Commits", "Total Insertions", "Total Deletions",
+			"First Commit", "Last Commit", "Active Days", "Activity Level",
+			"Avg Commits Per Day", "Most Active Hour", "Most Active Weekday", "Top File Type",
+		},
 	}
 
-	// Write contributor data
 	for _, contributor := range contributors {
-		record := []string{
+		table.Rows = append(table.Rows, []string{
 			contributor.Name,
 			contributor.Email,
 			strconv.Itoa(contributor.TotalCommits),
@@ -180,167 +510,149 @@ func (cf *CSVFormatterImpl) FormatContributorsCSV(contributors []models.Contribu
 			strconv.Itoa(contributor.GetMostActiveHour()),
 			contributor.GetMostActiveWeekday().String(),
 			contributor.GetTopFileType(),
-		}
-		if err := writer.Write(record); err != nil {
-			return nil, fmt.Errorf("failed to write contributor record: %w", err)
-		}
+		})
 	}
 
-	writer.Flush()
-	if err := writer.Error(); err != nil {
-		return nil, fmt.Errorf("CSV writer error: %w", err)
+	return table
+}
+
+// writeMetadata writes metadata as CSV comments
+func (cf *CSVFormatterImpl) writeMetadata(buf *bytes.Buffer, data *models.AnalysisResult, newline string) error {
+	buf.WriteString("# Metadata" + newline)
+	for _, row := range cf.metadataTable(data).Rows {
+		buf.WriteString(fmt.Sprintf("# %s: %s%s", row[0], row[1], newline))
 	}
 
-	return buf.Bytes(), nil
+	return nil
 }
 
-// writeMetadata writes metadata as CSV comments
-func (cf *CSVFormatterImpl) writeMetadata(buf *bytes.Buffer, data *models.AnalysisResult) error {
-	buf.WriteString("# Metadata\n")
-	buf.WriteString(fmt.Sprintf("# Generated at: %s\n", time.Now().UTC().Format(time.RFC3339)))
-	buf.WriteString("# Format: CSV\n")
-	buf.WriteString("# Version: 1.0\n")
+// metadataTable builds the metadata key/value table
+func (cf *CSVFormatterImpl) metadataTable(data *models.AnalysisResult) CSVTable {
+	table := CSVTable{
+		Name:        "metadata",
+		FileName:    "metadata.csv",
+		Description: "Key/value pairs describing how and when the report was generated",
+		Headers:     []string{"Key", "Value"},
+		Rows: [][]string{
+			{"Generated at", time.Now().UTC().Format(time.RFC3339)},
+			{"Format", "CSV"},
+			{"Version", "1.0"},
+		},
+	}
 
 	if data.Repository != nil {
-		buf.WriteString(fmt.Sprintf("# Repository: %s\n", data.Repository.Name))
-		buf.WriteString(fmt.Sprintf("# Repository Path: %s\n", data.Repository.Path))
+		table.Rows = append(table.Rows,
+			[]string{"Repository", data.Repository.Name},
+			[]string{"Repository Path", data.Repository.Path})
 	}
 
-	buf.WriteString(fmt.Sprintf("# Analysis Period: %s to %s\n",
-		cf.formatTimeForCSV(data.TimeRange.Start),
-		cf.formatTimeForCSV(data.TimeRange.End)))
+	table.Rows = append(table.Rows, []string{"Analysis Period",
+		fmt.Sprintf("%s to %s", cf.formatTimeForCSV(data.TimeRange.Start), cf.formatTimeForCSV(data.TimeRange.End))})
 
-	return nil
+	return table
 }
 
 // formatSummaryCSV formats summary statistics as CSV
 func (cf *CSVFormatterImpl) formatSummaryCSV(summary *models.StatsSummary) ([]byte, error) {
-	var buf bytes.Buffer
-	writer := csv.NewWriter(&buf)
-
-	// Write header
-	headers := []string{"Metric", "Value"}
-	if err := writer.Write(headers); err != nil {
-		return nil, fmt.Errorf("failed to write summary CSV header: %w", err)
-	}
+	return cf.encodeTable(cf.summaryTable(summary), DefaultCSVDialect())
+}
 
-	// Write summary data
-	summaryData := [][]string{
-		{"Total Commits", strconv.Itoa(summary.TotalCommits)},
-		{"Total Insertions", strconv.Itoa(summary.TotalInsertions)},
-		{"Total Deletions", strconv.Itoa(summary.TotalDeletions)},
-		{"Files Changed", strconv.Itoa(summary.FilesChanged)},
-		{"Active Days", strconv.Itoa(summary.ActiveDays)},
-		{"Avg Commits Per Day", fmt.Sprintf("%.2f", summary.AvgCommitsPerDay)},
+// summaryTable builds the summary metric/value table
+func (cf *CSVFormatterImpl) summaryTable(summary *models.StatsSummary) CSVTable {
+	return CSVTable{
+		Name:        "summary",
+		FileName:    "summary.csv",
+		Description: "Repository-wide totals for the analysis period",
+		Headers:     []string{"Metric", "Value"},
+		Rows: [][]string{
+			{"Total Commits", strconv.Itoa(summary.TotalCommits)},
+			{"Total Insertions", strconv.Itoa(summary.TotalInsertions)},
+			{"Total Deletions", strconv.Itoa(summary.TotalDeletions)},
+			{"Files Changed", strconv.Itoa(summary.FilesChanged)},
+			{"Active Days", strconv.Itoa(summary.ActiveDays)},
+			{"Avg Commits Per Day", fmt.Sprintf("%.2f", summary.AvgCommitsPerDay)},
+		},
 	}
-
-	for _, record := range summaryData {
-		if err := writer.Write(record); err != nil {
-			return nil, fmt.Errorf("failed to write summary record: %w", err)
-		}
-	}
-
-	writer.Flush()
-	if err := writer.Error(); err != nil {
-		return nil, fmt.Errorf("summary CSV writer error: %w", err)
-	}
-
-	return buf.Bytes(), nil
 }
 
 // formatFilesCSV formats file statistics as CSV
 func (cf *CSVFormatterImpl) formatFilesCSV(files []models.FileStats) ([]byte, error) {
-	var buf bytes.Buffer
-	writer := csv.NewWriter(&buf)
+	return cf.encodeTable(cf.filesTable(files), DefaultCSVDialect())
+}
 
-	// Write header
-	headers := []string{"Path", "Commits", "Insertions", "Deletions", "Last Modified"}
-	if err := writer.Write(headers); err != nil {
-		return nil, fmt.Errorf("failed to write files CSV header: %w", err)
+// filesTable builds the most frequently changed files table
+func (cf *CSVFormatterImpl) filesTable(files []models.FileStats) CSVTable {
+	table := CSVTable{
+		Name:        "files",
+		FileName:    "files.csv",
+		Description: "Most frequently changed files",
+		Headers:     []string{"Path", "Commits", "Insertions", "Deletions", "Last Modified"},
 	}
 
-	// Write file data
 	for _, file := range files {
-		record := []string{
+		table.Rows = append(table.Rows, []string{
 			file.Path,
 			strconv.Itoa(file.Commits),
 			strconv.Itoa(file.Insertions),
 			strconv.Itoa(file.Deletions),
 			cf.formatTimeForCSV(file.LastModified),
-		}
-		if err := writer.Write(record); err != nil {
-			return nil, fmt.Errorf("failed to write file record: %w", err)
-		}
+		})
 	}
 
-	writer.Flush()
-	if err := writer.Error(); err != nil {
-		return nil, fmt.Errorf("files CSV writer error: %w", err)
-	}
-
-	return buf.Bytes(), nil
+	return table
 }
 
 // formatFileTypesCSV formats file type statistics as CSV
 func (cf *CSVFormatterImpl) formatFileTypesCSV(fileTypes []models.FileTypeStats) ([]byte, error) {
-	var buf bytes.Buffer
-	writer := csv.NewWriter(&buf)
+	return cf.encodeTable(cf.fileTypesTable(fileTypes), DefaultCSVDialect())
+}
 
-	// Write header
-	headers := []string{"Extension", "Files", "Commits", "Lines"}
-	if err := writer.Write(headers); err != nil {
-		return nil, fmt.Errorf("failed to write file types CSV header: %w", err)
+// fileTypesTable builds the file type statistics table
+func (cf *CSVFormatterImpl) fileTypesTable(fileTypes []models.FileTypeStats) CSVTable {
+	table := CSVTable{
+		Name:        "file_types",
+		FileName:    "file_types.csv",
+		Description: "Changes grouped by file extension",
+		Headers:     []string{"Extension", "Files", "Commits", "Lines"},
 	}
 
-	// Write file type data
 	for _, fileType := range fileTypes {
-		record := []string{
+		table.Rows = append(table.Rows, []string{
 			fileType.Extension,
 			strconv.Itoa(fileType.Files),
 			strconv.Itoa(fileType.Commits),
 			strconv.Itoa(fileType.Lines),
-		}
-		if err := writer.Write(record); err != nil {
-			return nil, fmt.Errorf("failed to write file type record: %w", err)
-		}
-	}
-
-	writer.Flush()
-	if err := writer.Error(); err != nil {
-		return nil, fmt.Errorf("file types CSV writer error: %w", err)
+		})
 	}
 
-	return buf.Bytes(), nil
+	return table
 }
 
 // formatContributionGraphCSV formats contribution graph as CSV
 func (cf *CSVFormatterImpl) formatContributionGraphCSV(graph *models.ContributionGraph) ([]byte, error) {
-	var buf bytes.Buffer
-	writer := csv.NewWriter(&buf)
+	return cf.encodeTable(cf.contributionGraphTable(graph), DefaultCSVDialect())
+}
 
-	// Write header
-	headers := []string{"Date", "Commits"}
-	if err := writer.Write(headers); err != nil {
-		return nil, fmt.Errorf("failed to write contribution graph CSV header: %w", err)
+// contributionGraphTable builds the daily contributions table in date order
+func (cf *CSVFormatterImpl) contributionGraphTable(graph *models.ContributionGraph) CSVTable {
+	table := CSVTable{
+		Name:        "daily_contributions",
+		FileName:    "daily_contributions.csv",
+		Description: models.MetricTitle(graph.Metric) + " per day in the contribution graph range",
+		Headers:     []string{"Date", models.MetricTitle(graph.Metric)},
 	}
 
-	// Write daily contribution data
-	for date, commits := range graph.DailyCommits {
-		record := []string{
-			date,
-			strconv.Itoa(commits),
-		}
-		if err := writer.Write(record); err != nil {
-			return nil, fmt.Errorf("failed to write contribution record: %w", err)
-		}
+	dates := make([]string, 0, len(graph.DailyValues))
+	for date := range graph.DailyValues {
+		dates = append(dates, date)
 	}
+	sort.Strings(dates)
 
-	writer.Flush()
-	if err := writer.Error(); err != nil {
-		return nil, fmt.Errorf("contribution graph CSV writer error: %w", err)
+	for _, date := range dates {
+		table.Rows = append(table.Rows, []string{date, strconv.Itoa(graph.DailyValues[date])})
 	}
 
-	return buf.Bytes(), nil
+	return table
 }
 
 // escapeCSVField properly escapes CSV fields
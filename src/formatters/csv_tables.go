@@ -0,0 +1,268 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - CSV table set output (directory or zip of CSV files)
+
+package formatters
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"git-stats/models"
+)
+
+// CSV dialect names accepted by GetCSVDialect
+const (
+	CSVDialectDefault = "default"
+	CSVDialectRFC4180 = "rfc4180"
+	CSVDialectExcel   = "excel"
+)
+
+// CSVManifestFile is the name of the manifest written alongside split CSV tables
+const CSVManifestFile = "manifest.json"
+
+// utf8BOM lets Excel detect UTF-8 encoded CSV files
+const utf8BOM = "\ufeff"
+
+// CSVDialect describes how CSV tables are encoded
+type CSVDialect struct {
+	Name      string
+	Delimiter rune
+	UseCRLF   bool
+	BOM       bool
+	Strict    bool // RFC 4180: CRLF line endings, no comment lines, uniform field counts
+}
+
+// CSVTable is a single well-formed CSV table with a stable header
+type CSVTable struct {
+	Name        string
+	FileName    string
+	Description string
+	Headers     []string
+	Rows        [][]string
+}
+
+// DefaultCSVDialect returns the dialect used for plain CSV output
+func DefaultCSVDialect() CSVDialect {
+	return CSVDialect{Name: CSVDialectDefault, Delimiter: ','}
+}
+
+// GetCSVDialect returns the dialect with the given name; an empty name selects the default
+func GetCSVDialect(name string) (CSVDialect, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", CSVDialectDefault:
+		return DefaultCSVDialect(), nil
+	case CSVDialectRFC4180:
+		return CSVDialect{Name: CSVDialectRFC4180, Delimiter: ',', UseCRLF: true, Strict: true}, nil
+	case CSVDialectExcel:
+		return CSVDialect{Name: CSVDialectExcel, Delimiter: ';', UseCRLF: true, BOM: true}, nil
+	default:
+		return CSVDialect{}, NewFormatterOperationError("csv_dialect",
+			fmt.Sprintf("unknown CSV dialect '%s'. Valid dialects: %s", name, strings.Join(CSVDialectNames(), ", ")))
+	}
+}
+
+// CSVDialectNames returns the names of the supported CSV dialects
+func CSVDialectNames() []string {
+	return []string{CSVDialectDefault, CSVDialectRFC4180, CSVDialectExcel}
+}
+
+// lineEnding returns the record terminator for the dialect
+func (d CSVDialect) lineEnding() string {
+	if d.UseCRLF {
+		return "\r\n"
+	}
+	return "\n"
+}
+
+// BuildCSVTables returns every section of the analysis as a separate table, including empty ones
+func (cf *CSVFormatterImpl) BuildCSVTables(data *models.AnalysisResult, config models.FormatConfig) []CSVTable {
+	var tables []CSVTable
+
+	if config.Metadata {
+		tables = append(tables, cf.metadataTable(data))
+	}
+
+	summary := data.Summary
+	if summary == nil {
+		summary = &models.StatsSummary{}
+	}
+
+	summaryTable := cf.summaryTable(summary)
+	if data.Summary == nil {
+		summaryTable.Rows = nil
+	}
+
+	graph := data.ContribGraph
+	if graph == nil {
+		graph = &models.ContributionGraph{}
+	}
+
+	tables = append(tables,
+		summaryTable,
+		cf.contributorsTable(data.Contributors),
+		cf.filesTable(summary.TopFiles),
+		cf.fileTypesTable(summary.TopFileTypes),
+		cf.contributionGraphTable(graph),
+	)
+
+	// Punch cards are written only by analyses that count commits by hour and weekday
+	if summary.PunchCard.Total() > 0 {
+		tables = append(tables, cf.punchCardTable(&summary.PunchCard))
+	}
+	if cf.hasContributorPunchCards(data.Contributors) {
+		tables = append(tables, cf.contributorPunchCardsTable(data.Contributors))
+	}
+
+	// Directory statistics, team rollups and code growth are written only by the analyses that
+	// compute them
+	if len(data.Directories) > 0 {
+		tables = append(tables, cf.directoriesTable(data.Directories))
+	}
+	if len(data.Teams) > 0 {
+		tables = append(tables, cf.teamsTable(data.Teams))
+	}
+	if data.Growth != nil {
+		tables = append(tables, cf.codeGrowthTable(data.Growth))
+	}
+
+	return tables
+}
+
+// FormatCSVTables encodes each table as its own file and appends a JSON manifest describing them
+func (cf *CSVFormatterImpl) FormatCSVTables(data *models.AnalysisResult, config models.FormatConfig) ([]NamedOutput, error) {
+	if data == nil {
+		return nil, NewFormatterError("analysis result cannot be nil")
+	}
+
+	dialect, err := GetCSVDialect(config.CSVDialect)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := cf.BuildCSVTables(data, config)
+	files := make([]NamedOutput, 0, len(tables)+1)
+
+	for _, table := range tables {
+		encoded, err := cf.encodeTable(table, dialect)
+		if err != nil {
+			return nil, NewFormatterOperationError(table.Name, err.Error())
+		}
+		if dialect.BOM {
+			encoded = append([]byte(utf8BOM), encoded...)
+		}
+		files = append(files, NamedOutput{Name: table.FileName, Data: encoded})
+	}
+
+	manifest, err := cf.buildManifest(data, tables, dialect)
+	if err != nil {
+		return nil, NewFormatterOperationError("manifest", err.Error())
+	}
+	files = append(files, NamedOutput{Name: CSVManifestFile, Data: manifest})
+
+	return files, nil
+}
+
+// FormatCSVZip encodes the table set as a zip archive
+func (cf *CSVFormatterImpl) FormatCSVZip(data *models.AnalysisResult, config models.FormatConfig) ([]byte, error) {
+	files, err := cf.FormatCSVTables(data, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return PackZip(files)
+}
+
+// encodeTable writes a table using the given dialect
+func (cf *CSVFormatterImpl) encodeTable(table CSVTable, dialect CSVDialect) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	writer.Comma = dialect.Delimiter
+	writer.UseCRLF = dialect.UseCRLF
+
+	if err := writer.Write(table.Headers); err != nil {
+		return nil, fmt.Errorf("failed to write %s CSV header: %w", table.Name, err)
+	}
+
+	for i, record := range table.Rows {
+		if dialect.Strict && len(record) != len(table.Headers) {
+			return nil, fmt.Errorf("%s row %d has %d fields, expected %d", table.Name, i+1, len(record), len(table.Headers))
+		}
+		if err := writer.Write(record); err != nil {
+			return nil, fmt.Errorf("failed to write %s record: %w", table.Name, err)
+		}
+	}
+
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("%s CSV writer error: %w", table.Name, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// csvManifest describes a split CSV table set
+type csvManifest struct {
+	GeneratedAt string             `json:"generated_at"`
+	Version     string             `json:"version"`
+	Repository  string             `json:"repository,omitempty"`
+	Dialect     csvManifestDialect `json:"dialect"`
+	Tables      []csvManifestTable `json:"tables"`
+}
+
+// csvManifestDialect describes the encoding used by every table in the set
+type csvManifestDialect struct {
+	Name       string `json:"name"`
+	Delimiter  string `json:"delimiter"`
+	LineEnding string `json:"line_ending"`
+	BOM        bool   `json:"bom"`
+	Encoding   string `json:"encoding"`
+}
+
+// csvManifestTable describes one CSV file in the set
+type csvManifestTable struct {
+	Name        string   `json:"name"`
+	File        string   `json:"file"`
+	Description string   `json:"description"`
+	Columns     []string `json:"columns"`
+	Rows        int      `json:"rows"`
+}
+
+// buildManifest builds the JSON manifest for a table set
+func (cf *CSVFormatterImpl) buildManifest(data *models.AnalysisResult, tables []CSVTable, dialect CSVDialect) ([]byte, error) {
+	lineEnding := "LF"
+	if dialect.UseCRLF {
+		lineEnding = "CRLF"
+	}
+
+	manifest := csvManifest{
+		GeneratedAt: time.Now().UTC().Format(time.RFC3339),
+		Version:     "1.0",
+		Dialect: csvManifestDialect{
+			Name:       dialect.Name,
+			Delimiter:  string(dialect.Delimiter),
+			LineEnding: lineEnding,
+			BOM:        dialect.BOM,
+			Encoding:   "UTF-8",
+		},
+	}
+
+	if data.Repository != nil {
+		manifest.Repository = data.Repository.Name
+	}
+
+	for _, table := range tables {
+		manifest.Tables = append(manifest.Tables, csvManifestTable{
+			Name:        table.Name,
+			File:        table.FileName,
+			Description: table.Description,
+			Columns:     table.Headers,
+			Rows:        len(table.Rows),
+		})
+	}
+
+	return json.MarshalIndent(manifest, "", "  ")
+}
@@ -4,6 +4,8 @@
 package formatters
 
 import (
+	"archive/zip"
+	"bytes"
 	"fmt"
 	"io/fs"
 	"os"
@@ -127,6 +129,65 @@ type FormatterConfig struct {
 	OutputConfig FileOutputConfig
 }
 
+// NamedOutput is one file of a multi-file output such as a CSV table set
+type NamedOutput struct {
+	Name string
+	Data []byte
+}
+
+// WriteFileSet writes several named outputs into the directory given by config.OutputPath
+func (foh *FileOutputHandler) WriteFileSet(files []NamedOutput, config FileOutputConfig) error {
+	if config.OutputPath == "" {
+		return NewFormatterError("output path cannot be empty")
+	}
+
+	if stat, err := os.Stat(config.OutputPath); err == nil && !stat.IsDir() {
+		return NewFormatterOperationError("file_set", fmt.Sprintf("output path is not a directory: %s", config.OutputPath))
+	}
+
+	if err := os.MkdirAll(config.OutputPath, 0755); err != nil {
+		return NewFormatterOperationError("create_directories", fmt.Sprintf("failed to create directories: %v", err))
+	}
+
+	for _, file := range files {
+		fileConfig := config
+		fileConfig.OutputPath = filepath.Join(config.OutputPath, file.Name)
+		fileConfig.CreateDirs = false
+		if err := foh.WriteToFile(file.Data, fileConfig); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PackZip bundles named outputs into a zip archive
+func PackZip(files []NamedOutput) ([]byte, error) {
+	var buf bytes.Buffer
+	archive := zip.NewWriter(&buf)
+
+	for _, file := range files {
+		header := &zip.FileHeader{
+			Name:     file.Name,
+			Method:   zip.Deflate,
+			Modified: time.Now(),
+		}
+		writer, err := archive.CreateHeader(header)
+		if err != nil {
+			return nil, NewFormatterOperationError("zip", fmt.Sprintf("failed to add %s: %v", file.Name, err))
+		}
+		if _, err := writer.Write(file.Data); err != nil {
+			return nil, NewFormatterOperationError("zip", fmt.Sprintf("failed to write %s: %v", file.Name, err))
+		}
+	}
+
+	if err := archive.Close(); err != nil {
+		return nil, NewFormatterOperationError("zip", fmt.Sprintf("failed to finalize archive: %v", err))
+	}
+
+	return buf.Bytes(), nil
+}
+
 // fileExists checks if a file exists
 func (foh *FileOutputHandler) fileExists(path string) (bool, error) {
 	_, err := os.Stat(path)
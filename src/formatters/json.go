@@ -83,6 +83,9 @@ func (jf *JSONFormatterImpl) prepareJSONOutput(data *models.AnalysisResult, conf
 	// Add contribution graph
 	if data.ContribGraph != nil {
 		output["contribution_graph"] = jf.formatContributionGraph(data.ContribGraph)
+		if len(data.ContribYears) > 0 {
+			output["contribution_years"] = jf.formatContributionYears(data.ContribYears, data.ContribGraph.Metric)
+		}
 	}
 
 	// Add health metrics
@@ -90,6 +93,41 @@ func (jf *JSONFormatterImpl) prepareJSONOutput(data *models.AnalysisResult, conf
 		output["health_metrics"] = jf.formatHealthMetrics(data.HealthMetrics)
 	}
 
+	// Add contributor comparison
+	if data.Comparison != nil {
+		output["comparison"] = data.Comparison
+	}
+
+	// Add file tree
+	if data.FileTree != nil {
+		output["file_tree"] = jf.formatFileTree(data.FileTree)
+	}
+
+	// Add commit graph
+	if data.CommitGraph != nil {
+		output["commit_graph"] = jf.formatCommitGraph(data.CommitGraph)
+	}
+
+	// Add directory and module statistics
+	if len(data.Directories) > 0 {
+		output["directories"] = jf.formatDirectories(data.Directories)
+	}
+
+	// Add team rollups
+	if len(data.Teams) > 0 {
+		output["teams"] = jf.formatTeams(data.Teams)
+	}
+
+	// Add code growth
+	if data.Growth != nil {
+		output["code_growth"] = jf.formatCodeGrowth(data.Growth)
+	}
+
+	// Add GUI selection
+	if data.Selection != nil {
+		output["selection"] = jf.formatSelection(data.Selection)
+	}
+
 	return output
 }
 
@@ -138,6 +176,11 @@ func (jf *JSONFormatterImpl) formatStatsSummary(summary *models.StatsSummary) ma
 		result["commits_by_weekday"] = weekdayMap
 	}
 
+	// Format the hour by weekday punch card
+	if summary.PunchCard.Total() > 0 {
+		result["punch_card"] = jf.formatPunchCard(&summary.PunchCard)
+	}
+
 	// Format top files
 	if len(summary.TopFiles) > 0 {
 		topFiles := make([]map[string]interface{}, len(summary.TopFiles))
@@ -207,6 +250,11 @@ func (jf *JSONFormatterImpl) formatContributors(contributors []models.Contributo
 			contrib["commits_by_weekday"] = weekdayMap
 		}
 
+		// Add the punch card if available
+		if contributor.PunchCard.Total() > 0 {
+			contrib["punch_card"] = jf.formatPunchCard(&contributor.PunchCard)
+		}
+
 		// Add file types if available
 		if len(contributor.FileTypes) > 0 {
 			contrib["file_types"] = contributor.FileTypes
@@ -225,13 +273,56 @@ func (jf *JSONFormatterImpl) formatContributors(contributors []models.Contributo
 
 // formatContributionGraph formats contribution graph for JSON
 func (jf *JSONFormatterImpl) formatContributionGraph(graph *models.ContributionGraph) map[string]interface{} {
-	return map[string]interface{}{
-		"start_date":    jf.formatTime(graph.StartDate),
-		"end_date":      jf.formatTime(graph.EndDate),
-		"daily_commits": graph.DailyCommits,
-		"max_commits":   graph.MaxCommits,
-		"total_commits": graph.TotalCommits,
+	metric := graph.Metric
+	if metric == "" {
+		metric = models.MetricCommits
+	}
+	levels := graph.Levels
+	if levels == "" {
+		levels = models.LevelsLinear
+	}
+
+	result := map[string]interface{}{
+		"start_date":   jf.formatTime(graph.StartDate),
+		"end_date":     jf.formatTime(graph.EndDate),
+		"metric":       metric,
+		"daily_values": graph.DailyValues,
+		"max_value":    graph.MaxValue,
+		"total":        graph.Total,
+		"levels": map[string]interface{}{
+			"strategy":   levels,
+			"thresholds": graph.LevelThresholds(),
+			"ranges":     models.LevelRanges(graph.LevelThresholds()),
+		},
+	}
+
+	// The commit-named keys of earlier versions stay for the commits metric only
+	if metric == models.MetricCommits {
+		result["daily_commits"] = graph.DailyValues
+		result["max_commits"] = graph.MaxValue
+		result["total_commits"] = graph.Total
 	}
+
+	return result
+}
+
+// formatContributionYears formats the per-year contribution totals of the graph's metric for JSON
+func (jf *JSONFormatterImpl) formatContributionYears(years []models.ContributionYear, metric string) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(years))
+	for i, year := range years {
+		result[i] = map[string]interface{}{
+			"year":           year.Year,
+			"total":          year.Total,
+			"active_days":    year.ActiveDays,
+			"max_value":      year.MaxValue,
+			"longest_streak": year.LongestStreak,
+		}
+		if metric == "" || metric == models.MetricCommits {
+			result[i]["total_commits"] = year.Total
+			result[i]["max_commits"] = year.MaxValue
+		}
+	}
+	return result
 }
 
 // formatHealthMetrics formats health metrics for JSON
@@ -261,6 +352,225 @@ func (jf *JSONFormatterImpl) formatHealthMetrics(health *models.HealthMetrics) m
 	return result
 }
 
+// formatFileTree formats a file tree node and everything below it for JSON
+func (jf *JSONFormatterImpl) formatFileTree(node *models.FileTreeNode) map[string]interface{} {
+	result := map[string]interface{}{
+		"name":          node.Name,
+		"path":          node.Path,
+		"is_dir":        node.IsDir,
+		"commits":       node.Commits,
+		"insertions":    node.Insertions,
+		"deletions":     node.Deletions,
+		"authors":       node.Authors,
+		"top_author":    node.TopAuthor,
+		"last_modified": jf.formatTime(node.LastModified),
+	}
+
+	if len(node.Children) > 0 {
+		children := make([]map[string]interface{}, len(node.Children))
+		for i, child := range node.Children {
+			children[i] = jf.formatFileTree(child)
+		}
+		result["children"] = children
+	}
+
+	return result
+}
+
+// formatSelection formats the GUI selection for JSON, leaving out unset fields
+func (jf *JSONFormatterImpl) formatSelection(selection *models.ViewSelection) map[string]interface{} {
+	result := map[string]interface{}{
+		"view": selection.View,
+	}
+
+	if !selection.Date.IsZero() {
+		result["date"] = selection.Date.Format("2006-01-02")
+	}
+	if selection.Contributor != "" {
+		result["contributor"] = selection.Contributor
+	}
+	if selection.Path != "" {
+		result["path"] = selection.Path
+	}
+	if selection.Filters != "" {
+		result["filters"] = selection.Filters
+	}
+
+	if len(selection.Commits) > 0 {
+		commits := make([]map[string]interface{}, len(selection.Commits))
+		for i, commit := range selection.Commits {
+			commits[i] = map[string]interface{}{
+				"hash":          commit.Hash,
+				"author":        commit.Author.Name,
+				"email":         commit.Author.Email,
+				"date":          jf.formatTime(commit.AuthorDate),
+				"message":       commit.Message,
+				"files_changed": commit.Stats.FilesChanged,
+				"insertions":    commit.Stats.Insertions,
+				"deletions":     commit.Stats.Deletions,
+			}
+		}
+		result["commits"] = commits
+	}
+
+	return result
+}
+
+// formatCommitGraph formats the commit graph for JSON, one entry per commit with its lane and
+// the lane glyphs drawn on its line
+func (jf *JSONFormatterImpl) formatCommitGraph(graph *models.CommitGraph) map[string]interface{} {
+	rows := make([]map[string]interface{}, len(graph.Rows))
+	for i, row := range graph.Rows {
+		refs := row.Refs
+		if refs == nil {
+			refs = []string{}
+		}
+		parents := row.Commit.ParentHashes
+		if parents == nil {
+			parents = []string{}
+		}
+
+		rows[i] = map[string]interface{}{
+			"hash":    row.Commit.Hash,
+			"parents": parents,
+			"lane":    row.Lane,
+			"graph":   row.Graph,
+			"refs":    refs,
+			"merge":   row.Merge(),
+			"author":  row.Commit.Author.Name,
+			"email":   row.Commit.Author.Email,
+			"date":    jf.formatTime(row.Commit.AuthorDate),
+			"message": row.Commit.Message,
+		}
+	}
+
+	return map[string]interface{}{
+		"lanes":   graph.MaxLanes,
+		"commits": rows,
+	}
+}
+
+// formatPunchCard formats a punch card for JSON as one entry per weekday, Sunday first, with
+// the commits of each hour
+func (jf *JSONFormatterImpl) formatPunchCard(card *models.PunchCard) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(card))
+	for weekday, hours := range card {
+		result[weekday] = map[string]interface{}{
+			"weekday": time.Weekday(weekday).String(),
+			"hours":   hours,
+			"total":   card.WeekdayTotal(time.Weekday(weekday)),
+		}
+	}
+	return result
+}
+
+// formatDirectories formats the directory and module statistics for JSON
+func (jf *JSONFormatterImpl) formatDirectories(directories []models.DirectoryStats) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(directories))
+	for i := range directories {
+		directory := &directories[i]
+
+		authors := make([]map[string]interface{}, len(directory.TopAuthors))
+		for j, author := range directory.TopAuthors {
+			authors[j] = map[string]interface{}{
+				"name":       author.Name,
+				"email":      author.Email,
+				"commits":    author.Commits,
+				"percentage": author.Percentage,
+			}
+		}
+
+		result[i] = map[string]interface{}{
+			"path":           directory.Path,
+			"module":         directory.IsModule(),
+			"files":          directory.Files,
+			"commits":        directory.Commits,
+			"insertions":     directory.Insertions,
+			"deletions":      directory.Deletions,
+			"authors":        directory.Authors,
+			"top_authors":    authors,
+			"first_change":   jf.formatTime(directory.FirstChange),
+			"last_change":    jf.formatTime(directory.LastChange),
+			"activity_trend": directory.Trend,
+			"weekly_commits": directory.WeeklyCommits,
+		}
+		if directory.IsModule() {
+			result[i]["manifest"] = directory.Manifest
+		}
+	}
+	return result
+}
+
+// formatTeams formats the team rollups for JSON
+func (jf *JSONFormatterImpl) formatTeams(teams []models.TeamStats) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(teams))
+	for i := range teams {
+		team := &teams[i]
+		result[i] = map[string]interface{}{
+			"name":         team.Name,
+			"members":      team.Members,
+			"commits":      team.Commits,
+			"commit_share": team.CommitShare,
+			"insertions":   team.Insertions,
+			"deletions":    team.Deletions,
+			"churn":        team.Churn(),
+			"active_days":  team.ActiveDays,
+			"files":        team.Files,
+			"owned_files":  team.OwnedFiles,
+			"ownership":    team.Ownership,
+			"first_commit": jf.formatTime(team.FirstCommit),
+			"last_commit":  jf.formatTime(team.LastCommit),
+		}
+	}
+	return result
+}
+
+// formatCodeGrowth formats the code growth series for JSON; groups appear only with a breakdown
+func (jf *JSONFormatterImpl) formatCodeGrowth(growth *models.CodeGrowth) map[string]interface{} {
+	result := map[string]interface{}{
+		"interval":       growth.Interval,
+		"excluded_files": growth.ExcludedFiles,
+		"excluded_lines": growth.ExcludedLines,
+		"net_lines":      growth.Total.Final(),
+		"points":         jf.formatGrowthPoints(growth.Total.Points),
+	}
+
+	if len(growth.Exclude) > 0 {
+		result["exclude"] = growth.Exclude
+	}
+
+	if growth.Breakdown != "" {
+		result["breakdown"] = growth.Breakdown
+		groups := make([]map[string]interface{}, len(growth.Groups))
+		for i := range growth.Groups {
+			group := &growth.Groups[i]
+			groups[i] = map[string]interface{}{
+				"name":      group.Name,
+				"net_lines": group.Final(),
+				"points":    jf.formatGrowthPoints(group.Points),
+			}
+		}
+		result["groups"] = groups
+	}
+
+	return result
+}
+
+// formatGrowthPoints formats the points of a code growth series, oldest period first
+func (jf *JSONFormatterImpl) formatGrowthPoints(points []models.GrowthPoint) []map[string]interface{} {
+	result := make([]map[string]interface{}, len(points))
+	for i, point := range points {
+		result[i] = map[string]interface{}{
+			"period":     point.Period.Format("2006-01-02"),
+			"insertions": point.Insertions,
+			"deletions":  point.Deletions,
+			"net":        point.Net,
+			"total":      point.Total,
+		}
+	}
+	return result
+}
+
 // formatTime formats time for JSON output
 func (jf *JSONFormatterImpl) formatTime(t time.Time) interface{} {
 	if t.IsZero() {
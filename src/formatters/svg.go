@@ -0,0 +1,73 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - SVG contribution graph and punch card formatter
+
+package formatters
+
+import (
+	"fmt"
+
+	"git-stats/models"
+	"git-stats/visualizers"
+)
+
+// SVGFormatterImpl renders the contribution graph or the hour by weekday punch card of the
+// analysis as an SVG image
+type SVGFormatterImpl struct{}
+
+// NewSVGFormatter creates a new SVG formatter instance
+func NewSVGFormatter() *SVGFormatterImpl {
+	return &SVGFormatterImpl{}
+}
+
+// Format implements the Formatter interface. config.Command "contrib" draws the contribution
+// graph, per author when config.ByAuthor is set, and any other command the punch card;
+// config.Theme colors the days and circles
+func (sf *SVGFormatterImpl) Format(data *models.AnalysisResult, config models.FormatConfig) ([]byte, error) {
+	if data == nil {
+		return nil, NewFormatterError("analysis result cannot be nil")
+	}
+	if config.Command == "contrib" {
+		return sf.formatContrib(data, config)
+	}
+	if data.Summary == nil {
+		return nil, NewFormatterOperationError("svg", "no punch card data available")
+	}
+
+	title := "Punch card"
+	if data.Repository != nil && data.Repository.Name != "" {
+		title = fmt.Sprintf("%s punch card", data.Repository.Name)
+	}
+
+	renderer := visualizers.NewPunchCardRenderer(models.RenderConfig{})
+	renderer.SetTheme(config.Theme)
+
+	svg, err := renderer.RenderPunchCardSVG(&data.Summary.PunchCard, title)
+	if err != nil {
+		return nil, NewFormatterOperationError("svg", err.Error())
+	}
+	return []byte(svg), nil
+}
+
+// formatContrib renders the contribution graph as an SVG image
+func (sf *SVGFormatterImpl) formatContrib(data *models.AnalysisResult, config models.FormatConfig) ([]byte, error) {
+	if data.ContribGraph == nil {
+		return nil, NewFormatterOperationError("svg", "no contribution data available")
+	}
+
+	title := "Contributions"
+	if data.Repository != nil && data.Repository.Name != "" {
+		title = fmt.Sprintf("%s contributions", data.Repository.Name)
+	}
+	if config.ByAuthor != "" {
+		title += " by author"
+	}
+
+	renderer := visualizers.NewContributionGraphRenderer(models.RenderConfig{})
+	renderer.SetTheme(config.Theme)
+
+	svg, err := renderer.RenderContributionGraphSVG(data.ContribGraph, title, config.ByAuthor, config.TopAuthors)
+	if err != nil {
+		return nil, NewFormatterOperationError("svg", err.Error())
+	}
+	return []byte(svg), nil
+}
@@ -0,0 +1,373 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - User-supplied text/template output formatter
+
+package formatters
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"git-stats/models"
+)
+
+// Template function map available to user templates:
+//
+//	humanizeDuration d       - "3 days", "4 months", "2 years, 1 month"
+//	percent part total       - part/total as a percentage, e.g. "42.5%"
+//	padLeft n s / padRight n s - pad s with spaces to width n
+//	truncate n s             - shorten s to n characters with an ellipsis
+//	firstLine s              - first line of s, e.g. a commit subject
+//	sortContributors key cs  - sort contributors by commits, insertions,
+//	                           deletions, lines, active_days or name (descending)
+//	topN n list              - first n elements of a slice
+//	formatDate layout t      - format a time; layout may be iso, short, long,
+//	                           relative ("3 days ago", "in 2 days") or any
+//	                           Go time layout
+//	add a b / sub a b        - integer arithmetic
+//	join sep list            - strings.Join
+//	upper s / lower s        - case conversion
+//	replace old new s        - replace every old in s with new
+//	repeat n s               - strings.Repeat
+
+// builtinTemplates contains the named templates shipped with git-stats
+var builtinTemplates = map[string]string{
+	"oneline": `{{with .Repository}}{{.Name}}: {{end}}{{with .Summary}}{{.TotalCommits}} commits, +{{.TotalInsertions}}/-{{.TotalDeletions}}{{end}}, {{len .Contributors}} contributors
+`,
+	"statusbar": `{{with .Summary}}{{.TotalCommits}}c {{.ActiveDays}}d{{end}}{{with .HealthMetrics}} {{.ActivityTrend}}{{end}}
+`,
+	"slack": `*{{with .Repository}}{{.Name}}{{else}}Repository{{end}}* — {{formatDate "short" .TimeRange.Start}} to {{formatDate "short" .TimeRange.End}}
+{{with .Summary}}• {{.TotalCommits}} commits over {{.ActiveDays}} active days (+{{.TotalInsertions}} / -{{.TotalDeletions}})
+{{end}}{{$total := 0}}{{with .Summary}}{{$total = .TotalCommits}}{{end}}{{range topN 5 (sortContributors "commits" .Contributors)}}• {{.Name}}: {{.TotalCommits}} commits ({{percent .TotalCommits $total}})
+{{end}}`,
+	"markdown": `# {{with .Repository}}{{.Name}}{{else}}Repository{{end}} report
+
+Period: {{formatDate "short" .TimeRange.Start}} – {{formatDate "short" .TimeRange.End}}
+{{with .Selection}}
+Exported from the {{.View}} view{{if not .Date.IsZero}} on {{formatDate "short" .Date}}{{end}}{{with .Contributor}} for {{.}}{{end}}{{with .Path}} for ` + "`{{.}}`" + `{{end}}.
+{{with .Filters}}Filters: {{.}}
+{{end}}{{if .Commits}}
+| Commit | Author | Date | Message | +/- |
+| ------ | ------ | ---- | ------- | --- |
+{{range .Commits}}| {{printf "%.7s" .Hash}} | {{.Author.Name}} | {{formatDate "short" .AuthorDate}} | {{firstLine .Message | replace "|" "\\|"}} | +{{.Stats.Insertions}}/-{{.Stats.Deletions}} |
+{{end}}{{end}}{{end}}{{with .Summary}}
+| Metric | Value |
+| ------ | ----- |
+| Commits | {{.TotalCommits}} |
+| Insertions | {{.TotalInsertions}} |
+| Deletions | {{.TotalDeletions}} |
+| Active days | {{.ActiveDays}} |
+{{end}}{{if .Contributors}}
+## Top contributors
+
+| Name | Commits | +/- |
+| ---- | ------- | --- |
+{{range topN 10 (sortContributors "commits" .Contributors)}}| {{.Name}} | {{.TotalCommits}} | +{{.TotalInsertions}}/-{{.TotalDeletions}} |
+{{end}}{{end}}{{with .HealthMetrics}}
+## Health
+
+Repository age: {{humanizeDuration .RepositoryAge}}, trend: {{.ActivityTrend}}
+{{end}}{{with .FileTree}}
+## Files
+
+| Path | Commits | +/- | Authors | Top author | Last modified |
+| ---- | ------- | --- | ------- | ---------- | ------------- |
+| {{with .Path}}{{.}}{{else}}/{{end}}{{if and .IsDir .Path}}/{{end}} | {{.Commits}} | +{{.Insertions}}/-{{.Deletions}} | {{.Authors}} | {{.TopAuthor}} | {{formatDate "short" .LastModified}} |
+{{range .Children}}| {{.Path}}{{if .IsDir}}/{{end}} | {{.Commits}} | +{{.Insertions}}/-{{.Deletions}} | {{.Authors}} | {{.TopAuthor}} | {{formatDate "short" .LastModified}} |
+{{end}}{{end}}{{with .CommitGraph}}{{$width := add .MaxLanes .MaxLanes}}
+## Commit graph
+
+` + "```" + `
+{{range .Rows}}{{padRight $width .Graph}} {{printf "%.7s" .Commit.Hash}} {{with .Refs}}({{join ", " .}}) {{end}}{{firstLine .Commit.Message}}
+{{end}}` + "```" + `
+{{end}}`,
+}
+
+// templateLinePattern extracts the template name and line from text/template errors
+var templateLinePattern = regexp.MustCompile(`template: ([^:]+):(\d+)(?::(\d+))?: (.*)`)
+
+// TemplateFormatterImpl executes Go text/template templates against analysis results
+type TemplateFormatterImpl struct{}
+
+// NewTemplateFormatter creates a new template formatter instance
+func NewTemplateFormatter() *TemplateFormatterImpl {
+	return &TemplateFormatterImpl{}
+}
+
+// BuiltinTemplateNames returns the names of the built-in templates
+func BuiltinTemplateNames() []string {
+	names := make([]string, 0, len(builtinTemplates))
+	for name := range builtinTemplates {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsBuiltinTemplate reports whether name refers to a built-in template
+func IsBuiltinTemplate(name string) bool {
+	_, ok := builtinTemplates[name]
+	return ok
+}
+
+// Format implements the Formatter interface; config.Template names a built-in template or a file
+func (tf *TemplateFormatterImpl) Format(data *models.AnalysisResult, config models.FormatConfig) ([]byte, error) {
+	if data == nil {
+		return nil, NewFormatterError("analysis result cannot be nil")
+	}
+	if config.Template == "" {
+		return nil, NewFormatterOperationError("template", "no template specified")
+	}
+
+	name, text, err := tf.loadTemplate(config.Template)
+	if err != nil {
+		return nil, err
+	}
+
+	return tf.FormatTemplate(data, name, text)
+}
+
+// FormatTemplate parses and executes the given template text against the analysis result
+func (tf *TemplateFormatterImpl) FormatTemplate(data *models.AnalysisResult, name, text string) ([]byte, error) {
+	tmpl, err := template.New(name).Funcs(TemplateFuncs()).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, tf.describeError("parse", text, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, tf.describeError("execute", text, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// loadTemplate resolves a built-in template name or reads a template file
+func (tf *TemplateFormatterImpl) loadTemplate(ref string) (string, string, error) {
+	if text, ok := builtinTemplates[ref]; ok {
+		return ref, text, nil
+	}
+
+	content, err := os.ReadFile(ref)
+	if err != nil {
+		if os.IsNotExist(err) && !strings.ContainsAny(ref, `/\.`) {
+			return "", "", NewFormatterOperationError("template",
+				fmt.Sprintf("unknown template '%s'. Built-in templates: %s", ref, strings.Join(BuiltinTemplateNames(), ", ")))
+		}
+		return "", "", NewFormatterOperationError("template", fmt.Sprintf("failed to read template file: %v", err))
+	}
+
+	return filepath.Base(ref), string(content), nil
+}
+
+// describeError rewrites a text/template error so it points at the offending template line
+func (tf *TemplateFormatterImpl) describeError(stage, text string, err error) error {
+	match := templateLinePattern.FindStringSubmatch(err.Error())
+	if match == nil {
+		return NewFormatterOperationError("template", fmt.Sprintf("%s failed: %v", stage, err))
+	}
+
+	line, _ := strconv.Atoi(match[2])
+	location := fmt.Sprintf("%s line %d", match[1], line)
+	if match[3] != "" {
+		location += ", column " + match[3]
+	}
+
+	message := fmt.Sprintf("%s failed at %s: %s", stage, location, match[4])
+
+	lines := strings.Split(text, "\n")
+	if line >= 1 && line <= len(lines) {
+		message += fmt.Sprintf("\n  %d | %s", line, strings.TrimRight(lines[line-1], "\r"))
+	}
+
+	return NewFormatterOperationError("template", message)
+}
+
+// TemplateFuncs returns the function map available to user templates
+func TemplateFuncs() template.FuncMap {
+	return template.FuncMap{
+		"humanizeDuration": humanizeDuration,
+		"percent":          percent,
+		"padLeft":          padLeft,
+		"padRight":         padRight,
+		"truncate":         truncate,
+		"firstLine":        func(s string) string { return strings.SplitN(s, "\n", 2)[0] },
+		"sortContributors": sortContributors,
+		"topN":             topN,
+		"formatDate":       formatDate,
+		"add":              func(a, b int) int { return a + b },
+		"sub":              func(a, b int) int { return a - b },
+		"join":             func(sep string, list []string) string { return strings.Join(list, sep) },
+		"upper":            strings.ToUpper,
+		"lower":            strings.ToLower,
+		"replace":          func(old, replacement, s string) string { return strings.ReplaceAll(s, old, replacement) },
+		"repeat":           func(n int, s string) string { return strings.Repeat(s, max(n, 0)) },
+	}
+}
+
+// humanizeDuration renders a duration in days, months and years
+func humanizeDuration(d time.Duration) string {
+	if d < 24*time.Hour {
+		hours := int(d.Hours())
+		if hours == 1 {
+			return "1 hour"
+		}
+		return fmt.Sprintf("%d hours", hours)
+	}
+
+	days := int(d.Hours() / 24)
+	switch {
+	case days < 30:
+		return plural(days, "day")
+	case days < 365:
+		return plural(days/30, "month")
+	default:
+		years := days / 365
+		months := (days % 365) / 30
+		if months > 0 {
+			return plural(years, "year") + ", " + plural(months, "month")
+		}
+		return plural(years, "year")
+	}
+}
+
+// plural formats a count with a singular or plural unit
+func plural(n int, unit string) string {
+	if n == 1 {
+		return fmt.Sprintf("1 %s", unit)
+	}
+	return fmt.Sprintf("%d %ss", n, unit)
+}
+
+// percent formats part/total as a percentage with one decimal place
+func percent(part, total interface{}) (string, error) {
+	p, err := toFloat(part)
+	if err != nil {
+		return "", err
+	}
+	t, err := toFloat(total)
+	if err != nil {
+		return "", err
+	}
+	if t == 0 {
+		return "0.0%", nil
+	}
+	return fmt.Sprintf("%.1f%%", p/t*100), nil
+}
+
+// toFloat converts numeric template values to float64
+func toFloat(v interface{}) (float64, error) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), nil
+	case int64:
+		return float64(n), nil
+	case float64:
+		return n, nil
+	case float32:
+		return float64(n), nil
+	default:
+		return 0, fmt.Errorf("expected a number, got %T", v)
+	}
+}
+
+// padLeft right-aligns s in a field of width n
+func padLeft(n int, s interface{}) string {
+	return fmt.Sprintf("%*v", n, s)
+}
+
+// padRight left-aligns s in a field of width n
+func padRight(n int, s interface{}) string {
+	return fmt.Sprintf("%-*v", n, s)
+}
+
+// truncate shortens s to at most n characters
+func truncate(n int, s string) string {
+	runes := []rune(s)
+	if len(runes) <= n {
+		return s
+	}
+	if n <= 3 {
+		return string(runes[:max(n, 0)])
+	}
+	return string(runes[:n-3]) + "..."
+}
+
+// sortContributors returns a copy of contributors sorted by key in descending order
+func sortContributors(key string, contributors []models.ContributorStats) ([]models.ContributorStats, error) {
+	var less func(a, b models.ContributorStats) bool
+	switch key {
+	case "commits":
+		less = func(a, b models.ContributorStats) bool { return a.TotalCommits > b.TotalCommits }
+	case "insertions":
+		less = func(a, b models.ContributorStats) bool { return a.TotalInsertions > b.TotalInsertions }
+	case "deletions":
+		less = func(a, b models.ContributorStats) bool { return a.TotalDeletions > b.TotalDeletions }
+	case "lines":
+		less = func(a, b models.ContributorStats) bool {
+			return a.TotalInsertions+a.TotalDeletions > b.TotalInsertions+b.TotalDeletions
+		}
+	case "active_days":
+		less = func(a, b models.ContributorStats) bool { return a.ActiveDays > b.ActiveDays }
+	case "name":
+		less = func(a, b models.ContributorStats) bool { return strings.ToLower(a.Name) < strings.ToLower(b.Name) }
+	default:
+		return nil, fmt.Errorf("unknown sort key '%s' (use commits, insertions, deletions, lines, active_days or name)", key)
+	}
+
+	sorted := make([]models.ContributorStats, len(contributors))
+	copy(sorted, contributors)
+	sort.SliceStable(sorted, func(i, j int) bool { return less(sorted[i], sorted[j]) })
+	return sorted, nil
+}
+
+// topN returns the first n elements of a supported slice type
+func topN(n int, list interface{}) (interface{}, error) {
+	if n < 0 {
+		n = 0
+	}
+	switch l := list.(type) {
+	case []models.ContributorStats:
+		return l[:min(n, len(l))], nil
+	case []models.FileStats:
+		return l[:min(n, len(l))], nil
+	case []models.FileTypeStats:
+		return l[:min(n, len(l))], nil
+	case []models.MonthlyStats:
+		return l[:min(n, len(l))], nil
+	case []string:
+		return l[:min(n, len(l))], nil
+	default:
+		return nil, fmt.Errorf("topN: unsupported list type %T", list)
+	}
+}
+
+// formatDate formats a time using a named layout or a Go time layout
+func formatDate(layout string, t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	switch layout {
+	case "iso":
+		return t.Format(time.RFC3339)
+	case "short":
+		return t.Format("2006-01-02")
+	case "long":
+		return t.Format("January 2, 2006")
+	case "relative":
+		// Commit dates come from the committer's clock, which may be ahead of ours
+		if d := time.Since(t); d < 0 {
+			return "in " + humanizeDuration(-d)
+		}
+		return humanizeDuration(time.Since(t)) + " ago"
+	default:
+		return t.Format(layout)
+	}
+}
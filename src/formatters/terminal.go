@@ -0,0 +1,538 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Terminal (text report) output formatter
+
+package formatters
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"git-stats/analyzers"
+	"git-stats/models"
+	"git-stats/visualizers"
+)
+
+// ansiPattern matches ANSI escape sequences so plain output is guaranteed escape-free
+var ansiPattern = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+
+// terminalColors maps color names accepted by FormatColorized to ANSI codes
+var terminalColors = map[string]string{
+	"red":    visualizers.ColorRed,
+	"green":  visualizers.ColorGreen,
+	"yellow": visualizers.ColorYellow,
+	"blue":   visualizers.ColorBlue,
+	"purple": visualizers.ColorPurple,
+	"cyan":   visualizers.ColorCyan,
+	"white":  visualizers.ColorWhite,
+	"bold":   visualizers.ColorBold,
+	"dim":    visualizers.ColorDim,
+}
+
+// TerminalFormatterImpl implements the TerminalFormatter interface
+type TerminalFormatterImpl struct {
+	renderConfig models.RenderConfig
+	plain        bool
+}
+
+// NewTerminalFormatter creates a new terminal formatter instance
+func NewTerminalFormatter() *TerminalFormatterImpl {
+	return &TerminalFormatterImpl{
+		renderConfig: models.RenderConfig{
+			Width:       80,
+			Height:      25,
+			ColorScheme: "default",
+			ShowLegend:  true,
+			Interactive: false,
+		},
+	}
+}
+
+// Format implements the Formatter interface for terminal output
+func (tf *TerminalFormatterImpl) Format(data *models.AnalysisResult, config models.FormatConfig) ([]byte, error) {
+	if data == nil {
+		return nil, NewFormatterError("analysis result cannot be nil")
+	}
+
+	return tf.FormatTerminal(data, config)
+}
+
+// FormatTerminal renders the report for config.Command; config.NoColor produces plain text
+func (tf *TerminalFormatterImpl) FormatTerminal(data *models.AnalysisResult, config models.FormatConfig) ([]byte, error) {
+	tf.plain = config.NoColor
+	tf.renderConfig.FitWidth = config.Width > 0
+	if config.Width > 0 {
+		tf.renderConfig.Width = config.Width
+	}
+
+	colorTheme := config.ColorTheme
+	if colorTheme == "" {
+		colorTheme = "github"
+	}
+
+	var out strings.Builder
+	var err error
+
+	switch config.Command {
+	case "contrib", "":
+		err = tf.formatContrib(&out, data, colorTheme, config)
+	case "summary":
+		err = tf.formatSummary(&out, data)
+	case "contributors":
+		err = tf.formatContributors(&out, data)
+	case "files":
+		err = tf.formatFiles(&out, data)
+	case "health":
+		err = tf.formatHealth(&out, data)
+	case "compare":
+		err = tf.formatCompare(&out, data)
+	case "graph":
+		err = tf.formatGraph(&out, data, config)
+	case "punchcard":
+		err = tf.formatPunchCard(&out, data, config.Theme)
+	case "growth":
+		err = tf.formatGrowth(&out, data)
+	default:
+		return nil, NewFormatterOperationError("terminal", fmt.Sprintf("unknown command: %s", config.Command))
+	}
+	if err != nil {
+		return nil, NewFormatterOperationError("terminal", err.Error())
+	}
+
+	// Every report ends with the team rollups when grouped by team
+	if len(data.Teams) > 0 {
+		teamOutput, err := tf.chartsRenderer().RenderTeamStatistics(data.Teams, tf.renderConfig)
+		if err != nil {
+			return nil, NewFormatterOperationError("terminal", fmt.Sprintf("error rendering team statistics: %v", err))
+		}
+		out.WriteString("\n" + teamOutput)
+	}
+
+	text := out.String()
+	if tf.plain {
+		text = StripANSI(text)
+	}
+
+	return []byte(text), nil
+}
+
+// FormatColorized wraps text in the named ANSI color unless the formatter is in plain mode
+func (tf *TerminalFormatterImpl) FormatColorized(text string, color string) string {
+	code, ok := terminalColors[strings.ToLower(color)]
+	if tf.plain || !ok {
+		return text
+	}
+	return code + text + visualizers.ColorReset
+}
+
+// FormatTable renders rows as a box-drawn table
+func (tf *TerminalFormatterImpl) FormatTable(headers []string, rows [][]string) string {
+	table, err := visualizers.NewChartsRenderer(tf.renderConfig).RenderTable(headers, rows, tf.renderConfig)
+	if err != nil {
+		return ""
+	}
+	return table
+}
+
+// chartsRenderer returns a charts renderer for the report, colored unless in plain mode
+func (tf *TerminalFormatterImpl) chartsRenderer() *visualizers.ChartsRenderer {
+	renderer := visualizers.NewChartsRenderer(tf.renderConfig)
+	renderer.SetColorOptions(!tf.plain)
+	return renderer
+}
+
+// StripANSI removes ANSI escape sequences from text
+func StripANSI(text string) string {
+	return ansiPattern.ReplaceAllString(text, "")
+}
+
+// formatContrib renders the contribution graph report, per author when config.ByAuthor is set
+func (tf *TerminalFormatterImpl) formatContrib(out *strings.Builder, data *models.AnalysisResult, colorTheme string, config models.FormatConfig) error {
+	out.WriteString("Git Contribution Graph\n")
+	out.WriteString("======================\n")
+
+	if data.Repository != nil {
+		fmt.Fprintf(out, "Repository: %s\n", data.Repository.Name)
+		fmt.Fprintf(out, "Total Commits: %d\n\n", data.Repository.TotalCommits)
+	}
+
+	if data.ContribGraph == nil {
+		out.WriteString("No contribution data available.\n")
+		return nil
+	}
+
+	// Create contribution graph renderer
+	contribRenderer := visualizers.NewContributionGraphRenderer(tf.renderConfig)
+	contribRenderer.SetColorOptions(!tf.plain, colorTheme)
+	contribRenderer.SetTheme(config.Theme)
+
+	switch {
+	case config.ByAuthor == models.ByAuthorDominant:
+		graphOutput, err := contribRenderer.RenderDominantAuthorGraph(data.ContribGraph, config.TopAuthors, tf.renderConfig)
+		if err != nil {
+			return fmt.Errorf("error rendering contribution graph: %w", err)
+		}
+		out.WriteString(graphOutput)
+	case config.ByAuthor == models.ByAuthorMultiples:
+		graphOutput, err := contribRenderer.RenderAuthorMultiples(data.ContribGraph, config.TopAuthors, tf.renderConfig)
+		if err != nil {
+			return fmt.Errorf("error rendering contribution graph: %w", err)
+		}
+		out.WriteString(graphOutput)
+	case len(data.ContribYears) > 0:
+		if err := tf.formatContribYears(out, data, contribRenderer); err != nil {
+			return err
+		}
+	default:
+		graphOutput, err := contribRenderer.RenderContributionGraph(data.ContribGraph, tf.renderConfig)
+		if err != nil {
+			return fmt.Errorf("error rendering contribution graph: %w", err)
+		}
+		out.WriteString(graphOutput)
+	}
+
+	// Display contribution summary
+	contribSummary := analyzers.NewContributionAnalyzer().GetContributionSummary(data.ContribGraph)
+	metric := models.MetricTitle(data.ContribGraph.Metric)
+
+	out.WriteString("\nContribution Summary:\n")
+	out.WriteString("====================\n")
+	fmt.Fprintf(out, "Total %s: %d\n", metric, contribSummary.TotalCommits)
+	fmt.Fprintf(out, "Active Days: %d out of %d days\n", contribSummary.ActiveDays, contribSummary.TotalDays)
+	fmt.Fprintf(out, "Average %s/Day: %.2f\n", metric, contribSummary.AvgCommitsPerDay)
+	fmt.Fprintf(out, "Max %s in a Day: %d\n", metric, contribSummary.MaxCommitsPerDay)
+	fmt.Fprintf(out, "Current Streak: %d days\n", contribSummary.CurrentStreak)
+	fmt.Fprintf(out, "Longest Streak: %d days\n", contribSummary.LongestStreak)
+
+	// Show activity level distribution
+	out.WriteString("\nActivity Level Distribution:\n")
+	out.WriteString("============================\n")
+
+	levelCounts := make(map[int]int)
+	for _, level := range contribSummary.ActivityLevels {
+		levelCounts[level]++
+	}
+
+	ranges := models.LevelRanges(data.ContribGraph.LevelThresholds())
+	levelNames := []string{
+		"No activity",
+		"Low activity (" + ranges[0] + ")",
+		"Medium activity (" + ranges[1] + ")",
+		"High activity (" + ranges[2] + ")",
+		"Very high activity (" + ranges[3] + ")",
+	}
+	for level := 0; level <= 4; level++ {
+		count := levelCounts[level]
+		percentage := 0.0
+		if contribSummary.TotalDays > 0 {
+			percentage = float64(count) / float64(contribSummary.TotalDays) * 100
+		}
+		fmt.Fprintf(out, "%s: %d days (%.1f%%)\n", levelNames[level], count, percentage)
+	}
+
+	out.WriteString("\nNote: Showing activity for the specified time range\n")
+	return nil
+}
+
+// formatContribYears renders one calendar per requested year, newest first, each headed by
+// its totals and longest streak, followed by a single legend
+func (tf *TerminalFormatterImpl) formatContribYears(out *strings.Builder, data *models.AnalysisResult, contribRenderer *visualizers.ContributionGraphRenderer) error {
+	for _, year := range data.ContribYears {
+		fmt.Fprintf(out, "%s  %d %s, %d active days, longest streak %d days\n",
+			tf.FormatColorized(fmt.Sprintf("%d", year.Year), "bold"), year.Total,
+			models.MetricLabel(data.ContribGraph.Metric), year.ActiveDays, year.LongestStreak)
+
+		calendar, err := contribRenderer.RenderYear(data.ContribGraph, year.Year)
+		if err != nil {
+			return fmt.Errorf("error rendering contribution graph for %d: %w", year.Year, err)
+		}
+		out.WriteString(calendar)
+		out.WriteString("\n")
+	}
+
+	if tf.renderConfig.ShowLegend {
+		out.WriteString(contribRenderer.RenderGraphLegend(data.ContribGraph))
+	}
+	return nil
+}
+
+// formatSummary renders the summary statistics report
+func (tf *TerminalFormatterImpl) formatSummary(out *strings.Builder, data *models.AnalysisResult) error {
+	out.WriteString("Git Repository Summary\n")
+	out.WriteString("======================\n")
+
+	if data.Repository != nil {
+		fmt.Fprintf(out, "Repository: %s\n", data.Repository.Name)
+		fmt.Fprintf(out, "Path: %s\n", data.Repository.Path)
+		fmt.Fprintf(out, "Total Commits: %d\n", data.Repository.TotalCommits)
+
+		if !data.Repository.FirstCommit.IsZero() {
+			fmt.Fprintf(out, "First Commit: %s\n", data.Repository.FirstCommit.Format("2006-01-02 15:04:05"))
+		}
+		if !data.Repository.LastCommit.IsZero() {
+			fmt.Fprintf(out, "Last Commit: %s\n", data.Repository.LastCommit.Format("2006-01-02 15:04:05"))
+		}
+
+		fmt.Fprintf(out, "Branches: %d\n\n", len(data.Repository.Branches))
+	}
+
+	if data.Summary == nil {
+		out.WriteString("No summary data available.\n")
+		return nil
+	}
+
+	chartsRenderer := tf.chartsRenderer()
+
+	summaryOutput, err := chartsRenderer.RenderSummaryStats(data.Summary, tf.renderConfig)
+	if err != nil {
+		return fmt.Errorf("error rendering summary: %w", err)
+	}
+	out.WriteString(summaryOutput)
+
+	// Render contributor statistics if available
+	if len(data.Contributors) > 0 {
+		out.WriteString("\n\n")
+		contributorOutput, err := chartsRenderer.RenderContributorStats(data.Contributors, tf.renderConfig)
+		if err != nil {
+			return fmt.Errorf("error rendering contributors: %w", err)
+		}
+		out.WriteString(contributorOutput)
+	}
+
+	// Render time-based analysis
+	out.WriteString("\n\n")
+	timeAnalysisOutput, err := chartsRenderer.RenderTimeBasedAnalysis(data.Summary, tf.renderConfig)
+	if err != nil {
+		return fmt.Errorf("error rendering time analysis: %w", err)
+	}
+	out.WriteString(timeAnalysisOutput)
+
+	return nil
+}
+
+// formatContributors renders the contributor statistics report
+func (tf *TerminalFormatterImpl) formatContributors(out *strings.Builder, data *models.AnalysisResult) error {
+	out.WriteString("Git Contributors Analysis\n")
+	out.WriteString("=========================\n")
+
+	if data.Repository != nil {
+		fmt.Fprintf(out, "Repository: %s\n", data.Repository.Name)
+		fmt.Fprintf(out, "Total Contributors: %d\n\n", len(data.Contributors))
+	}
+
+	if len(data.Contributors) == 0 {
+		out.WriteString("No contributors found.\n")
+		return nil
+	}
+
+	contributorOutput, err := tf.chartsRenderer().RenderContributorStats(data.Contributors, tf.renderConfig)
+	if err != nil {
+		return fmt.Errorf("error rendering contributors: %w", err)
+	}
+	out.WriteString(contributorOutput)
+
+	return nil
+}
+
+// formatFiles renders the file and file type statistics report
+func (tf *TerminalFormatterImpl) formatFiles(out *strings.Builder, data *models.AnalysisResult) error {
+	out.WriteString("Git File Statistics\n")
+	out.WriteString("===================\n")
+
+	if data.Repository != nil {
+		fmt.Fprintf(out, "Repository: %s\n", data.Repository.Name)
+		fmt.Fprintf(out, "Total Commits: %d\n\n", data.Repository.TotalCommits)
+	}
+
+	if data.Summary == nil || len(data.Summary.TopFiles) == 0 {
+		out.WriteString("No file changes found.\n")
+		return nil
+	}
+
+	if len(data.Directories) > 0 {
+		directoryOutput, err := tf.chartsRenderer().RenderDirectoryStatistics(data.Directories, tf.renderConfig)
+		if err != nil {
+			return fmt.Errorf("error rendering directory statistics: %w", err)
+		}
+		out.WriteString(directoryOutput)
+		return nil
+	}
+
+	fileOutput, err := tf.chartsRenderer().RenderFileStatistics(data.Summary, tf.renderConfig)
+	if err != nil {
+		return fmt.Errorf("error rendering file statistics: %w", err)
+	}
+	out.WriteString(fileOutput)
+
+	return nil
+}
+
+// formatGrowth renders the net lines of code over time, per group when the growth has a breakdown
+func (tf *TerminalFormatterImpl) formatGrowth(out *strings.Builder, data *models.AnalysisResult) error {
+	out.WriteString("Git Code Growth\n")
+	out.WriteString("===============\n")
+
+	if data.Repository != nil {
+		fmt.Fprintf(out, "Repository: %s\n", data.Repository.Name)
+	}
+	if !data.TimeRange.Start.IsZero() {
+		fmt.Fprintf(out, "Period: %s to %s\n", data.TimeRange.Start.Format("2006-01-02"), data.TimeRange.End.Format("2006-01-02"))
+	}
+	out.WriteString("\n")
+
+	if data.Growth == nil {
+		out.WriteString("No code growth data available.\n")
+		return nil
+	}
+
+	growthOutput, err := tf.chartsRenderer().RenderCodeGrowth(data.Growth, tf.renderConfig)
+	if err != nil {
+		return fmt.Errorf("error rendering code growth: %w", err)
+	}
+	out.WriteString(growthOutput)
+
+	return nil
+}
+
+// formatCompare renders the side-by-side contributor comparison report
+func (tf *TerminalFormatterImpl) formatCompare(out *strings.Builder, data *models.AnalysisResult) error {
+	out.WriteString("Git Contributor Comparison\n")
+	out.WriteString("==========================\n")
+
+	if data.Repository != nil {
+		fmt.Fprintf(out, "Repository: %s\n\n", data.Repository.Name)
+	}
+
+	if data.Comparison == nil {
+		out.WriteString("No comparison data available.\n")
+		return nil
+	}
+
+	comparisonRenderer := visualizers.NewComparisonRenderer(tf.renderConfig)
+	comparisonRenderer.SetColorOptions(!tf.plain)
+
+	comparisonOutput, err := comparisonRenderer.RenderComparison(data.Comparison)
+	if err != nil {
+		return fmt.Errorf("error rendering comparison: %w", err)
+	}
+	out.WriteString(comparisonOutput)
+
+	return nil
+}
+
+// formatGraph renders the commit graph like git log --graph --oneline
+func (tf *TerminalFormatterImpl) formatGraph(out *strings.Builder, data *models.AnalysisResult, config models.FormatConfig) error {
+	out.WriteString("Git Commit Graph\n")
+	out.WriteString("================\n")
+
+	if data.Repository != nil {
+		fmt.Fprintf(out, "Repository: %s\n", data.Repository.Name)
+	}
+
+	if data.CommitGraph == nil || len(data.CommitGraph.Rows) == 0 {
+		out.WriteString("\nNo commits in the selected range.\n")
+		return nil
+	}
+
+	merges := 0
+	for _, row := range data.CommitGraph.Rows {
+		if row.Merge() {
+			merges++
+		}
+	}
+	fmt.Fprintf(out, "Commits: %d (%d merges), up to %d lanes\n\n", len(data.CommitGraph.Rows), merges, data.CommitGraph.MaxLanes)
+
+	// Like git log, long subjects are not cut to the terminal width
+	renderConfig := tf.renderConfig
+	renderConfig.Width = 0
+
+	graphRenderer := visualizers.NewCommitGraphRenderer(renderConfig)
+	graphRenderer.SetColorOptions(!tf.plain)
+	graphRenderer.SetASCII(config.ASCII)
+
+	graphOutput, err := graphRenderer.RenderGraph(data.CommitGraph, config.CollapseRun)
+	if err != nil {
+		return fmt.Errorf("error rendering commit graph: %w", err)
+	}
+	out.WriteString(graphOutput)
+
+	return nil
+}
+
+// punchCardContributors is how many of the most active contributors get their own punch card
+const punchCardContributors = 5
+
+// formatPunchCard renders the hour by weekday punch card of the repository and its top contributors
+func (tf *TerminalFormatterImpl) formatPunchCard(out *strings.Builder, data *models.AnalysisResult, theme *models.Theme) error {
+	out.WriteString("Git Punch Card\n")
+	out.WriteString("==============\n")
+
+	if data.Repository != nil {
+		fmt.Fprintf(out, "Repository: %s\n", data.Repository.Name)
+	}
+
+	if data.Summary == nil || data.Summary.PunchCard.Total() == 0 {
+		out.WriteString("\nNo commits in the selected range.\n")
+		return nil
+	}
+
+	renderer := visualizers.NewPunchCardRenderer(tf.renderConfig)
+	renderer.SetColorOptions(!tf.plain)
+	renderer.SetTheme(theme)
+
+	fmt.Fprintf(out, "Commits: %d\n\n", data.Summary.PunchCard.Total())
+	if err := tf.writePunchCard(out, renderer, &data.Summary.PunchCard); err != nil {
+		return err
+	}
+
+	for i, contributor := range data.Contributors {
+		if i == punchCardContributors {
+			break
+		}
+		if contributor.PunchCard.Total() == 0 {
+			continue
+		}
+
+		fmt.Fprintf(out, "\n%s <%s> - %d commits\n", contributor.Name, contributor.Email, contributor.PunchCard.Total())
+		if err := tf.writePunchCard(out, renderer, &contributor.PunchCard); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// writePunchCard writes one punch card followed by its legend
+func (tf *TerminalFormatterImpl) writePunchCard(out *strings.Builder, renderer *visualizers.PunchCardRenderer, card *models.PunchCard) error {
+	cardOutput, err := renderer.RenderPunchCard(card)
+	if err != nil {
+		return fmt.Errorf("error rendering punch card: %w", err)
+	}
+	out.WriteString(cardOutput)
+	out.WriteString(renderer.RenderLegend(card))
+	return nil
+}
+
+// formatHealth renders the repository health report
+func (tf *TerminalFormatterImpl) formatHealth(out *strings.Builder, data *models.AnalysisResult) error {
+	out.WriteString("Repository Health Analysis\n")
+	out.WriteString("==========================\n")
+
+	if data.Repository != nil {
+		fmt.Fprintf(out, "Repository: %s\n", data.Repository.Name)
+		fmt.Fprintf(out, "Total Commits: %d\n\n", data.Repository.TotalCommits)
+	}
+
+	if data.HealthMetrics == nil {
+		out.WriteString("No health metrics available.\n")
+		return nil
+	}
+
+	healthOutput, err := tf.chartsRenderer().RenderHealthMetrics(data.HealthMetrics, tf.renderConfig)
+	if err != nil {
+		return fmt.Errorf("error rendering health metrics: %w", err)
+	}
+	out.WriteString(healthOutput)
+
+	return nil
+}
@@ -0,0 +1,567 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - XLSX (Office Open XML) workbook formatter
+
+package formatters
+
+import (
+	"encoding/xml"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"git-stats/models"
+)
+
+// Cell style indexes defined in xlsxStylesXML
+const (
+	xlsxStyleDefault  = 0
+	xlsxStyleHeader   = 1
+	xlsxStyleDate     = 2
+	xlsxStyleDateTime = 3
+	xlsxStyleDecimal  = 4
+	xlsxStyleMonth    = 5
+)
+
+// xlsxEpoch is the origin of spreadsheet date serial numbers (1900 date system)
+var xlsxEpoch = time.Date(1899, 12, 30, 0, 0, 0, 0, time.UTC)
+
+// XLSXFormatterImpl writes analysis results as an Excel workbook
+type XLSXFormatterImpl struct{}
+
+// NewXLSXFormatter creates a new XLSX formatter instance
+func NewXLSXFormatter() *XLSXFormatterImpl {
+	return &XLSXFormatterImpl{}
+}
+
+// xlsxValue is a typed cell value
+type xlsxValue struct {
+	text    string
+	number  float64
+	numeric bool
+	style   int
+}
+
+// xlsxSheet is a worksheet with a header row followed by data rows
+type xlsxSheet struct {
+	Name    string
+	Headers []string
+	Rows    [][]xlsxValue
+}
+
+// xlsxText creates a string cell
+func xlsxText(s string) xlsxValue {
+	return xlsxValue{text: s}
+}
+
+// xlsxInt creates an integer cell
+func xlsxInt(n int) xlsxValue {
+	return xlsxValue{number: float64(n), numeric: true}
+}
+
+// xlsxDecimal creates a two-decimal numeric cell
+func xlsxDecimal(f float64) xlsxValue {
+	return xlsxValue{number: f, numeric: true, style: xlsxStyleDecimal}
+}
+
+// xlsxTime creates a date or date-time cell; zero times become empty cells. Cells have no time
+// zone, so they hold the wall clock time in t's own location, as the other formats show it
+func xlsxTime(t time.Time, style int) xlsxValue {
+	if t.IsZero() {
+		return xlsxValue{}
+	}
+	wall := time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), time.UTC)
+	serial := wall.Sub(xlsxEpoch).Hours() / 24
+	if style == xlsxStyleDate || style == xlsxStyleMonth {
+		serial = math.Floor(serial)
+	}
+	return xlsxValue{number: serial, numeric: true, style: style}
+}
+
+// Format implements the Formatter interface for XLSX output
+func (xf *XLSXFormatterImpl) Format(data *models.AnalysisResult, config models.FormatConfig) ([]byte, error) {
+	if data == nil {
+		return nil, NewFormatterError("analysis result cannot be nil")
+	}
+
+	return xf.FormatXLSX(data)
+}
+
+// FormatXLSX builds the workbook and returns the zipped package
+func (xf *XLSXFormatterImpl) FormatXLSX(data *models.AnalysisResult) ([]byte, error) {
+	sheets := xf.buildSheets(data)
+
+	files := []NamedOutput{
+		{Name: "[Content_Types].xml", Data: []byte(xf.contentTypesXML(len(sheets)))},
+		{Name: "_rels/.rels", Data: []byte(xlsxRootRelsXML)},
+		{Name: "docProps/app.xml", Data: []byte(xlsxAppXML)},
+		{Name: "docProps/core.xml", Data: []byte(xf.coreXML())},
+		{Name: "xl/styles.xml", Data: []byte(xlsxStylesXML)},
+	}
+
+	workbook, err := xf.workbookXML(sheets)
+	if err != nil {
+		return nil, NewFormatterOperationError("xlsx", fmt.Sprintf("failed to build workbook: %v", err))
+	}
+	files = append(files,
+		NamedOutput{Name: "xl/workbook.xml", Data: workbook},
+		NamedOutput{Name: "xl/_rels/workbook.xml.rels", Data: []byte(xf.workbookRelsXML(len(sheets)))},
+	)
+
+	for i, sheet := range sheets {
+		content, err := xf.sheetXML(sheet)
+		if err != nil {
+			return nil, NewFormatterOperationError("xlsx", fmt.Sprintf("failed to build sheet %s: %v", sheet.Name, err))
+		}
+		files = append(files, NamedOutput{Name: fmt.Sprintf("xl/worksheets/sheet%d.xml", i+1), Data: content})
+	}
+
+	return PackZip(files)
+}
+
+// buildSheets converts the analysis result into worksheets
+func (xf *XLSXFormatterImpl) buildSheets(data *models.AnalysisResult) []xlsxSheet {
+	summary := data.Summary
+	if summary == nil {
+		summary = &models.StatsSummary{}
+	}
+
+	sheets := []xlsxSheet{xf.summarySheet(data)}
+
+	contributors := xlsxSheet{
+		Name:    "Contributors",
+		Headers: []string{"Name", "Email", "Commits", "Insertions", "Deletions", "First Commit", "Last Commit", "Active Days", "Avg Commits Per Day"},
+	}
+	for _, c := range data.Contributors {
+		contributors.Rows = append(contributors.Rows, []xlsxValue{
+			xlsxText(c.Name), xlsxText(c.Email),
+			xlsxInt(c.TotalCommits), xlsxInt(c.TotalInsertions), xlsxInt(c.TotalDeletions),
+			xlsxTime(c.FirstCommit, xlsxStyleDateTime), xlsxTime(c.LastCommit, xlsxStyleDateTime),
+			xlsxInt(c.ActiveDays), xlsxDecimal(c.GetAverageCommitsPerDay()),
+		})
+	}
+
+	files := xlsxSheet{
+		Name:    "Files",
+		Headers: []string{"Path", "Commits", "Insertions", "Deletions", "Last Modified"},
+	}
+	for _, f := range summary.TopFiles {
+		files.Rows = append(files.Rows, []xlsxValue{
+			xlsxText(f.Path), xlsxInt(f.Commits), xlsxInt(f.Insertions), xlsxInt(f.Deletions),
+			xlsxTime(f.LastModified, xlsxStyleDateTime),
+		})
+	}
+
+	fileTypes := xlsxSheet{
+		Name:    "File Types",
+		Headers: []string{"Extension", "Files", "Commits", "Lines"},
+	}
+	for _, ft := range summary.TopFileTypes {
+		fileTypes.Rows = append(fileTypes.Rows, []xlsxValue{
+			xlsxText(ft.Extension), xlsxInt(ft.Files), xlsxInt(ft.Commits), xlsxInt(ft.Lines),
+		})
+	}
+
+	monthly := xlsxSheet{
+		Name:    "Monthly Growth",
+		Headers: []string{"Month", "Commits", "Authors"},
+	}
+	if data.HealthMetrics != nil {
+		for _, m := range data.HealthMetrics.MonthlyGrowth {
+			monthly.Rows = append(monthly.Rows, []xlsxValue{
+				xlsxTime(m.Month, xlsxStyleMonth), xlsxInt(m.Commits), xlsxInt(m.Authors),
+			})
+		}
+	}
+
+	daily := xlsxSheet{
+		Name:    "Daily Activity",
+		Headers: []string{"Date", "Commits"},
+	}
+	if data.ContribGraph != nil {
+		daily.Headers[1] = models.MetricTitle(data.ContribGraph.Metric)
+		dates := make([]string, 0, len(data.ContribGraph.DailyValues))
+		for date := range data.ContribGraph.DailyValues {
+			dates = append(dates, date)
+		}
+		sort.Strings(dates)
+		for _, date := range dates {
+			day, err := time.Parse("2006-01-02", date)
+			if err != nil {
+				continue
+			}
+			daily.Rows = append(daily.Rows, []xlsxValue{
+				xlsxTime(day, xlsxStyleDate), xlsxInt(data.ContribGraph.DailyValues[date]),
+			})
+		}
+	}
+
+	sheets = append(sheets, contributors, files, fileTypes, monthly, daily)
+
+	// Directory statistics, team rollups and code growth are written only by the analyses that
+	// compute them
+	if len(data.Directories) > 0 {
+		directories := xlsxSheet{
+			Name: "Directories",
+			Headers: []string{"Directory", "Manifest", "Files", "Commits", "Insertions", "Deletions", "Authors",
+				"Top Authors", "First Change", "Last Change", "Activity Trend"},
+		}
+		for _, directory := range data.Directories {
+			authors := make([]string, len(directory.TopAuthors))
+			for i, author := range directory.TopAuthors {
+				authors[i] = fmt.Sprintf("%s (%d)", author.Name, author.Commits)
+			}
+			directories.Rows = append(directories.Rows, []xlsxValue{
+				xlsxText(directory.Path), xlsxText(directory.Manifest), xlsxInt(directory.Files),
+				xlsxInt(directory.Commits), xlsxInt(directory.Insertions), xlsxInt(directory.Deletions),
+				xlsxInt(directory.Authors), xlsxText(strings.Join(authors, ", ")),
+				xlsxTime(directory.FirstChange, xlsxStyleDateTime), xlsxTime(directory.LastChange, xlsxStyleDateTime),
+				xlsxText(directory.Trend),
+			})
+		}
+		sheets = append(sheets, directories)
+	}
+
+	if len(data.Teams) > 0 {
+		teams := xlsxSheet{
+			Name: "Teams",
+			Headers: []string{"Team", "Members", "Commits", "Commit Share", "Insertions", "Deletions", "Active Days",
+				"Files", "Owned Files", "Ownership", "First Commit", "Last Commit"},
+		}
+		for _, team := range data.Teams {
+			teams.Rows = append(teams.Rows, []xlsxValue{
+				xlsxText(team.Name), xlsxInt(team.Members), xlsxInt(team.Commits), xlsxDecimal(team.CommitShare),
+				xlsxInt(team.Insertions), xlsxInt(team.Deletions), xlsxInt(team.ActiveDays), xlsxInt(team.Files),
+				xlsxInt(team.OwnedFiles), xlsxDecimal(team.Ownership),
+				xlsxTime(team.FirstCommit, xlsxStyleDateTime), xlsxTime(team.LastCommit, xlsxStyleDateTime),
+			})
+		}
+		sheets = append(sheets, teams)
+	}
+
+	if data.Growth != nil {
+		growth := xlsxSheet{
+			Name:    "Code Growth",
+			Headers: []string{"Period", "Group", "Insertions", "Deletions", "Net", "Total"},
+		}
+		for _, series := range append([]models.GrowthSeries{data.Growth.Total}, data.Growth.Groups...) {
+			for _, point := range series.Points {
+				growth.Rows = append(growth.Rows, []xlsxValue{
+					xlsxTime(point.Period, xlsxStyleDate), xlsxText(series.Name),
+					xlsxInt(point.Insertions), xlsxInt(point.Deletions), xlsxInt(point.Net), xlsxInt(point.Total),
+				})
+			}
+		}
+		sheets = append(sheets, growth)
+	}
+
+	return sheets
+}
+
+// summarySheet builds the metric/value summary sheet
+func (xf *XLSXFormatterImpl) summarySheet(data *models.AnalysisResult) xlsxSheet {
+	sheet := xlsxSheet{
+		Name:    "Summary",
+		Headers: []string{"Metric", "Value"},
+	}
+
+	add := func(metric string, value xlsxValue) {
+		sheet.Rows = append(sheet.Rows, []xlsxValue{xlsxText(metric), value})
+	}
+
+	if data.Repository != nil {
+		add("Repository", xlsxText(data.Repository.Name))
+		add("Repository Path", xlsxText(data.Repository.Path))
+	}
+	add("Period Start", xlsxTime(data.TimeRange.Start, xlsxStyleDate))
+	add("Period End", xlsxTime(data.TimeRange.End, xlsxStyleDate))
+
+	if data.Summary != nil {
+		add("Total Commits", xlsxInt(data.Summary.TotalCommits))
+		add("Total Insertions", xlsxInt(data.Summary.TotalInsertions))
+		add("Total Deletions", xlsxInt(data.Summary.TotalDeletions))
+		add("Files Changed", xlsxInt(data.Summary.FilesChanged))
+		add("Active Days", xlsxInt(data.Summary.ActiveDays))
+		add("Avg Commits Per Day", xlsxDecimal(data.Summary.AvgCommitsPerDay))
+	}
+
+	if data.HealthMetrics != nil {
+		add("Contributors", xlsxInt(data.HealthMetrics.ContributorCount))
+		add("Active Contributors", xlsxInt(data.HealthMetrics.ActiveContributors))
+		add("Activity Trend", xlsxText(data.HealthMetrics.ActivityTrend))
+	}
+
+	return sheet
+}
+
+// XML structures for worksheet parts
+
+type xlsxWorksheet struct {
+	XMLName    xml.Name       `xml:"worksheet"`
+	Xmlns      string         `xml:"xmlns,attr"`
+	Dimension  xlsxRef        `xml:"dimension"`
+	SheetViews xlsxSheetViews `xml:"sheetViews"`
+	Cols       *xlsxCols      `xml:"cols,omitempty"`
+	SheetData  xlsxSheetData  `xml:"sheetData"`
+	AutoFilter *xlsxRef       `xml:"autoFilter,omitempty"`
+}
+
+type xlsxRef struct {
+	Ref string `xml:"ref,attr"`
+}
+
+type xlsxSheetViews struct {
+	SheetView xlsxSheetView `xml:"sheetView"`
+}
+
+type xlsxSheetView struct {
+	WorkbookViewID int      `xml:"workbookViewId,attr"`
+	Pane           xlsxPane `xml:"pane"`
+}
+
+type xlsxPane struct {
+	YSplit      int    `xml:"ySplit,attr"`
+	TopLeftCell string `xml:"topLeftCell,attr"`
+	ActivePane  string `xml:"activePane,attr"`
+	State       string `xml:"state,attr"`
+}
+
+type xlsxCols struct {
+	Col []xlsxCol `xml:"col"`
+}
+
+type xlsxCol struct {
+	Min         int     `xml:"min,attr"`
+	Max         int     `xml:"max,attr"`
+	Width       float64 `xml:"width,attr"`
+	CustomWidth int     `xml:"customWidth,attr"`
+}
+
+type xlsxSheetData struct {
+	Rows []xlsxRow `xml:"row"`
+}
+
+type xlsxRow struct {
+	R     int        `xml:"r,attr"`
+	Cells []xlsxCell `xml:"c"`
+}
+
+type xlsxCell struct {
+	R  string      `xml:"r,attr"`
+	S  int         `xml:"s,attr,omitempty"`
+	T  string      `xml:"t,attr,omitempty"`
+	V  string      `xml:"v,omitempty"`
+	IS *xlsxInline `xml:"is,omitempty"`
+}
+
+type xlsxInline struct {
+	T xlsxInlineText `xml:"t"`
+}
+
+type xlsxInlineText struct {
+	Space string `xml:"xml:space,attr,omitempty"`
+	Text  string `xml:",chardata"`
+}
+
+// sheetXML renders a worksheet with a frozen header row and auto-filter
+func (xf *XLSXFormatterImpl) sheetXML(sheet xlsxSheet) ([]byte, error) {
+	lastColumn := xlsxColumnName(len(sheet.Headers))
+	lastRow := len(sheet.Rows) + 1
+	ref := fmt.Sprintf("A1:%s%d", lastColumn, lastRow)
+
+	ws := xlsxWorksheet{
+		Xmlns:     "http://schemas.openxmlformats.org/spreadsheetml/2006/main",
+		Dimension: xlsxRef{Ref: ref},
+		SheetViews: xlsxSheetViews{SheetView: xlsxSheetView{
+			Pane: xlsxPane{YSplit: 1, TopLeftCell: "A2", ActivePane: "bottomLeft", State: "frozen"},
+		}},
+		AutoFilter: &xlsxRef{Ref: ref},
+	}
+
+	widths := make([]int, len(sheet.Headers))
+	header := xlsxRow{R: 1}
+	for i, title := range sheet.Headers {
+		header.Cells = append(header.Cells, xf.cell(i, 1, xlsxValue{text: title, style: xlsxStyleHeader}))
+		widths[i] = len(title)
+	}
+	ws.SheetData.Rows = append(ws.SheetData.Rows, header)
+
+	for r, values := range sheet.Rows {
+		row := xlsxRow{R: r + 2}
+		for c, value := range values {
+			if c >= len(widths) {
+				break
+			}
+			row.Cells = append(row.Cells, xf.cell(c, r+2, value))
+			width := len([]rune(value.text))
+			if value.numeric {
+				width = 12
+				if value.style == xlsxStyleDateTime {
+					width = 19
+				}
+			}
+			widths[c] = max(widths[c], width)
+		}
+		ws.SheetData.Rows = append(ws.SheetData.Rows, row)
+	}
+
+	ws.Cols = &xlsxCols{}
+	for i, width := range widths {
+		ws.Cols.Col = append(ws.Cols.Col, xlsxCol{
+			Min: i + 1, Max: i + 1, Width: float64(min(width, 60) + 2), CustomWidth: 1,
+		})
+	}
+
+	content, err := xml.Marshal(ws)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), content...), nil
+}
+
+// cell creates a typed cell at the given zero-based column and one-based row
+func (xf *XLSXFormatterImpl) cell(column, row int, value xlsxValue) xlsxCell {
+	cell := xlsxCell{R: fmt.Sprintf("%s%d", xlsxColumnName(column+1), row), S: value.style}
+	if value.numeric {
+		cell.V = strconv.FormatFloat(value.number, 'f', -1, 64)
+		return cell
+	}
+	if value.text == "" {
+		return cell
+	}
+
+	cell.T = "inlineStr"
+	cell.IS = &xlsxInline{T: xlsxInlineText{Text: value.text}}
+	if value.text[0] == ' ' || value.text[len(value.text)-1] == ' ' {
+		cell.IS.T.Space = "preserve"
+	}
+	return cell
+}
+
+// xlsxColumnName converts a one-based column index into a spreadsheet column name
+func xlsxColumnName(index int) string {
+	name := ""
+	for index > 0 {
+		index--
+		name = string(rune('A'+index%26)) + name
+		index /= 26
+	}
+	return name
+}
+
+// XML structures for the workbook part
+
+type xlsxWorkbook struct {
+	XMLName      xml.Name          `xml:"workbook"`
+	Xmlns        string            `xml:"xmlns,attr"`
+	XmlnsR       string            `xml:"xmlns:r,attr"`
+	Sheets       []xlsxWorkbookRef `xml:"sheets>sheet"`
+	DefinedNames []xlsxDefinedName `xml:"definedNames>definedName,omitempty"`
+}
+
+type xlsxWorkbookRef struct {
+	Name    string `xml:"name,attr"`
+	SheetID int    `xml:"sheetId,attr"`
+	RID     string `xml:"r:id,attr"`
+}
+
+type xlsxDefinedName struct {
+	Name         string `xml:"name,attr"`
+	LocalSheetID int    `xml:"localSheetId,attr"`
+	Hidden       int    `xml:"hidden,attr"`
+	Value        string `xml:",chardata"`
+}
+
+// workbookXML renders the workbook part listing every sheet and its auto-filter range
+func (xf *XLSXFormatterImpl) workbookXML(sheets []xlsxSheet) ([]byte, error) {
+	wb := xlsxWorkbook{
+		Xmlns:  "http://schemas.openxmlformats.org/spreadsheetml/2006/main",
+		XmlnsR: "http://schemas.openxmlformats.org/officeDocument/2006/relationships",
+	}
+
+	for i, sheet := range sheets {
+		wb.Sheets = append(wb.Sheets, xlsxWorkbookRef{Name: sheet.Name, SheetID: i + 1, RID: fmt.Sprintf("rId%d", i+1)})
+		wb.DefinedNames = append(wb.DefinedNames, xlsxDefinedName{
+			Name:         "_xlnm._FilterDatabase",
+			LocalSheetID: i,
+			Hidden:       1,
+			Value:        fmt.Sprintf("'%s'!$A$1:$%s$%d", sheet.Name, xlsxColumnName(len(sheet.Headers)), len(sheet.Rows)+1),
+		})
+	}
+
+	content, err := xml.Marshal(wb)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), content...), nil
+}
+
+// workbookRelsXML links the workbook to its sheets and styles
+func (xf *XLSXFormatterImpl) workbookRelsXML(sheetCount int) string {
+	rels := xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">`
+	for i := 1; i <= sheetCount; i++ {
+		rels += fmt.Sprintf(`<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet%d.xml"/>`, i, i)
+	}
+	rels += fmt.Sprintf(`<Relationship Id="rId%d" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/styles" Target="styles.xml"/>`, sheetCount+1)
+	return rels + `</Relationships>`
+}
+
+// contentTypesXML declares the content type of every package part
+func (xf *XLSXFormatterImpl) contentTypesXML(sheetCount int) string {
+	types := xml.Header + `<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types">` +
+		`<Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/>` +
+		`<Default Extension="xml" ContentType="application/xml"/>` +
+		`<Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/>` +
+		`<Override PartName="/xl/styles.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.styles+xml"/>` +
+		`<Override PartName="/docProps/core.xml" ContentType="application/vnd.openxmlformats-package.core-properties+xml"/>` +
+		`<Override PartName="/docProps/app.xml" ContentType="application/vnd.openxmlformats-officedocument.extended-properties+xml"/>`
+	for i := 1; i <= sheetCount; i++ {
+		types += fmt.Sprintf(`<Override PartName="/xl/worksheets/sheet%d.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/>`, i)
+	}
+	return types + `</Types>`
+}
+
+// coreXML renders document properties with the creation time
+func (xf *XLSXFormatterImpl) coreXML() string {
+	now := time.Now().UTC().Format(time.RFC3339)
+	return xml.Header + `<cp:coreProperties xmlns:cp="http://schemas.openxmlformats.org/package/2006/metadata/core-properties" ` +
+		`xmlns:dc="http://purl.org/dc/elements/1.1/" xmlns:dcterms="http://purl.org/dc/terms/" ` +
+		`xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance">` +
+		`<dc:title>git-stats report</dc:title><dc:creator>git-stats</dc:creator>` +
+		`<dcterms:created xsi:type="dcterms:W3CDTF">` + now + `</dcterms:created>` +
+		`<dcterms:modified xsi:type="dcterms:W3CDTF">` + now + `</dcterms:modified>` +
+		`</cp:coreProperties>`
+}
+
+const xlsxRootRelsXML = xml.Header + `<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships">` +
+	`<Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/>` +
+	`<Relationship Id="rId2" Type="http://schemas.openxmlformats.org/package/2006/relationships/metadata/core-properties" Target="docProps/core.xml"/>` +
+	`<Relationship Id="rId3" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/extended-properties" Target="docProps/app.xml"/>` +
+	`</Relationships>`
+
+const xlsxAppXML = xml.Header + `<Properties xmlns="http://schemas.openxmlformats.org/officeDocument/2006/extended-properties">` +
+	`<Application>git-stats</Application></Properties>`
+
+// xlsxStylesXML defines the cell formats referenced by the xlsxStyle constants
+const xlsxStylesXML = xml.Header + `<styleSheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main">` +
+	`<numFmts count="3">` +
+	`<numFmt numFmtId="164" formatCode="yyyy-mm-dd"/>` +
+	`<numFmt numFmtId="165" formatCode="yyyy-mm-dd hh:mm:ss"/>` +
+	`<numFmt numFmtId="166" formatCode="yyyy-mm"/>` +
+	`</numFmts>` +
+	`<fonts count="2"><font><sz val="11"/><name val="Calibri"/></font><font><b/><sz val="11"/><name val="Calibri"/></font></fonts>` +
+	`<fills count="2"><fill><patternFill patternType="none"/></fill><fill><patternFill patternType="gray125"/></fill></fills>` +
+	`<borders count="1"><border><left/><right/><top/><bottom/><diagonal/></border></borders>` +
+	`<cellStyleXfs count="1"><xf numFmtId="0" fontId="0" fillId="0" borderId="0"/></cellStyleXfs>` +
+	`<cellXfs count="6">` +
+	`<xf numFmtId="0" fontId="0" fillId="0" borderId="0" xfId="0"/>` +
+	`<xf numFmtId="0" fontId="1" fillId="0" borderId="0" xfId="0" applyFont="1"/>` +
+	`<xf numFmtId="164" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="165" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="2" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`<xf numFmtId="166" fontId="0" fillId="0" borderId="0" xfId="0" applyNumberFormat="1"/>` +
+	`</cellXfs>` +
+	`<cellStyles count="1"><cellStyle name="Normal" xfId="0" builtinId="0"/></cellStyles>` +
+	`</styleSheet>`
@@ -62,6 +62,8 @@ func main() {
 				os.Exit(4) // Configuration errors
 			case actions.ErrNotImplemented:
 				os.Exit(5) // Feature not implemented
+			case actions.ErrGUIUnavailable:
+				os.Exit(6) // GUI not available
 			default:
 				os.Exit(1) // General error
 			}
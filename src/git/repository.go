@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
+	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -89,6 +90,9 @@ func NewGitRepository(config RepositoryConfig) (*GitRepository, error) {
 	return repo, nil
 }
 
+// gitTimestamp is the date format passed to git's --since and --until
+const gitTimestamp = "2006-01-02 15:04:05 -0700"
+
 // GetCommits retrieves commits from the repository with optional filtering
 func (r *GitRepository) GetCommits(since, until time.Time, author string) ([]Commit, error) {
 	ctx := context.Background()
@@ -101,12 +105,13 @@ func (r *GitRepository) GetCommits(since, until time.Time, author string) ([]Com
 		"--all",
 	}
 
-	// Add date filters
+	// Add date filters; git reads a bare date as that day at the current time of day, so pass
+	// the full timestamp
 	if !since.IsZero() {
-		args = append(args, "--since="+since.Format("2006-01-02"))
+		args = append(args, "--since="+since.Format(gitTimestamp))
 	}
 	if !until.IsZero() {
-		args = append(args, "--until="+until.Format("2006-01-02"))
+		args = append(args, "--until="+until.Format(gitTimestamp))
 	}
 
 	// Add author filter
@@ -174,6 +179,54 @@ func (r *GitRepository) GetBranches() ([]string, error) {
 	return branches, nil
 }
 
+// GetRefs returns the branch and tag names pointing at each decorated commit, keyed by full hash;
+// names are as git log prints them, e.g. "HEAD -> main", "origin/main" and "tag: v1.0"
+func (r *GitRepository) GetRefs() (map[string][]string, error) {
+	ctx := context.Background()
+
+	result, err := r.executor.Execute(ctx, "log", "--all", "--simplify-by-decoration", "--pretty=format:%H|%D")
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute git log: %w", err)
+	}
+
+	return parseRefs(result.Output), nil
+}
+
+// GetFiles returns the slash-separated paths of the files tracked in the index
+func (r *GitRepository) GetFiles() ([]string, error) {
+	ctx := context.Background()
+
+	result, err := r.executor.Execute(ctx, "ls-files")
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute git ls-files: %w", err)
+	}
+
+	var files []string
+	for _, line := range strings.Split(result.Output, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			files = append(files, line)
+		}
+	}
+	return files, nil
+}
+
+// parseRefs parses "hash|ref, ref" lines into a map of hash to ref names
+func parseRefs(output string) map[string][]string {
+	refs := make(map[string][]string)
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.SplitN(strings.TrimSpace(line), "|", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			continue
+		}
+		for _, ref := range strings.Split(parts[1], ",") {
+			if ref = strings.TrimSpace(ref); ref != "" {
+				refs[parts[0]] = append(refs[parts[0]], ref)
+			}
+		}
+	}
+	return refs
+}
+
 // IsValidRepository checks if the path contains a valid git repository
 func (r *GitRepository) IsValidRepository() bool {
 	ctx := context.Background()
@@ -232,6 +285,77 @@ func (r *GitRepository) GetRepositoryInfo() (*RepositoryInfo, error) {
 	return info, nil
 }
 
+// commitHashPattern matches abbreviated or full commit hashes
+var commitHashPattern = regexp.MustCompile(`^[0-9a-fA-F]{4,64}$`)
+
+// GetCommitDetail retrieves a single commit with its full message, per-file statistics and patch
+func (r *GitRepository) GetCommitDetail(hash string) (*CommitDetail, error) {
+	if !commitHashPattern.MatchString(hash) {
+		return nil, fmt.Errorf("invalid commit hash: %s", hash)
+	}
+
+	ctx := context.Background()
+
+	// Header and per-file numstat, in the same format as GetCommits
+	result, err := r.executor.Execute(ctx, "log", "-1",
+		"--pretty=format:%H|%an|%ae|%ad|%cn|%ce|%cd|%s|%P|%T",
+		"--date=iso",
+		"--numstat",
+		hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute git log: %w", err)
+	}
+
+	commits, err := r.parser.ParseCommitLog(result.Output)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse commit: %w", err)
+	}
+	if len(commits) == 0 {
+		return nil, fmt.Errorf("commit not found: %s", hash)
+	}
+
+	detail := &CommitDetail{Commit: commits[0]}
+
+	// Replace the numstat status guess with the real one
+	result, err = r.executor.Execute(ctx, "show", "--format=", "--name-status", "--no-color", hash)
+	if err == nil {
+		applyNameStatus(&detail.Commit, result.Output)
+	}
+
+	result, err = r.executor.Execute(ctx, "show", "-s", "--format=%B", hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit message: %w", err)
+	}
+	detail.FullMessage = strings.TrimSpace(result.Output)
+
+	result, err = r.executor.Execute(ctx, "show", "--format=", "--patch", "--no-color", hash)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read commit diff: %w", err)
+	}
+	detail.Diff = result.Output
+
+	return detail, nil
+}
+
+// applyNameStatus sets file statuses from git --name-status output
+func applyNameStatus(commit *Commit, output string) {
+	statuses := make(map[string]string)
+	for _, line := range strings.Split(output, "\n") {
+		parts := strings.Split(strings.TrimSpace(line), "\t")
+		if len(parts) < 2 || parts[0] == "" {
+			continue
+		}
+		// Renames and copies carry a similarity score (R100) and list the new path last
+		statuses[parts[len(parts)-1]] = parts[0][:1]
+	}
+
+	for i, file := range commit.Stats.Files {
+		if status, ok := statuses[file.Path]; ok {
+			commit.Stats.Files[i].Status = status
+		}
+	}
+}
+
 // enhanceContributorData adds detailed statistics to a contributor
 func (r *GitRepository) enhanceContributorData(contributor *Contributor) error {
 	ctx := context.Background()
@@ -349,6 +473,13 @@ type FileChange struct {
 	Deletions  int
 }
 
+// CommitDetail is a single commit with its full message and patch
+type CommitDetail struct {
+	Commit      Commit
+	FullMessage string
+	Diff        string
+}
+
 // Contributor represents a repository contributor
 type Contributor struct {
 	Name            string
@@ -5,6 +5,7 @@ go 1.24.6
 require (
 	github.com/gdamore/tcell/v2 v2.6.0
 	github.com/rivo/tview v0.0.0-20230826224341-9754ab44dc1c
+	golang.org/x/term v0.5.0
 )
 
 require (
@@ -13,6 +14,5 @@ require (
 	github.com/mattn/go-runewidth v0.0.14 // indirect
 	github.com/rivo/uniseg v0.4.3 // indirect
 	golang.org/x/sys v0.5.0 // indirect
-	golang.org/x/term v0.5.0 // indirect
 	golang.org/x/text v0.7.0 // indirect
 )
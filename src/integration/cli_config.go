@@ -97,6 +97,9 @@ func (fcm *FilteredConfigManager) CreateAnalysisConfig(cliConfig *cli.Config) mo
 	analysisConfig := models.AnalysisConfig{
 		Limit:         cliConfig.Limit,
 		IncludeMerges: appConfig.Filters.IncludeMerges,
+		Metric:        cliConfig.Metric,
+		Levels:        cliConfig.Levels,
+		Thresholds:    cliConfig.Thresholds,
 	}
 
 	// Set time range
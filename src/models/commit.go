@@ -43,6 +43,13 @@ type FileChange struct {
 	OldPath    string `json:"old_path,omitempty"` // For renamed/copied files
 }
 
+// CommitDetail is a single commit with its full message and patch, used for drill-down views
+type CommitDetail struct {
+	Commit      Commit `json:"commit"`
+	FullMessage string `json:"full_message"`
+	Diff        string `json:"diff"`
+}
+
 // Validate checks if the commit data is valid
 func (c *Commit) Validate() error {
 	if c.Hash == "" {
@@ -0,0 +1,55 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Contributor comparison data models
+
+package models
+
+import (
+	"time"
+)
+
+// ContributorComparison compares the activity of two or more contributors over the same commits
+type ContributorComparison struct {
+	Contributors []ComparedContributor `json:"contributors"`
+	Months       []time.Time           `json:"months"`       // first day of each month in the time range, oldest first
+	SharedFiles  []SharedFile          `json:"shared_files"` // files changed by more than one compared contributor
+	TimeRange    TimeRange             `json:"-"`            // reported as the analysis time range
+}
+
+// ComparedContributor is one side of a comparison; it may combine several author identities
+type ComparedContributor struct {
+	Selector        string         `json:"selector"` // the name or email the contributor was selected by
+	Name            string         `json:"name"`
+	Email           string         `json:"email"`
+	Identities      []Author       `json:"identities"` // every author identity matched by the selector
+	TotalCommits    int            `json:"total_commits"`
+	TotalInsertions int            `json:"total_insertions"`
+	TotalDeletions  int            `json:"total_deletions"`
+	ActiveDays      int            `json:"active_days"`
+	FirstCommit     time.Time      `json:"first_commit"`
+	LastCommit      time.Time      `json:"last_commit"`
+	CommitsByDay    map[string]int `json:"commits_by_day"`   // date -> commit count
+	CommitsByMonth  []int          `json:"commits_by_month"` // aligned with ContributorComparison.Months
+	CommitsByHour   [24]int        `json:"commits_by_hour"`
+	FileTypes       map[string]int `json:"file_types"` // extension -> file changes
+	FilesTouched    int            `json:"files_touched"`
+	ExclusiveFiles  []string       `json:"exclusive_files"` // most changed files no other compared contributor touched
+}
+
+// SharedFile is a file changed by more than one compared contributor
+type SharedFile struct {
+	Path         string `json:"path"`
+	Commits      []int  `json:"commits"` // commits per compared contributor, aligned with Contributors
+	TotalCommits int    `json:"total_commits"`
+	Contributors int    `json:"contributors"` // number of compared contributors that changed the file
+}
+
+// PeakHour returns the hour of day with the most commits
+func (cc *ComparedContributor) PeakHour() int {
+	peak := 0
+	for hour, commits := range cc.CommitsByHour {
+		if commits > cc.CommitsByHour[peak] {
+			peak = hour
+		}
+	}
+	return peak
+}
@@ -11,6 +11,9 @@ type AnalysisConfig struct {
 	AuthorFilter  string
 	Limit         int
 	IncludeMerges bool
+	Metric        string // contribution graph metric, one of ContributionMetrics; empty means commits
+	Levels        string // activity level strategy, one of LevelStrategies; empty means linear
+	Thresholds    []int  // activity level thresholds of the fixed strategy
 }
 
 // RenderConfig contains configuration for visualization rendering
@@ -20,16 +23,38 @@ type RenderConfig struct {
 	ColorScheme string
 	ShowLegend  bool
 	Interactive bool
+	FitWidth    bool // cut table columns so tables fit Width; otherwise tables keep their natural size
 }
 
 // FormatConfig contains configuration for output formatting
 type FormatConfig struct {
-	Format     string // json, csv, terminal
-	OutputFile string
-	Pretty     bool
-	Metadata   bool
+	Format      string // json, csv, xlsx, terminal, template
+	OutputFile  string
+	Pretty      bool
+	Metadata    bool
+	Template    string // built-in template name or template file path
+	CSVDialect  string // default, rfc4180, excel
+	Command     string // report to render for terminal and svg output: contrib, summary, contributors, health
+	NoColor     bool   // plain text without ANSI escape sequences
+	ColorTheme  string // contribution graph color theme
+	Theme       *Theme // resolved color theme, possibly from the configuration file; nil resolves ColorTheme among the built-in themes
+	CollapseRun int    // commit graph: shortest run of linear commits to collapse, 0 shows every commit
+	ASCII       bool   // commit graph: draw lanes with ASCII instead of box-drawing characters
+	Width       int    // terminal columns to fit the report to; 0 keeps tables at their natural width
+	ByAuthor    string // contribution graph: per-author mode, one of ByAuthorModes; empty shows the total
+	TopAuthors  int    // contribution graph: authors with their own color or calendar in per-author modes
 }
 
+// Color modes of the -color flag
+const (
+	ColorAuto   = "auto"   // color terminals, honoring NO_COLOR and CLICOLOR_FORCE
+	ColorAlways = "always" // color even when writing to a pipe or file
+	ColorNever  = "never"  // plain text
+)
+
+// ColorModes lists the color modes, default first
+var ColorModes = []string{ColorAuto, ColorAlways, ColorNever}
+
 // SystemConfig contains system-wide configuration
 type SystemConfig struct {
 	DefaultDateRange    string
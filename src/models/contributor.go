@@ -20,6 +20,7 @@ type Contributor struct {
 	CommitsByDay     map[string]int `json:"commits_by_day"`     // date -> commit count
 	CommitsByHour    map[int]int    `json:"commits_by_hour"`    // hour -> commit count
 	CommitsByWeekday map[int]int    `json:"commits_by_weekday"` // weekday -> commit count
+	PunchCard        PunchCard      `json:"punch_card"`         // commits by weekday and hour
 	FileTypes        map[string]int `json:"file_types"`         // extension -> commit count
 	TopFiles         []string       `json:"top_files"`          // most frequently modified files
 }
@@ -96,6 +97,26 @@ func (c *Contributor) IsActiveInPeriod(start, end time.Time) bool {
 	return !c.LastCommit.Before(start) && !c.FirstCommit.After(end)
 }
 
+// WeeklyCommits returns the commits of the weeks days before and including end's day, oldest
+// first, counted from CommitsByDay
+func (c *Contributor) WeeklyCommits(end time.Time, weeks int) []int {
+	counts := make([]int, weeks)
+	endDay := time.Date(end.Year(), end.Month(), end.Day(), 0, 0, 0, 0, time.UTC)
+
+	for date, commits := range c.CommitsByDay {
+		day, err := time.Parse("2006-01-02", date)
+		if err != nil {
+			continue
+		}
+		daysBefore := int(endDay.Sub(day).Hours() / 24)
+		if daysBefore >= 0 && daysBefore < weeks*7 {
+			counts[weeks-1-daysBefore/7] += commits
+		}
+	}
+
+	return counts
+}
+
 // GetMostActiveHour returns the hour with most commits
 func (c *Contributor) GetMostActiveHour() int {
 	maxHour := 0
@@ -0,0 +1,44 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Directory and module statistics
+
+package models
+
+import "time"
+
+// ModuleManifests are the files whose directories -modules treats as module roots
+var ModuleManifests = []string{"go.mod", "package.json", "Cargo.toml"}
+
+// RootDirectory names the repository root among directories and modules
+const RootDirectory = "(root)"
+
+// DirectoryOptions selects how changed files are grouped into directories
+type DirectoryOptions struct {
+	Depth       int               // leading path segments of a directory; 0 or 1 groups by top-level directory
+	ModuleRoots map[string]string // module root directory ("" for the repository root) to its manifest
+}
+
+// DirectoryStats aggregates the commits that changed files in a directory or module
+type DirectoryStats struct {
+	Path          string // slash-separated directory, RootDirectory for files at the root
+	Manifest      string // go.mod, package.json or Cargo.toml for a module root, empty for a directory
+	Files         int    // distinct files changed
+	Commits       int    // commits that changed any of the files
+	Insertions    int
+	Deletions     int
+	Authors       int                  // distinct author emails
+	TopAuthors    []ContributorSummary // up to three authors with the most commits, with their share
+	FirstChange   time.Time
+	LastChange    time.Time
+	Trend         string // increasing, decreasing or stable, like the repository's activity trend
+	WeeklyCommits []int  // commits of the 12 weeks ending at the last analyzed commit, oldest first
+}
+
+// Churn returns the lines added and deleted
+func (d *DirectoryStats) Churn() int {
+	return d.Insertions + d.Deletions
+}
+
+// IsModule reports whether the directory is a module root
+func (d *DirectoryStats) IsModule() bool {
+	return d.Manifest != ""
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - File tree data models
+
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// FileTreeNode is a file or directory with the activity of everything below it
+type FileTreeNode struct {
+	Name         string
+	Path         string // slash-separated path from the repository root, empty for the root
+	IsDir        bool
+	Commits      int // commits that changed the file or anything below the directory
+	Insertions   int
+	Deletions    int
+	Authors      int    // distinct author emails
+	TopAuthor    string // name of the author with the most commits
+	LastModified time.Time
+	Children     []*FileTreeNode
+}
+
+// Churn returns the lines added and deleted
+func (n *FileTreeNode) Churn() int {
+	return n.Insertions + n.Deletions
+}
+
+// Find returns the node at path below n, or nil
+func (n *FileTreeNode) Find(path string) *FileTreeNode {
+	if path == "" || path == n.Path {
+		return n
+	}
+
+	node := n
+	for _, name := range strings.Split(path, "/") {
+		var next *FileTreeNode
+		for _, child := range node.Children {
+			if child.Name == name {
+				next = child
+				break
+			}
+		}
+		if next == nil {
+			return nil
+		}
+		node = next
+	}
+	return node
+}
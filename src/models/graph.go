@@ -0,0 +1,24 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Commit graph data models
+
+package models
+
+// CommitGraph is the commit DAG laid out in lanes like git log --graph, newest commit first
+type CommitGraph struct {
+	Rows     []CommitGraphRow
+	MaxLanes int // widest row, in lanes
+}
+
+// CommitGraphRow is one commit of the graph with the lane glyphs drawn on its line
+type CommitGraphRow struct {
+	Commit Commit
+	Lane   int      // lane of the commit's node
+	Graph  string   // lane glyphs left of the message, two columns per lane; the node is at column 2*Lane
+	Refs   []string // branches and tags pointing at the commit, as git log --decorate prints them
+	Linear bool     // single parent, no refs and no lanes joining or forking, so the row can be collapsed
+}
+
+// Merge reports whether the row's commit is a merge
+func (r CommitGraphRow) Merge() bool {
+	return len(r.Commit.ParentHashes) > 1
+}
@@ -0,0 +1,140 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Lines of code growth over time
+
+package models
+
+import (
+	"strings"
+	"time"
+)
+
+// Intervals of the code growth series
+const (
+	GrowthDay   = "day"
+	GrowthWeek  = "week"
+	GrowthMonth = "month"
+)
+
+// GrowthIntervals lists the accepted code growth intervals
+var GrowthIntervals = []string{GrowthDay, GrowthWeek, GrowthMonth}
+
+// Breakdowns of the code growth series
+const (
+	GrowthByExtension = "extension"
+	GrowthByDirectory = "directory"
+)
+
+// GrowthBreakdowns lists the accepted code growth breakdowns
+var GrowthBreakdowns = []string{GrowthByExtension, GrowthByDirectory}
+
+// Classes of paths the code growth series can leave out; any other exclusion is a glob
+const (
+	ExcludeGenerated = "generated"
+	ExcludeVendored  = "vendored"
+	ExcludeLockfiles = "lockfiles"
+)
+
+// ExclusionClasses lists the named path classes accepted by -exclude
+var ExclusionClasses = []string{ExcludeGenerated, ExcludeVendored, ExcludeLockfiles}
+
+// GrowthOptions selects the interval, breakdown and excluded paths of a code growth analysis
+type GrowthOptions struct {
+	Interval  string   // day, week or month; empty means week
+	Breakdown string   // extension, directory or empty for the total only
+	Exclude   []string // exclusion classes and path globs
+}
+
+// GrowthPoint is the line count change of one period and the running total at its end
+type GrowthPoint struct {
+	Period     time.Time // start of the period
+	Insertions int
+	Deletions  int
+	Net        int // Insertions - Deletions
+	Total      int // cumulative net lines up to the end of the period
+}
+
+// GrowthSeries is the code growth of the whole repository or of one group, oldest period first
+type GrowthSeries struct {
+	Name   string
+	Points []GrowthPoint
+}
+
+// Final returns the cumulative net lines at the end of the last period
+func (s *GrowthSeries) Final() int {
+	if len(s.Points) == 0 {
+		return 0
+	}
+	return s.Points[len(s.Points)-1].Total
+}
+
+// Insertions returns the lines added over every period
+func (s *GrowthSeries) Insertions() int {
+	total := 0
+	for _, point := range s.Points {
+		total += point.Insertions
+	}
+	return total
+}
+
+// Deletions returns the lines deleted over every period
+func (s *GrowthSeries) Deletions() int {
+	total := 0
+	for _, point := range s.Points {
+		total += point.Deletions
+	}
+	return total
+}
+
+// CodeGrowth is the cumulative net lines of code per period computed from numstat. Groups,
+// largest first, share the periods of Total; renames that move a file between groups are not
+// carried over since numstat only reports the changed lines
+type CodeGrowth struct {
+	Interval      string
+	Breakdown     string   // empty when there are no groups
+	Exclude       []string // exclusion classes and globs that were applied
+	ExcludedFiles int      // distinct paths left out
+	ExcludedLines int      // insertions and deletions left out
+	Total         GrowthSeries
+	Groups        []GrowthSeries
+}
+
+// PeriodStart returns midnight of the first day of the interval containing t, on t's calendar;
+// weeks start on Monday
+func PeriodStart(t time.Time, interval string) time.Time {
+	switch interval {
+	case GrowthDay:
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	case GrowthMonth:
+		return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, time.UTC)
+	default:
+		return WeekStart(t)
+	}
+}
+
+// NextPeriod returns the start of the interval following the one starting at start
+func NextPeriod(start time.Time, interval string) time.Time {
+	switch interval {
+	case GrowthDay:
+		return start.AddDate(0, 0, 1)
+	case GrowthMonth:
+		return start.AddDate(0, 1, 0)
+	default:
+		return start.AddDate(0, 0, 7)
+	}
+}
+
+// PeriodLabel formats the start of a period for charts and tables
+func PeriodLabel(start time.Time, interval string) string {
+	if interval == GrowthMonth {
+		return start.Format("2006-01")
+	}
+	return start.Format("2006-01-02")
+}
+
+// GrowthTitle capitalizes an interval or breakdown for headings, e.g. "week" becomes "Week"
+func GrowthTitle(word string) string {
+	if word == "" {
+		return word
+	}
+	return strings.ToUpper(word[:1]) + word[1:]
+}
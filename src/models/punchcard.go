@@ -0,0 +1,56 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Hour by weekday punch card
+
+package models
+
+import (
+	"time"
+)
+
+// PunchCard counts commits by weekday and hour of the author date: PunchCard[weekday][hour],
+// Sunday first like time.Weekday
+type PunchCard [7][24]int
+
+// Add counts a commit made at t
+func (p *PunchCard) Add(t time.Time) {
+	p[t.Weekday()][t.Hour()]++
+}
+
+// Total returns the number of commits on the card
+func (p *PunchCard) Total() int {
+	total := 0
+	for _, hours := range p {
+		for _, commits := range hours {
+			total += commits
+		}
+	}
+	return total
+}
+
+// Max returns the commits of the busiest weekday and hour
+func (p *PunchCard) Max() int {
+	weekday, hour := p.Peak()
+	return p[weekday][hour]
+}
+
+// Peak returns the busiest weekday and hour; the earliest wins ties
+func (p *PunchCard) Peak() (time.Weekday, int) {
+	peakDay, peakHour := time.Sunday, 0
+	for weekday, hours := range p {
+		for hour, commits := range hours {
+			if commits > p[peakDay][peakHour] {
+				peakDay, peakHour = time.Weekday(weekday), hour
+			}
+		}
+	}
+	return peakDay, peakHour
+}
+
+// WeekdayTotal returns the commits of a weekday
+func (p *PunchCard) WeekdayTotal(weekday time.Weekday) int {
+	total := 0
+	for _, commits := range p[weekday] {
+		total += commits
+	}
+	return total
+}
@@ -0,0 +1,18 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - GUI selection data models
+
+package models
+
+import (
+	"time"
+)
+
+// ViewSelection records the GUI view and selection an exported analysis was taken from
+type ViewSelection struct {
+	View        string
+	Date        time.Time // selected day in the contribution view, zero otherwise
+	Contributor string    // selected contributor as "Name <email>", empty when none
+	Path        string    // selected file or directory in the files view, empty for the root
+	Filters     string    // summary of the active GUI filters, empty when unfiltered
+	Commits     []Commit  // commits of the selected day, or the selected commit or run of the commit graph
+}
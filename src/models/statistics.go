@@ -4,6 +4,9 @@
 package models
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -13,7 +16,15 @@ type AnalysisResult struct {
 	Summary       *StatsSummary
 	Contributors  []ContributorStats
 	ContribGraph  *ContributionGraph
+	ContribYears  []ContributionYear // per-year totals when calendar years were requested, newest first
 	HealthMetrics *HealthMetrics
+	Comparison    *ContributorComparison
+	FileTree      *FileTreeNode
+	CommitGraph   *CommitGraph
+	Growth        *CodeGrowth      // cumulative net lines of code per period
+	Directories   []DirectoryStats // per directory or module, most commits first
+	Teams         []TeamStats      // per team when grouped by team, most commits first
+	Selection     *ViewSelection   // set when the result was exported from the GUI
 	TimeRange     TimeRange
 }
 
@@ -37,6 +48,8 @@ type StatsSummary struct {
 	AvgCommitsPerDay float64
 	CommitsByHour    map[int]int
 	CommitsByWeekday map[time.Weekday]int
+	PunchCard        PunchCard     // commits by weekday and hour
+	CommitsByWeek    []WeeklyStats // one entry per week from the first commit to the last, oldest first
 	TopFiles         []FileStats
 	TopFileTypes     []FileTypeStats
 }
@@ -47,11 +60,163 @@ type ContributorStats = Contributor
 
 // ContributionGraph represents the GitHub-style contribution graph
 type ContributionGraph struct {
-	StartDate    time.Time
-	EndDate      time.Time
-	DailyCommits map[string]int // date -> commit count
-	MaxCommits   int
-	TotalCommits int
+	StartDate   time.Time
+	EndDate     time.Time
+	Metric      string                // what each day counts, one of ContributionMetrics; empty means commits
+	DailyValues map[string]int        // date -> metric value, the commit count by default
+	MaxValue    int                   // highest daily value
+	Total       int                   // sum of the daily values
+	Levels      string                // activity level strategy, one of LevelStrategies; empty means linear
+	Thresholds  []int                 // highest daily value of activity levels 1 to 3; higher values are level 4
+	Authors     []AuthorContributions // each author's daily values, busiest first; only filled for per-author graphs
+	ByTeam      bool                  // Authors holds teams rather than people
+}
+
+// AuthorContributions holds one author's share of a contribution graph
+type AuthorContributions struct {
+	Name  string
+	Email string
+	Daily map[string]int // date -> the author's metric value, active days only
+	Total int            // sum of the daily values
+}
+
+// DominantAuthor returns the index in Authors of the author with the highest value on a date,
+// the busier author overall on ties, or -1 when no author was active that day
+func (g *ContributionGraph) DominantAuthor(date string) int {
+	dominant, best := -1, 0
+	for i, author := range g.Authors {
+		if value := author.Daily[date]; value > best {
+			dominant, best = i, value
+		}
+	}
+	return dominant
+}
+
+// AuthorNoun returns what the entries of Authors are, "team" or "author"
+func (g *ContributionGraph) AuthorNoun() string {
+	if g.ByTeam {
+		return "team"
+	}
+	return "author"
+}
+
+// LevelThresholds returns the highest daily value of activity levels 1 to 3. Graphs built
+// without thresholds split the busiest day into quarters
+func (g *ContributionGraph) LevelThresholds() []int {
+	if len(g.Thresholds) == 3 {
+		return g.Thresholds
+	}
+	return []int{g.MaxValue / 4, g.MaxValue / 2, g.MaxValue * 3 / 4}
+}
+
+// ActivityLevel returns the activity level, 0 to 4, of a daily value of the graph
+func (g *ContributionGraph) ActivityLevel(value int) int {
+	return ActivityLevel(value, g.LevelThresholds())
+}
+
+// Activity level strategies, how the daily values of a contribution graph map to levels
+const (
+	LevelsLinear   = "linear"   // quarters of the busiest day
+	LevelsQuantile = "quantile" // quartiles of the active days, like GitHub's calendar
+	LevelsLog      = "log"      // quarters of the busiest day on a log scale
+	LevelsFixed    = "fixed"    // thresholds given by the user
+)
+
+// LevelStrategies lists the activity level strategies, default first
+var LevelStrategies = []string{LevelsLinear, LevelsQuantile, LevelsLog, LevelsFixed}
+
+// Per-author contribution graph modes
+const (
+	ByAuthorDominant  = "dominant"  // each day in the color of the author with the most activity
+	ByAuthorMultiples = "multiples" // one small calendar per top author
+)
+
+// ByAuthorModes lists the per-author contribution graph modes
+var ByAuthorModes = []string{ByAuthorDominant, ByAuthorMultiples}
+
+// MaxTopAuthors is the most authors a per-author graph tells apart, one per palette color
+const MaxTopAuthors = 9
+
+// ActivityLevel returns the activity level, 0 to 4, of a daily value: 0 without activity,
+// otherwise the first level whose threshold holds the value
+func ActivityLevel(value int, thresholds []int) int {
+	if value <= 0 {
+		return 0
+	}
+	for i, threshold := range thresholds {
+		if value <= threshold {
+			return i + 1
+		}
+	}
+	return len(thresholds) + 1
+}
+
+// LevelRanges describes the daily values of each activity level above 0, e.g. "4-9" or
+// "20+"; levels the thresholds leave empty read "-"
+func LevelRanges(thresholds []int) []string {
+	ranges := make([]string, 0, len(thresholds)+1)
+	low := 1
+	for _, high := range thresholds {
+		switch {
+		case low > high:
+			ranges = append(ranges, "-")
+		case low == high:
+			ranges = append(ranges, strconv.Itoa(low))
+		default:
+			ranges = append(ranges, fmt.Sprintf("%d-%d", low, high))
+		}
+		if high+1 > low {
+			low = high + 1
+		}
+	}
+	return append(ranges, fmt.Sprintf("%d+", low))
+}
+
+// Contribution graph metrics
+const (
+	MetricCommits    = "commits"
+	MetricLines      = "lines"
+	MetricInsertions = "insertions"
+	MetricDeletions  = "deletions"
+	MetricFiles      = "files"
+	MetricAuthors    = "authors"
+)
+
+// ContributionMetrics lists the values a contribution graph can count per day, default first
+var ContributionMetrics = []string{MetricCommits, MetricLines, MetricInsertions, MetricDeletions, MetricFiles, MetricAuthors}
+
+// MetricLabel returns what a contribution graph counts per day, as shown in legends
+func MetricLabel(metric string) string {
+	switch metric {
+	case MetricLines:
+		return "lines changed"
+	case MetricFiles:
+		return "files touched"
+	case MetricAuthors:
+		return "active authors"
+	case MetricInsertions, MetricDeletions:
+		return metric
+	default:
+		return "commits"
+	}
+}
+
+// MetricTitle returns the label of a metric in title case, e.g. "Lines Changed"
+func MetricTitle(metric string) string {
+	words := strings.Fields(MetricLabel(metric))
+	for i, word := range words {
+		words[i] = strings.ToUpper(word[:1]) + word[1:]
+	}
+	return strings.Join(words, " ")
+}
+
+// ContributionYear summarizes one calendar year of a contribution graph
+type ContributionYear struct {
+	Year          int
+	Total         int // sum of the year's daily values
+	ActiveDays    int
+	MaxValue      int // highest value of a single day
+	LongestStreak int // consecutive active days
 }
 
 // HealthMetrics contains repository health indicators
@@ -89,6 +254,25 @@ type MonthlyStats struct {
 	Authors int
 }
 
+// WeeklyStats contains statistics for the week starting on Week, a Monday
+type WeeklyStats struct {
+	Week       time.Time
+	Commits    int
+	Insertions int
+	Deletions  int
+}
+
+// NetLines returns the lines added minus the lines deleted during the week
+func (w WeeklyStats) NetLines() int {
+	return w.Insertions - w.Deletions
+}
+
+// WeekStart returns midnight of the Monday starting the week of t, on t's calendar
+func WeekStart(t time.Time) time.Time {
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return day.AddDate(0, 0, -(int(day.Weekday())+6)%7)
+}
+
 // TimeRange represents a time period for analysis
 type TimeRange struct {
 	Start time.Time
@@ -0,0 +1,96 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Team definitions and statistics
+
+package models
+
+import (
+	"path"
+	"strings"
+	"time"
+)
+
+// NoTeam collects the commits of authors who belong to no team
+const NoTeam = "(no team)"
+
+// TeamMember matches the emails of a team's members, optionally only while they were on the team
+type TeamMember struct {
+	Pattern string    // email or glob like *@infra.example.com, matched case insensitively
+	Since   time.Time // first day on the team; zero when unbounded
+	Until   time.Time // last day on the team; zero when unbounded
+}
+
+// Matches reports whether a commit by email at when counts for the member
+func (m *TeamMember) Matches(email string, when time.Time) bool {
+	matched, err := path.Match(strings.ToLower(m.Pattern), strings.ToLower(email))
+	if err != nil || !matched {
+		return false
+	}
+
+	day := time.Date(when.Year(), when.Month(), when.Day(), 0, 0, 0, 0, time.UTC)
+	if !m.Since.IsZero() && day.Before(m.Since) {
+		return false
+	}
+	return m.Until.IsZero() || !day.After(m.Until)
+}
+
+// Team is a named group of members
+type Team struct {
+	Name    string
+	Members []TeamMember
+}
+
+// Teams is the team definition file's teams in the order they are defined
+type Teams []Team
+
+// TeamOf returns the team a commit by email at when counts for: the first team with a matching
+// member, or NoTeam
+func (t Teams) TeamOf(email string, when time.Time) string {
+	for i := range t {
+		for j := range t[i].Members {
+			if t[i].Members[j].Matches(email, when) {
+				return t[i].Name
+			}
+		}
+	}
+	return NoTeam
+}
+
+// Find returns the team named name, ignoring case, or nil
+func (t Teams) Find(name string) *Team {
+	for i := range t {
+		if strings.EqualFold(t[i].Name, name) {
+			return &t[i]
+		}
+	}
+	return nil
+}
+
+// Names returns the names of the teams
+func (t Teams) Names() []string {
+	names := make([]string, len(t))
+	for i := range t {
+		names[i] = t[i].Name
+	}
+	return names
+}
+
+// TeamStats rolls up the commits of a team's members
+type TeamStats struct {
+	Name        string
+	Members     int // distinct author emails that committed for the team
+	Commits     int
+	Insertions  int
+	Deletions   int
+	ActiveDays  int       // days with at least one commit
+	Files       int       // distinct files changed
+	OwnedFiles  int       // changed files the team made the most commits to
+	Ownership   float64   // percentage of all changed files the team owns
+	CommitShare float64   // percentage of all commits
+	FirstCommit time.Time // earliest commit
+	LastCommit  time.Time // latest commit
+}
+
+// Churn returns the lines added and deleted
+func (s *TeamStats) Churn() int {
+	return s.Insertions + s.Deletions
+}
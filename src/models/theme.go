@@ -0,0 +1,140 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Contribution graph color themes
+
+package models
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// RGB is a 24-bit color
+type RGB struct {
+	R, G, B uint8
+}
+
+// Theme is a named contribution graph palette, the color of no activity first and then
+// increasing activity
+type Theme struct {
+	Name   string
+	Colors []RGB
+	ANSI16 []string // SGR parameters of each color on 16-color terminals; nil derives them from Colors
+}
+
+// DefaultThemeName is the theme used when none is configured
+const DefaultThemeName = "github"
+
+// BuiltinThemes are the palettes available without configuration. viridis and cividis stay
+// distinguishable with the common forms of color blindness
+var BuiltinThemes = map[string][]string{
+	"github":  {"#30363d", "#0e4429", "#006d32", "#26a641", "#39d353"},
+	"blue":    {"#30363d", "#0c2d6b", "#1f6feb", "#58a6ff", "#a5d6ff"},
+	"fire":    {"#30363d", "#6e2c00", "#c2410c", "#f97316", "#fde047"},
+	"mono":    {"#30363d", "#484f58", "#8b949e", "#c9d1d9", "#f0f6fc"},
+	"viridis": {"#30363d", "#3b528b", "#21918c", "#5ec962", "#fde725"},
+	"cividis": {"#30363d", "#35456c", "#666970", "#948e77", "#fee838"},
+}
+
+// BuiltinThemes16 are the built-in palettes on 16-color terminals, as SGR parameters. The nearest
+// of the 16 colors would merge neighboring levels, so each level gets its own color
+var BuiltinThemes16 = map[string][]string{
+	"github":  {"90", "92", "32", "32;1", "33;1"},
+	"blue":    {"90", "94", "34", "34;1", "36;1"},
+	"fire":    {"90", "93", "33", "31;1", "35;1"},
+	"mono":    {"90", "2;37", "37", "97", "97;1"},
+	"viridis": {"90", "34", "36", "32", "93"},
+	"cividis": {"90", "34", "37", "33", "93"},
+}
+
+// BuiltinThemeNames returns the names of the built-in themes in alphabetical order
+func BuiltinThemeNames() []string {
+	names := make([]string, 0, len(BuiltinThemes))
+	for name := range BuiltinThemes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewTheme builds a theme from hex colors such as "#26a641"; it needs at least the color
+// of no activity and one of activity
+func NewTheme(name string, colors []string) (*Theme, error) {
+	if len(colors) < 2 {
+		return nil, fmt.Errorf("theme '%s' needs at least 2 colors, got %d", name, len(colors))
+	}
+
+	theme := &Theme{Name: name, Colors: make([]RGB, len(colors))}
+	for i, color := range colors {
+		rgb, err := ParseHexColor(color)
+		if err != nil {
+			return nil, fmt.Errorf("theme '%s': %w", name, err)
+		}
+		theme.Colors[i] = rgb
+	}
+	return theme, nil
+}
+
+// ResolveTheme returns the theme called name, looking in custom before the built-in themes;
+// an empty name is the default theme
+func ResolveTheme(name string, custom map[string][]string) (*Theme, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		name = DefaultThemeName
+	}
+
+	for customName, colors := range custom {
+		if strings.ToLower(customName) == name {
+			return NewTheme(name, colors)
+		}
+	}
+	if colors, ok := BuiltinThemes[name]; ok {
+		theme, err := NewTheme(name, colors)
+		if err == nil {
+			theme.ANSI16 = BuiltinThemes16[name]
+		}
+		return theme, err
+	}
+
+	return nil, fmt.Errorf("unknown color theme '%s'. Built-in themes: %s; define others under output.themes in the configuration file",
+		name, strings.Join(BuiltinThemeNames(), ", "))
+}
+
+// LevelColor returns the color of an activity level from 0 to 4. Level 0 takes the first
+// color and the active levels spread over the rest, so palettes of any length fit
+func (t *Theme) LevelColor(level int) RGB {
+	return t.Colors[t.LevelIndex(level)]
+}
+
+// LevelIndex returns the index in Colors of an activity level from 0 to 4
+func (t *Theme) LevelIndex(level int) int {
+	if level <= 0 || len(t.Colors) == 1 {
+		return 0
+	}
+	if level > 4 {
+		level = 4
+	}
+
+	active := len(t.Colors) - 1
+	return 1 + ((level-1)*(active-1)+1)/3
+}
+
+// ParseHexColor parses a color written as #rrggbb or #rgb
+func ParseHexColor(color string) (RGB, error) {
+	hex := strings.TrimPrefix(strings.TrimSpace(color), "#")
+	if len(hex) == 3 {
+		hex = string([]byte{hex[0], hex[0], hex[1], hex[1], hex[2], hex[2]})
+	}
+
+	value, err := strconv.ParseUint(hex, 16, 32)
+	if len(hex) != 6 || err != nil {
+		return RGB{}, fmt.Errorf("invalid color '%s': expected #rrggbb", color)
+	}
+	return RGB{R: uint8(value >> 16), G: uint8(value >> 8), B: uint8(value)}, nil
+}
+
+// Hex returns the color written as #rrggbb
+func (c RGB) Hex() string {
+	return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+}
@@ -9,20 +9,33 @@ import (
 	"sort"
 	"strings"
 	"time"
+	"unicode/utf8"
 )
 
+// minColumnWidth is the narrowest a table column gets when the table is fit to the render width
+const minColumnWidth = 5
+
+// minBarWidth is the narrowest a bar chart gets when it is fit to the render width
+const minBarWidth = 10
+
 // ChartsRenderer implements the ChartsVisualizer interface
 type ChartsRenderer struct {
-	config models.RenderConfig
+	config    models.RenderConfig
+	useColors bool
 }
 
-// NewChartsRenderer creates a new charts renderer
+// NewChartsRenderer creates a new charts renderer; charts are plain until colors are enabled
 func NewChartsRenderer(config models.RenderConfig) *ChartsRenderer {
 	return &ChartsRenderer{
 		config: config,
 	}
 }
 
+// SetColorOptions enables or disables ANSI colors for the series of line charts
+func (cr *ChartsRenderer) SetColorOptions(useColors bool) {
+	cr.useColors = useColors
+}
+
 // RenderBarChart renders a horizontal bar chart for the given data
 func (cr *ChartsRenderer) RenderBarChart(data map[string]int, title string, config models.RenderConfig) (string, error) {
 	if data == nil || len(data) == 0 {
@@ -57,17 +70,20 @@ func (cr *ChartsRenderer) RenderBarChart(data map[string]int, title string, conf
 		return items[i].value > items[j].value
 	})
 
-	// Calculate bar width based on config or default
-	maxBarWidth := config.Width
-	if maxBarWidth <= 0 {
-		maxBarWidth = 50
-	}
-
 	// Find the longest key for alignment
 	maxKeyLength := 0
 	for _, item := range items {
-		if len(item.key) > maxKeyLength {
-			maxKeyLength = len(item.key)
+		if length := utf8.RuneCountInString(item.key); length > maxKeyLength {
+			maxKeyLength = length
+		}
+	}
+
+	// Bars take the render width left after the key, separator and value
+	maxBarWidth := 50
+	if config.Width > 0 {
+		maxBarWidth = config.Width - maxKeyLength - utf8.RuneCountInString(" │ ") - len(fmt.Sprintf(" %d", maxValue))
+		if maxBarWidth < minBarWidth {
+			maxBarWidth = minBarWidth
 		}
 	}
 
@@ -106,36 +122,42 @@ func (cr *ChartsRenderer) RenderTable(headers []string, rows [][]string, config
 	// Calculate column widths
 	colWidths := make([]int, len(headers))
 
-	// Initialize with header lengths
-	for i, header := range headers {
-		colWidths[i] = len(header)
-	}
+	cellWidths := make([]int, len(headers))
 
 	// Check row data for maximum widths
 	for _, row := range rows {
 		for i, cell := range row {
-			if i < len(colWidths) && len(cell) > colWidths[i] {
-				colWidths[i] = len(cell)
+			if i < len(cellWidths) && utf8.RuneCountInString(cell) > cellWidths[i] {
+				cellWidths[i] = utf8.RuneCountInString(cell)
 			}
 		}
 	}
 
-	// Add padding
-	for i := range colWidths {
-		colWidths[i] += 2
+	// Widen to the header lengths
+	for i, header := range headers {
+		colWidths[i] = cellWidths[i]
+		if length := utf8.RuneCountInString(header); length > colWidths[i] {
+			colWidths[i] = length
+		}
+	}
+
+	// Narrow the widest columns so the table fits the render width, cutting headers before cells
+	if config.FitWidth && config.Width > 0 {
+		fitColumns(colWidths, cellWidths, config.Width)
+		fitColumns(colWidths, nil, config.Width)
 	}
 
 	// Render header
 	result.WriteString("│")
 	for i, header := range headers {
-		result.WriteString(fmt.Sprintf(" %-*s │", colWidths[i]-2, header))
+		result.WriteString(fmt.Sprintf(" %-*s │", colWidths[i], truncateRunes(header, colWidths[i])))
 	}
 	result.WriteString("\n")
 
 	// Render header separator
 	result.WriteString("├")
 	for i, width := range colWidths {
-		result.WriteString(strings.Repeat("─", width))
+		result.WriteString(strings.Repeat("─", width+2))
 		if i < len(colWidths)-1 {
 			result.WriteString("┼")
 		}
@@ -147,7 +169,7 @@ func (cr *ChartsRenderer) RenderTable(headers []string, rows [][]string, config
 		result.WriteString("│")
 		for i, cell := range row {
 			if i < len(colWidths) {
-				result.WriteString(fmt.Sprintf(" %-*s │", colWidths[i]-2, cell))
+				result.WriteString(fmt.Sprintf(" %-*s │", colWidths[i], truncateRunes(cell, colWidths[i])))
 			}
 		}
 		result.WriteString("\n")
@@ -156,7 +178,7 @@ func (cr *ChartsRenderer) RenderTable(headers []string, rows [][]string, config
 	// Render bottom border
 	result.WriteString("└")
 	for i, width := range colWidths {
-		result.WriteString(strings.Repeat("─", width))
+		result.WriteString(strings.Repeat("─", width+2))
 		if i < len(colWidths)-1 {
 			result.WriteString("┴")
 		}
@@ -166,6 +188,37 @@ func (cr *ChartsRenderer) RenderTable(headers []string, rows [][]string, config
 	return result.String(), nil
 }
 
+// fitColumns narrows the widest columns, one character at a time, until a table of the given
+// column widths fits width characters. No column gets narrower than its floor, nor than
+// minColumnWidth; nil floors leave only minColumnWidth
+func fitColumns(colWidths, floors []int, width int) {
+	floor := func(i int) int {
+		if floors != nil && floors[i] > minColumnWidth {
+			return floors[i]
+		}
+		return minColumnWidth
+	}
+
+	total := 1 // left border
+	for _, colWidth := range colWidths {
+		total += colWidth + 3 // padding and right border
+	}
+
+	for total > width {
+		widest := -1
+		for i, colWidth := range colWidths {
+			if colWidth > floor(i) && (widest < 0 || colWidth > colWidths[widest]) {
+				widest = i
+			}
+		}
+		if widest < 0 {
+			return
+		}
+		colWidths[widest]--
+		total--
+	}
+}
+
 // RenderSummaryStats renders a comprehensive summary of repository statistics
 func (cr *ChartsRenderer) RenderSummaryStats(summary *models.StatsSummary, config models.RenderConfig) (string, error) {
 	if summary == nil {
@@ -185,6 +238,36 @@ func (cr *ChartsRenderer) RenderSummaryStats(summary *models.StatsSummary, confi
 	result.WriteString(fmt.Sprintf("Active Days:         %d\n", summary.ActiveDays))
 	result.WriteString(fmt.Sprintf("Avg Commits/Day:     %.2f\n\n", summary.AvgCommitsPerDay))
 
+	// Weekly commits and net lines over time
+	if len(summary.CommitsByWeek) > 1 {
+		labels := make([]string, len(summary.CommitsByWeek))
+		commits := make([]float64, len(summary.CommitsByWeek))
+		netLines := make([]float64, len(summary.CommitsByWeek))
+		layout := "Jan 02"
+		if summary.CommitsByWeek[0].Week.Year() != summary.CommitsByWeek[len(summary.CommitsByWeek)-1].Week.Year() {
+			layout = "Jan 02 2006" // weeks from different years need the year to tell them apart
+		}
+		for i, week := range summary.CommitsByWeek {
+			labels[i] = week.Week.Format(layout)
+			commits[i] = float64(week.Commits)
+			netLines[i] = float64(week.NetLines())
+		}
+
+		result.WriteString("Commits per Week\n")
+		result.WriteString("----------------\n")
+		if chart, err := cr.RenderLineChart(labels, []ChartSeries{{Name: "Commits", Values: commits}}, config); err == nil {
+			result.WriteString(chart)
+		}
+		result.WriteString("\n")
+
+		result.WriteString("Net Lines per Week\n")
+		result.WriteString("------------------\n")
+		if chart, err := cr.RenderLineChart(labels, []ChartSeries{{Name: "Net lines", Values: netLines}}, config); err == nil {
+			result.WriteString(chart)
+		}
+		result.WriteString("\n")
+	}
+
 	// Commits by Hour
 	if len(summary.CommitsByHour) > 0 {
 		result.WriteString("Commits by Hour\n")
@@ -290,36 +373,8 @@ func (cr *ChartsRenderer) RenderContributorStats(contributors []models.Contribut
 	result.WriteString("Contributor Statistics\n")
 	result.WriteString("======================\n\n")
 
-	// Calculate total commits for percentage calculation
-	totalCommits := 0
-	for _, contributor := range contributors {
-		totalCommits += contributor.TotalCommits
-	}
-
 	// Summary table with percentages (Requirement 3.1)
-	headers := []string{"Name", "Email", "Commits", "Percentage", "Insertions", "Deletions", "Active Days", "First Commit", "Last Commit"}
-	var rows [][]string
-
-	for _, contributor := range contributors {
-		percentage := 0.0
-		if totalCommits > 0 {
-			percentage = float64(contributor.TotalCommits) / float64(totalCommits) * 100
-		}
-
-		row := []string{
-			contributor.Name,
-			contributor.Email,
-			fmt.Sprintf("%d", contributor.TotalCommits),
-			fmt.Sprintf("%.1f%%", percentage),
-			fmt.Sprintf("%d", contributor.TotalInsertions),
-			fmt.Sprintf("%d", contributor.TotalDeletions),
-			fmt.Sprintf("%d", contributor.ActiveDays),
-			contributor.FirstCommit.Format("2006-01-02"),
-			contributor.LastCommit.Format("2006-01-02"),
-		}
-		rows = append(rows, row)
-	}
-
+	headers, rows := ContributorTable(contributors)
 	table, err := cr.RenderTable(headers, rows, config)
 	if err != nil {
 		return "", fmt.Errorf("failed to render contributor table: %v", err)
@@ -360,6 +415,77 @@ func (cr *ChartsRenderer) RenderContributorStats(contributors []models.Contribut
 	return result.String(), nil
 }
 
+// ContributorTable returns the headers and rows of the contributor table, one row per
+// contributor with their share of the commits and a sparkline of their last 12 weeks
+func ContributorTable(contributors []models.Contributor) ([]string, [][]string) {
+	totalCommits := 0
+	for _, contributor := range contributors {
+		totalCommits += contributor.TotalCommits
+	}
+
+	lastCommit := latestCommit(contributors)
+
+	headers := []string{"Name", "Email", "Commits", "Percentage", "Insertions", "Deletions", "Active Days", "First Commit", "Last Commit", "Last 12 Weeks"}
+	var rows [][]string
+
+	for _, contributor := range contributors {
+		percentage := 0.0
+		if totalCommits > 0 {
+			percentage = float64(contributor.TotalCommits) / float64(totalCommits) * 100
+		}
+
+		row := []string{
+			contributor.Name,
+			contributor.Email,
+			fmt.Sprintf("%d", contributor.TotalCommits),
+			fmt.Sprintf("%.1f%%", percentage),
+			fmt.Sprintf("%d", contributor.TotalInsertions),
+			fmt.Sprintf("%d", contributor.TotalDeletions),
+			fmt.Sprintf("%d", contributor.ActiveDays),
+			contributor.FirstCommit.Format("2006-01-02"),
+			contributor.LastCommit.Format("2006-01-02"),
+			Sparkline(contributor.WeeklyCommits(lastCommit, 12)),
+		}
+		rows = append(rows, row)
+	}
+
+	return headers, rows
+}
+
+// latestCommit returns the last commit of the most recent contributor, where the weekly
+// sparklines of contributors end
+func latestCommit(contributors []models.Contributor) time.Time {
+	var latest time.Time
+	for _, contributor := range contributors {
+		if contributor.LastCommit.After(latest) {
+			latest = contributor.LastCommit
+		}
+	}
+	return latest
+}
+
+// fillMonths returns the monthly stats with the months without commits between them added
+func fillMonths(growth []models.MonthlyStats) []models.MonthlyStats {
+	if len(growth) == 0 {
+		return growth
+	}
+
+	byMonth := make(map[time.Time]models.MonthlyStats, len(growth))
+	for _, month := range growth {
+		byMonth[month.Month] = month
+	}
+
+	var months []models.MonthlyStats
+	for month := growth[0].Month; !month.After(growth[len(growth)-1].Month); month = month.AddDate(0, 1, 0) {
+		stats, ok := byMonth[month]
+		if !ok {
+			stats = models.MonthlyStats{Month: month}
+		}
+		months = append(months, stats)
+	}
+	return months
+}
+
 // RenderHealthMetrics renders repository health metrics
 func (cr *ChartsRenderer) RenderHealthMetrics(health *models.HealthMetrics, config models.RenderConfig) (string, error) {
 	if health == nil {
@@ -379,8 +505,29 @@ func (cr *ChartsRenderer) RenderHealthMetrics(health *models.HealthMetrics, conf
 	result.WriteString(fmt.Sprintf("Branch Count:         %d\n", health.BranchCount))
 	result.WriteString(fmt.Sprintf("Activity Trend:       %s\n\n", health.ActivityTrend))
 
-	// Monthly growth chart
-	if len(health.MonthlyGrowth) > 0 {
+	// Monthly commits and authors over time; a single month is shown as a bar
+	if len(health.MonthlyGrowth) > 1 {
+		result.WriteString("Monthly Activity\n")
+		result.WriteString("----------------\n")
+
+		months := fillMonths(health.MonthlyGrowth)
+		labels := make([]string, len(months))
+		commits := make([]float64, len(months))
+		authors := make([]float64, len(months))
+		for i, month := range months {
+			labels[i] = month.Month.Format("2006-01")
+			commits[i] = float64(month.Commits)
+			authors[i] = float64(month.Authors)
+		}
+
+		monthChart, err := cr.RenderLineChart(labels, []ChartSeries{
+			{Name: "Commits", Values: commits},
+			{Name: "Authors", Values: authors},
+		}, config)
+		if err == nil {
+			result.WriteString(monthChart)
+		}
+	} else if len(health.MonthlyGrowth) > 0 {
 		result.WriteString("Monthly Activity\n")
 		result.WriteString("----------------\n")
 
@@ -715,25 +862,7 @@ func (cr *ChartsRenderer) RenderFileStatistics(summary *models.StatsSummary, con
 		result.WriteString("Most Frequently Modified Files\n")
 		result.WriteString("------------------------------\n")
 
-		headers := []string{"Rank", "File", "Commits", "Insertions", "Deletions", "Net Changes", "Last Modified"}
-		var rows [][]string
-
-		for i, file := range summary.TopFiles {
-			netChanges := file.Insertions - file.Deletions
-			netChangeStr := fmt.Sprintf("%+d", netChanges)
-
-			row := []string{
-				fmt.Sprintf("#%d", i+1),
-				file.Path,
-				fmt.Sprintf("%d", file.Commits),
-				fmt.Sprintf("%d", file.Insertions),
-				fmt.Sprintf("%d", file.Deletions),
-				netChangeStr,
-				file.LastModified.Format("2006-01-02"),
-			}
-			rows = append(rows, row)
-		}
-
+		headers, rows := FileTable(summary.TopFiles)
 		table, err := cr.RenderTable(headers, rows, config)
 		if err == nil {
 			result.WriteString(table)
@@ -848,3 +977,28 @@ func (cr *ChartsRenderer) RenderFileStatistics(summary *models.StatsSummary, con
 
 	return result.String(), nil
 }
+
+// FileTable returns the headers and rows of the file table, one row per file ranked in the
+// order given
+func FileTable(files []models.FileStats) ([]string, [][]string) {
+	headers := []string{"Rank", "File", "Commits", "Insertions", "Deletions", "Net Changes", "Last Modified"}
+	var rows [][]string
+
+	for i, file := range files {
+		netChanges := file.Insertions - file.Deletions
+		netChangeStr := fmt.Sprintf("%+d", netChanges)
+
+		row := []string{
+			fmt.Sprintf("#%d", i+1),
+			file.Path,
+			fmt.Sprintf("%d", file.Commits),
+			fmt.Sprintf("%d", file.Insertions),
+			fmt.Sprintf("%d", file.Deletions),
+			netChangeStr,
+			file.LastModified.Format("2006-01-02"),
+		}
+		rows = append(rows, row)
+	}
+
+	return headers, rows
+}
@@ -0,0 +1,108 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Commit drill-down rendering for the GUI
+
+package visualizers
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"git-stats/models"
+)
+
+// tagPattern matches text that tview would interpret as a color or region tag
+var tagPattern = regexp.MustCompile(`(\[[a-zA-Z0-9_,;: \-\."#]+\[*)\]`)
+
+// EscapeTags escapes text so tview renders it literally
+func EscapeTags(text string) string {
+	return tagPattern.ReplaceAllString(text, "$1[]")
+}
+
+// FormatCommitDetail renders a commit's header, full message, file list and colorized diff
+// using tview color tags; position and total describe the commit's place in the day's list
+func FormatCommitDetail(detail *models.CommitDetail, position, total int) string {
+	if detail == nil {
+		return "No commit selected"
+	}
+
+	commit := detail.Commit
+	var content strings.Builder
+
+	if total > 1 {
+		content.WriteString(fmt.Sprintf("[gray]Commit %d of %d on %s[white]\n\n",
+			position+1, total, commit.AuthorDate.Format("2006-01-02")))
+	}
+
+	content.WriteString(fmt.Sprintf("[yellow]commit %s[white]\n", commit.Hash))
+	if len(commit.ParentHashes) > 1 {
+		content.WriteString(fmt.Sprintf("[yellow]Merge:[white]  %s\n", strings.Join(commit.ParentHashes, " ")))
+	}
+	content.WriteString(fmt.Sprintf("[yellow]Author:[white] %s <%s>\n",
+		EscapeTags(commit.Author.Name), EscapeTags(commit.Author.Email)))
+	content.WriteString(fmt.Sprintf("[yellow]Date:[white]   %s\n\n", commit.AuthorDate.Format("2006-01-02 15:04:05 -0700")))
+
+	message := detail.FullMessage
+	if message == "" {
+		message = commit.Message
+	}
+	for _, line := range strings.Split(message, "\n") {
+		content.WriteString("    " + EscapeTags(strings.ReplaceAll(line, "\t", "    ")) + "\n")
+	}
+
+	content.WriteString(fmt.Sprintf("\n[cyan]Files changed: %d, [green]+%d[cyan] / [red]-%d[white]\n",
+		commit.Stats.FilesChanged, commit.Stats.Insertions, commit.Stats.Deletions))
+	for _, file := range commit.Stats.Files {
+		content.WriteString(fmt.Sprintf("  %s %-50s [green]+%-5d[red]-%d[white]\n",
+			file.Status, EscapeTags(file.Path), file.Insertions, file.Deletions))
+	}
+
+	if detail.Diff != "" {
+		content.WriteString("\n")
+		for _, line := range strings.Split(strings.TrimRight(detail.Diff, "\n"), "\n") {
+			content.WriteString(colorizeDiffLine(line) + "\n")
+		}
+	}
+
+	return content.String()
+}
+
+// colorizeDiffLine highlights a single line of unified diff output
+func colorizeDiffLine(line string) string {
+	// Tabs are expanded because tview measures them as a single cell
+	escaped := EscapeTags(strings.ReplaceAll(line, "\t", "    "))
+
+	switch {
+	case strings.HasPrefix(line, "diff --git"):
+		return "[yellow::b]" + escaped + "[-:-:-]"
+	case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+		return "[white::b]" + escaped + "[-:-:-]"
+	case strings.HasPrefix(line, "@@"):
+		return "[cyan]" + escaped + "[white]"
+	case strings.HasPrefix(line, "+"):
+		return "[green]" + escaped + "[white]"
+	case strings.HasPrefix(line, "-"):
+		return "[red]" + escaped + "[white]"
+	case strings.HasPrefix(line, "index "), strings.HasPrefix(line, "new file"),
+		strings.HasPrefix(line, "deleted file"), strings.HasPrefix(line, "similarity"),
+		strings.HasPrefix(line, "rename "), strings.HasPrefix(line, "Binary files"):
+		return "[gray]" + escaped + "[white]"
+	default:
+		return escaped
+	}
+}
+
+// FindContributor returns the index of the contributor matching author, preferring the email, or -1
+func FindContributor(contributors []models.Contributor, author models.Author) int {
+	for i, contributor := range contributors {
+		if author.Email != "" && strings.EqualFold(contributor.Email, author.Email) {
+			return i
+		}
+	}
+	for i, contributor := range contributors {
+		if contributor.Name == author.Name {
+			return i
+		}
+	}
+	return -1
+}
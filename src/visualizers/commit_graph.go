@@ -0,0 +1,263 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Commit graph (DAG) renderer
+
+package visualizers
+
+import (
+	"fmt"
+	"git-stats/models"
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// DefaultCollapseRun is the shortest run of linear commits that is collapsed by default
+const DefaultCollapseRun = 5
+
+// asciiGraph replaces the box-drawing glyphs of a commit graph with ASCII
+var asciiGraph = strings.NewReplacer(
+	"●", "*", "│", "|", "─", "-", "┆", ":",
+	"┘", "/", "╭", "/", "└", "\\", "╮", "\\",
+	"┤", "+", "├", "+", "┴", "+", "┬", "+", "┼", "+",
+)
+
+// CommitGraphLine is one line of a rendered commit graph: a commit or a collapsed run of commits
+type CommitGraphLine struct {
+	Row    int // index in CommitGraph.Rows of the commit, or of the first hidden commit of a run
+	Hidden int // commits hidden by a collapsed run, 0 for a commit line
+}
+
+// CollapseCommitGraph returns the lines of graph, folding each run of at least minRun linear
+// commits on the same lanes into its first and last commit around a summary line. Runs whose
+// first hidden row is in expanded stay open; a minRun below 3 shows every commit
+func CollapseCommitGraph(graph *models.CommitGraph, minRun int, expanded map[int]bool) []CommitGraphLine {
+	if graph == nil {
+		return nil
+	}
+
+	lines := make([]CommitGraphLine, 0, len(graph.Rows))
+	for start := 0; start < len(graph.Rows); {
+		end := start + 1
+		if graph.Rows[start].Linear {
+			for end < len(graph.Rows) && graph.Rows[end].Linear && graph.Rows[end].Graph == graph.Rows[start].Graph {
+				end++
+			}
+		}
+
+		if minRun >= 3 && end-start >= minRun && !expanded[start+1] {
+			lines = append(lines,
+				CommitGraphLine{Row: start},
+				CommitGraphLine{Row: start + 1, Hidden: end - start - 2},
+				CommitGraphLine{Row: end - 1})
+		} else {
+			for row := start; row < end; row++ {
+				lines = append(lines, CommitGraphLine{Row: row})
+			}
+		}
+		start = end
+	}
+	return lines
+}
+
+// CommitGraphRenderer renders a commit graph as text like git log --graph --oneline
+type CommitGraphRenderer struct {
+	config       models.RenderConfig
+	useColors    bool
+	ascii        bool
+	authorColors map[string]string // by lowercased author email
+}
+
+// NewCommitGraphRenderer creates a new commit graph renderer
+func NewCommitGraphRenderer(config models.RenderConfig) *CommitGraphRenderer {
+	return &CommitGraphRenderer{
+		config:    config,
+		useColors: true,
+	}
+}
+
+// SetColorOptions enables or disables ANSI colors
+func (cgr *CommitGraphRenderer) SetColorOptions(useColors bool) {
+	cgr.useColors = useColors
+}
+
+// SetASCII draws the lanes with ASCII characters instead of box-drawing glyphs
+func (cgr *CommitGraphRenderer) SetASCII(ascii bool) {
+	cgr.ascii = ascii
+}
+
+// RenderGraph renders every line of graph, collapsing linear runs of at least minRun commits
+func (cgr *CommitGraphRenderer) RenderGraph(graph *models.CommitGraph, minRun int) (string, error) {
+	if graph == nil || len(graph.Rows) == 0 {
+		return "", fmt.Errorf("commit graph cannot be nil or empty")
+	}
+
+	var result strings.Builder
+	for _, line := range cgr.RenderLines(graph, CollapseCommitGraph(graph, minRun, nil)) {
+		result.WriteString(line)
+		result.WriteString("\n")
+	}
+	return result.String(), nil
+}
+
+// RenderLines renders the given lines of graph without trailing newlines; authors are colored
+// in order of their first commit in the graph
+func (cgr *CommitGraphRenderer) RenderLines(graph *models.CommitGraph, lines []CommitGraphLine) []string {
+	cgr.assignAuthorColors(graph)
+
+	rendered := make([]string, 0, len(lines))
+	for _, line := range lines {
+		if line.Hidden > 0 {
+			rendered = append(rendered, cgr.renderRun(graph, line))
+		} else {
+			rendered = append(rendered, cgr.renderRow(graph, graph.Rows[line.Row]))
+		}
+	}
+	return rendered
+}
+
+// renderRow renders a commit line: lanes, abbreviated hash, decorations, subject, author and date
+func (cgr *CommitGraphRenderer) renderRow(graph *models.CommitGraph, row models.CommitGraphRow) string {
+	commit := row.Commit
+	color := cgr.authorColors[strings.ToLower(commit.Author.Email)]
+
+	// The node takes its author's color, the lanes stay plain
+	lanes := []rune(cgr.padGraph(graph, row.Graph))
+	node := string(lanes[2*row.Lane])
+	prefix := string(lanes[:2*row.Lane]) + cgr.colorize(node, color) + string(lanes[2*row.Lane+1:])
+
+	hash := commit.Hash
+	if len(hash) > 7 {
+		hash = hash[:7]
+	}
+
+	var text strings.Builder
+	text.WriteString(prefix)
+	text.WriteString(" ")
+	text.WriteString(cgr.colorize(hash, ColorDim))
+	text.WriteString(" ")
+	if len(row.Refs) > 0 {
+		text.WriteString(cgr.renderRefs(row.Refs))
+		text.WriteString(" ")
+	}
+
+	author := fmt.Sprintf("  %s, %s", commit.Author.Name, commit.AuthorDate.Format("2006-01-02"))
+	subject := firstLine(commit.Message)
+	if width := cgr.config.Width; width > 0 {
+		used := utf8.RuneCountInString(ansiSequence.ReplaceAllString(text.String(), "")) + utf8.RuneCountInString(author)
+		subject = truncateRunes(subject, width-used)
+	}
+	text.WriteString(subject)
+	text.WriteString(cgr.colorize(author, color))
+
+	return text.String()
+}
+
+// renderRun renders the summary line of a collapsed run with its authors and dates
+func (cgr *CommitGraphRenderer) renderRun(graph *models.CommitGraph, line CommitGraphLine) string {
+	rows := graph.Rows[line.Row : line.Row+line.Hidden]
+	first := rows[0]
+
+	lanes := []rune(cgr.padGraph(graph, first.Graph))
+	lanes[2*first.Lane] = '┆'
+	if cgr.ascii {
+		lanes[2*first.Lane] = ':'
+	}
+
+	// Authors in order of their commits in the run, most active first
+	counts := make(map[string]int)
+	var names []string
+	for _, row := range rows {
+		if counts[row.Commit.Author.Name] == 0 {
+			names = append(names, row.Commit.Author.Name)
+		}
+		counts[row.Commit.Author.Name]++
+	}
+	sort.SliceStable(names, func(i, j int) bool { return counts[names[i]] > counts[names[j]] })
+	authors := strings.Join(names, ", ")
+	if len(names) > 3 {
+		authors = fmt.Sprintf("%s and %d more", strings.Join(names[:3], ", "), len(names)-3)
+	}
+
+	newest, oldest := rows[0].Commit.AuthorDate, rows[len(rows)-1].Commit.AuthorDate
+	dates := newest.Format("2006-01-02")
+	if oldest.Format("2006-01-02") != dates {
+		dates = oldest.Format("2006-01-02") + " to " + dates
+	}
+
+	commits := "commits"
+	if line.Hidden == 1 {
+		commits = "commit"
+	}
+	summary := fmt.Sprintf("… %d %s by %s (%s)", line.Hidden, commits, authors, dates)
+	return string(lanes) + " " + cgr.colorize(summary, ColorDim)
+}
+
+// renderRefs renders decorations like git: HEAD in cyan, tags in yellow, remote branches in
+// red and local branches in green
+func (cgr *CommitGraphRenderer) renderRefs(refs []string) string {
+	parts := make([]string, len(refs))
+	for i, ref := range refs {
+		color := ColorGreen
+		switch {
+		case strings.HasPrefix(ref, "HEAD"):
+			color = ColorCyan + ColorBold
+		case strings.HasPrefix(ref, "tag: "):
+			color = ColorYellow + ColorBold
+		case strings.Contains(ref, "/"):
+			color = ColorRed
+		}
+		parts[i] = cgr.colorize(ref, color)
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// padGraph pads the lanes of a row to the width of the widest row and converts them to ASCII
+// when requested
+func (cgr *CommitGraphRenderer) padGraph(graph *models.CommitGraph, lanes string) string {
+	if width := 2 * graph.MaxLanes; utf8.RuneCountInString(lanes) < width {
+		lanes += strings.Repeat(" ", width-utf8.RuneCountInString(lanes))
+	}
+	if cgr.ascii {
+		return asciiGraph.Replace(lanes)
+	}
+	return lanes
+}
+
+// assignAuthorColors gives each author of graph a color in order of their first commit
+func (cgr *CommitGraphRenderer) assignAuthorColors(graph *models.CommitGraph) {
+	cgr.authorColors = make(map[string]string)
+	for _, row := range graph.Rows {
+		email := strings.ToLower(row.Commit.Author.Email)
+		if _, ok := cgr.authorColors[email]; !ok {
+			cgr.authorColors[email] = seriesColor(len(cgr.authorColors))
+		}
+	}
+}
+
+// colorize wraps text in an ANSI color when colors are enabled
+func (cgr *CommitGraphRenderer) colorize(text, color string) string {
+	if !cgr.useColors || color == "" {
+		return text
+	}
+	return color + text + ColorReset
+}
+
+// firstLine returns the first line of a commit message
+func firstLine(message string) string {
+	if index := strings.IndexByte(message, '\n'); index >= 0 {
+		return message[:index]
+	}
+	return message
+}
+
+// truncateRunes shortens text to at most maxLen characters, ending in "..." when cut
+func truncateRunes(text string, maxLen int) string {
+	runes := []rune(text)
+	if len(runes) <= maxLen {
+		return text
+	}
+	if maxLen <= 3 {
+		return ""
+	}
+	return string(runes[:maxLen-3]) + "..."
+}
@@ -0,0 +1,459 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Side-by-side contributor comparison renderer
+
+package visualizers
+
+import (
+	"fmt"
+	"git-stats/models"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ansiSequence matches ANSI escape sequences, which take no columns on screen
+var ansiSequence = regexp.MustCompile(`\x1b\[[0-9;]*[A-Za-z]`)
+
+// sparkLevels are the glyphs of sparklines, from no activity to the maximum
+var sparkLevels = []rune{'·', '▁', '▂', '▃', '▄', '▅', '▆', '▇', '█'}
+
+// ComparisonRenderer renders a contributor comparison as text
+type ComparisonRenderer struct {
+	config    models.RenderConfig
+	useColors bool
+}
+
+// NewComparisonRenderer creates a new comparison renderer
+func NewComparisonRenderer(config models.RenderConfig) *ComparisonRenderer {
+	return &ComparisonRenderer{
+		config:    config,
+		useColors: true,
+	}
+}
+
+// SetColorOptions enables or disables ANSI colors
+func (cr *ComparisonRenderer) SetColorOptions(useColors bool) {
+	cr.useColors = useColors
+}
+
+// RenderComparison renders the summary table, heatmaps, monthly activity, hour-of-day profiles,
+// file-type mix and shared files of a comparison
+func (cr *ComparisonRenderer) RenderComparison(comparison *models.ContributorComparison) (string, error) {
+	if comparison == nil || len(comparison.Contributors) == 0 {
+		return "", fmt.Errorf("comparison cannot be nil or empty")
+	}
+
+	sections := []func(*strings.Builder, *models.ContributorComparison){
+		cr.renderSummary,
+		cr.renderHeatmaps,
+		cr.renderMonthlyActivity,
+		cr.renderHourProfiles,
+		cr.renderFileTypes,
+		cr.renderSharedFiles,
+	}
+
+	var result strings.Builder
+	for i, section := range sections {
+		if i > 0 {
+			result.WriteString("\n")
+		}
+		section(&result, comparison)
+	}
+	return result.String(), nil
+}
+
+// renderSummary renders the per-contributor totals
+func (cr *ComparisonRenderer) renderSummary(result *strings.Builder, comparison *models.ContributorComparison) {
+	cr.writeTitle(result, "Summary")
+	result.WriteString(fmt.Sprintf("Period: %s to %s\n\n",
+		comparison.TimeRange.Start.Format("2006-01-02"), comparison.TimeRange.End.Format("2006-01-02")))
+
+	headers := []string{"Contributor", "Commits", "Lines +/-", "Active Days", "Files", "First", "Last"}
+	rows := make([][]string, 0, len(comparison.Contributors))
+	for _, contributor := range comparison.Contributors {
+		rows = append(rows, []string{
+			TruncateString(contributor.Name, 24),
+			fmt.Sprintf("%d", contributor.TotalCommits),
+			fmt.Sprintf("+%d/-%d", contributor.TotalInsertions, contributor.TotalDeletions),
+			fmt.Sprintf("%d", contributor.ActiveDays),
+			fmt.Sprintf("%d", contributor.FilesTouched),
+			contributor.FirstCommit.Format("2006-01-02"),
+			contributor.LastCommit.Format("2006-01-02"),
+		})
+	}
+	cr.writeTable(result, headers, rows)
+
+	for _, contributor := range comparison.Contributors {
+		if len(contributor.Identities) > 1 {
+			identities := make([]string, 0, len(contributor.Identities))
+			for _, identity := range contributor.Identities {
+				identities = append(identities, fmt.Sprintf("%s <%s>", identity.Name, identity.Email))
+			}
+			result.WriteString(fmt.Sprintf("%s combines %s\n", contributor.Name, strings.Join(identities, ", ")))
+		}
+	}
+}
+
+// renderHeatmaps renders one contribution heatmap per contributor on a shared scale, side by side when they fit
+func (cr *ComparisonRenderer) renderHeatmaps(result *strings.Builder, comparison *models.ContributorComparison) {
+	cr.writeTitle(result, "Contribution Heatmaps")
+
+	// Show the most recent weeks of the range, at most a year, and say so when the range is longer
+	end := comparison.TimeRange.End
+	start := comparison.TimeRange.Start
+	shortened := end.Sub(start) > 365*24*time.Hour
+	if shortened {
+		start = end.AddDate(-1, 0, 0)
+		result.WriteString(fmt.Sprintf("Last year of the period: %s to %s\n\n", start.Format("2006-01-02"), end.Format("2006-01-02")))
+	}
+	first, last := start.Format("2006-01-02"), end.Format("2006-01-02")
+	for start.Weekday() != time.Sunday {
+		start = start.AddDate(0, 0, -1)
+	}
+	weeks := int(end.Sub(start).Hours()/24)/7 + 1
+
+	maxCommits := 0
+	for _, contributor := range comparison.Contributors {
+		for _, commits := range contributor.CommitsByDay {
+			if commits > maxCommits {
+				maxCommits = commits
+			}
+		}
+	}
+
+	days := []string{"S", "M", "T", "W", "T", "F", "S"}
+	blocks := make([][]string, 0, len(comparison.Contributors))
+	for _, contributor := range comparison.Contributors {
+		// Commits before the weeks shown are counted in the name, so an empty heatmap is explained
+		name := contributor.Name
+		if shortened {
+			shown := 0
+			for day, commits := range contributor.CommitsByDay {
+				if day >= first && day <= last {
+					shown += commits
+				}
+			}
+			if shown < contributor.TotalCommits {
+				name = fmt.Sprintf("%s (%d of %d commits)", name, shown, contributor.TotalCommits)
+			}
+		}
+
+		block := []string{padVisible(TruncateString(name, weeks+2), weeks+2)}
+		for day := 0; day < 7; day++ {
+			var row strings.Builder
+			row.WriteString(days[day] + " ")
+			for week := 0; week < weeks; week++ {
+				date := start.AddDate(0, 0, week*7+day)
+				if date.After(end) {
+					row.WriteString(" ")
+					continue
+				}
+				row.WriteString(cr.heatCell(contributor.CommitsByDay[date.Format("2006-01-02")], maxCommits))
+			}
+			block = append(block, row.String())
+		}
+		blocks = append(blocks, block)
+	}
+
+	cr.writeBlocks(result, blocks, weeks+2)
+	result.WriteString(fmt.Sprintf("Less %s More (max %d commits/day)\n",
+		cr.heatLegend(), maxCommits))
+}
+
+// renderMonthlyActivity renders one sparkline per contributor over the same months and scale
+func (cr *ComparisonRenderer) renderMonthlyActivity(result *strings.Builder, comparison *models.ContributorComparison) {
+	cr.writeTitle(result, "Monthly Activity")
+
+	months := comparison.Months
+	nameWidth := cr.nameWidth(comparison)
+	cellWidth := 1
+	if nameWidth+2+len(months)*4 <= cr.width() {
+		cellWidth = 4
+	}
+
+	maxCommits := 0
+	for _, contributor := range comparison.Contributors {
+		for _, commits := range contributor.CommitsByMonth {
+			if commits > maxCommits {
+				maxCommits = commits
+			}
+		}
+	}
+
+	// Month labels, with the year under each January and the first month
+	var labels, years strings.Builder
+	for i, month := range months {
+		if cellWidth == 1 {
+			labels.WriteString(month.Format("Jan")[:1])
+		} else {
+			labels.WriteString(month.Format("Jan") + " ")
+		}
+		year := ""
+		if i == 0 || month.Month() == time.January {
+			year = month.Format("2006")
+		}
+		if utf8.RuneCountInString(years.String()) <= i*cellWidth {
+			years.WriteString(strings.Repeat(" ", i*cellWidth-utf8.RuneCountInString(years.String())) + year)
+		}
+	}
+	result.WriteString(fmt.Sprintf("%-*s  %s\n", nameWidth, "", labels.String()))
+	result.WriteString(fmt.Sprintf("%-*s  %s\n", nameWidth, "", years.String()))
+
+	for i, contributor := range comparison.Contributors {
+		var line strings.Builder
+		for _, commits := range contributor.CommitsByMonth {
+			glyph := string(sparkGlyph(commits, maxCommits))
+			if cellWidth == 1 {
+				line.WriteString(glyph)
+			} else {
+				line.WriteString(strings.Repeat(glyph, 3) + " ")
+			}
+		}
+		result.WriteString(fmt.Sprintf("%-*s  %s %d\n", nameWidth, TruncateString(contributor.Name, nameWidth),
+			cr.colorize(line.String(), seriesColor(i)), contributor.TotalCommits))
+	}
+	result.WriteString(fmt.Sprintf("Shared scale: %s = %d commits/month\n", string(sparkLevels[len(sparkLevels)-1]), maxCommits))
+}
+
+// renderHourProfiles renders each contributor's commits by hour of day, scaled to their own peak
+func (cr *ComparisonRenderer) renderHourProfiles(result *strings.Builder, comparison *models.ContributorComparison) {
+	cr.writeTitle(result, "Hour of Day Profile")
+
+	nameWidth := cr.nameWidth(comparison)
+	result.WriteString(fmt.Sprintf("%-*s  %s\n", nameWidth, "", "0     6     12    18    "))
+
+	for i, contributor := range comparison.Contributors {
+		peak := contributor.CommitsByHour[contributor.PeakHour()]
+		var line strings.Builder
+		for _, commits := range contributor.CommitsByHour {
+			line.WriteRune(sparkGlyph(commits, peak))
+		}
+		result.WriteString(fmt.Sprintf("%-*s  %s peak %02d:00\n", nameWidth, TruncateString(contributor.Name, nameWidth),
+			cr.colorize(line.String(), seriesColor(i)), contributor.PeakHour()))
+	}
+}
+
+// renderFileTypes renders the share of each contributor's file changes per extension
+func (cr *ComparisonRenderer) renderFileTypes(result *strings.Builder, comparison *models.ContributorComparison) {
+	cr.writeTitle(result, "File Type Mix")
+
+	totals := make(map[string]int)
+	changes := make([]int, len(comparison.Contributors))
+	for i, contributor := range comparison.Contributors {
+		for ext, count := range contributor.FileTypes {
+			totals[ext] += count
+			changes[i] += count
+		}
+	}
+	if len(totals) == 0 {
+		result.WriteString("No file changes recorded.\n")
+		return
+	}
+
+	extensions := make([]string, 0, len(totals))
+	for ext := range totals {
+		extensions = append(extensions, ext)
+	}
+	sort.Slice(extensions, func(i, j int) bool {
+		if totals[extensions[i]] != totals[extensions[j]] {
+			return totals[extensions[i]] > totals[extensions[j]]
+		}
+		return extensions[i] < extensions[j]
+	})
+	if len(extensions) > 8 {
+		extensions = extensions[:8]
+	}
+
+	headers := []string{"Type"}
+	for _, contributor := range comparison.Contributors {
+		headers = append(headers, TruncateString(contributor.Name, 16))
+	}
+
+	rows := make([][]string, 0, len(extensions))
+	for _, ext := range extensions {
+		row := []string{"." + ext}
+		for i, contributor := range comparison.Contributors {
+			share := 0.0
+			if changes[i] > 0 {
+				share = float64(contributor.FileTypes[ext]) / float64(changes[i]) * 100
+			}
+			row = append(row, fmt.Sprintf("%.0f%%", share))
+		}
+		rows = append(rows, row)
+	}
+	cr.writeTable(result, headers, rows)
+}
+
+// renderSharedFiles renders the files changed by more than one contributor and the files only one of them knows
+func (cr *ComparisonRenderer) renderSharedFiles(result *strings.Builder, comparison *models.ContributorComparison) {
+	cr.writeTitle(result, "Shared Files")
+
+	if len(comparison.SharedFiles) == 0 {
+		result.WriteString("No files were changed by more than one of these contributors.\n")
+	} else {
+		result.WriteString(fmt.Sprintf("%d files were changed by more than one of these contributors\n\n", len(comparison.SharedFiles)))
+
+		headers := []string{"File"}
+		for _, contributor := range comparison.Contributors {
+			headers = append(headers, TruncateString(contributor.Name, 16))
+		}
+		headers = append(headers, "Total")
+
+		rows := [][]string{}
+		for i, file := range comparison.SharedFiles {
+			if i >= 15 {
+				break
+			}
+			row := []string{truncatePathLeft(file.Path, 40)}
+			for _, commits := range file.Commits {
+				row = append(row, fmt.Sprintf("%d", commits))
+			}
+			rows = append(rows, append(row, fmt.Sprintf("%d", file.TotalCommits)))
+		}
+		cr.writeTable(result, headers, rows)
+	}
+
+	// Files nobody else has touched are the knowledge at risk when someone is away
+	for _, contributor := range comparison.Contributors {
+		if len(contributor.ExclusiveFiles) == 0 {
+			continue
+		}
+		shown := contributor.ExclusiveFiles
+		if len(shown) > 5 {
+			shown = shown[:5]
+		}
+		result.WriteString(fmt.Sprintf("\nOnly changed by %s: %s\n", contributor.Name, strings.Join(shown, ", ")))
+	}
+}
+
+// writeTitle writes a section title with an underline
+func (cr *ComparisonRenderer) writeTitle(result *strings.Builder, title string) {
+	result.WriteString(cr.colorize(title, ColorBold) + "\n")
+	result.WriteString(strings.Repeat("=", len(title)) + "\n")
+}
+
+// writeTable writes a box-drawn table
+func (cr *ComparisonRenderer) writeTable(result *strings.Builder, headers []string, rows [][]string) {
+	table, err := NewChartsRenderer(cr.config).RenderTable(headers, rows, cr.config)
+	if err == nil {
+		result.WriteString(table)
+	}
+}
+
+// writeBlocks writes equally wide blocks of lines side by side, wrapping to the next row of blocks when needed
+func (cr *ComparisonRenderer) writeBlocks(result *strings.Builder, blocks [][]string, blockWidth int) {
+	layoutBlocks(result, blocks, blockWidth, cr.width())
+}
+
+// layoutBlocks writes equally wide blocks of lines side by side in width columns, wrapping to
+// the next row of blocks when needed
+func layoutBlocks(result *strings.Builder, blocks [][]string, blockWidth, width int) {
+	const gap = "   "
+	perRow := (width + len(gap)) / (blockWidth + len(gap))
+	if perRow < 1 {
+		perRow = 1
+	}
+
+	for first := 0; first < len(blocks); first += perRow {
+		last := first + perRow
+		if last > len(blocks) {
+			last = len(blocks)
+		}
+		for line := range blocks[first] {
+			parts := make([]string, 0, last-first)
+			for _, block := range blocks[first:last] {
+				parts = append(parts, padVisible(block[line], blockWidth))
+			}
+			result.WriteString(strings.TrimRight(strings.Join(parts, gap), " ") + "\n")
+		}
+		result.WriteString("\n")
+	}
+}
+
+// heatCell returns the glyph of a heatmap cell for commits on the shared scale
+func (cr *ComparisonRenderer) heatCell(commits, maxCommits int) string {
+	glyphs := []string{"·", "░", "▒", "▓", "█"}
+	colors := []string{"\033[90m", "\033[92m", "\033[32m", "\033[32;1m", "\033[33;1m"}
+
+	level := 0
+	if commits > 0 && maxCommits > 0 {
+		level = (commits*4 + maxCommits - 1) / maxCommits
+		if level > 4 {
+			level = 4
+		}
+	}
+	return cr.colorize(glyphs[level], colors[level])
+}
+
+// heatLegend returns the heatmap glyphs from low to high activity
+func (cr *ComparisonRenderer) heatLegend() string {
+	return strings.Join([]string{cr.heatCell(0, 4), cr.heatCell(1, 4), cr.heatCell(2, 4), cr.heatCell(3, 4), cr.heatCell(4, 4)}, " ")
+}
+
+// nameWidth returns the column width for contributor names
+func (cr *ComparisonRenderer) nameWidth(comparison *models.ContributorComparison) int {
+	width := 8
+	for _, contributor := range comparison.Contributors {
+		if length := utf8.RuneCountInString(contributor.Name); length > width {
+			width = length
+		}
+	}
+	if width > 20 {
+		width = 20
+	}
+	return width
+}
+
+// width returns the render width, defaulting to 80 columns
+func (cr *ComparisonRenderer) width() int {
+	if cr.config.Width > 0 {
+		return cr.config.Width
+	}
+	return 80
+}
+
+// colorize wraps text in an ANSI color when colors are enabled
+func (cr *ComparisonRenderer) colorize(text, color string) string {
+	if !cr.useColors {
+		return text
+	}
+	return color + text + ColorReset
+}
+
+// seriesColor returns a distinct color for the i-th contributor
+func seriesColor(i int) string {
+	colors := []string{ColorGreen, ColorCyan, ColorYellow, ColorPurple, ColorBlue, ColorRed}
+	return colors[i%len(colors)]
+}
+
+// sparkGlyph returns the sparkline glyph for value on a scale up to max
+func sparkGlyph(value, max int) rune {
+	if value <= 0 || max <= 0 {
+		return sparkLevels[0]
+	}
+	top := len(sparkLevels) - 1
+	level := (value*top + max - 1) / max
+	if level >= len(sparkLevels) {
+		level = len(sparkLevels) - 1
+	}
+	return sparkLevels[level]
+}
+
+// padVisible pads text with spaces to width visible characters, ignoring ANSI sequences
+func padVisible(text string, width int) string {
+	visible := utf8.RuneCountInString(ansiSequence.ReplaceAllString(text, ""))
+	if visible >= width {
+		return text
+	}
+	return text + strings.Repeat(" ", width-visible)
+}
+
+// truncatePathLeft shortens a path from the left so its file name stays visible
+func truncatePathLeft(path string, maxLen int) string {
+	if len(path) <= maxLen {
+		return path
+	}
+	return "..." + path[len(path)-maxLen+3:]
+}
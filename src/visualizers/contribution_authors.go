@@ -0,0 +1,444 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Per-author contribution graphs
+
+package visualizers
+
+import (
+	"fmt"
+	"git-stats/models"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// authorPalette colors the top authors of per-author contribution graphs, one hue each so
+// neighboring days of different authors stay apart
+var authorPalette = []models.RGB{
+	{R: 0x4e, G: 0x79, B: 0xa7}, // blue
+	{R: 0xf2, G: 0x8e, B: 0x2b}, // orange
+	{R: 0xe1, G: 0x57, B: 0x59}, // red
+	{R: 0x76, G: 0xb7, B: 0xb2}, // teal
+	{R: 0x59, G: 0xa1, B: 0x4f}, // green
+	{R: 0xed, G: 0xc9, B: 0x48}, // yellow
+	{R: 0xb0, G: 0x7a, B: 0xa1}, // purple
+	{R: 0xff, G: 0x9d, B: 0xa7}, // pink
+	{R: 0x9c, G: 0x75, B: 0x5f}, // brown
+}
+
+// otherAuthorsColor colors the days whose dominant author is not among the top authors
+var otherAuthorsColor = models.RGB{R: 0xba, G: 0xb0, B: 0xac}
+
+// authorColor returns the color of the author at index in a graph showing top authors
+func authorColor(index, top int) models.RGB {
+	if index < 0 || index >= top || index >= len(authorPalette) {
+		return otherAuthorsColor
+	}
+	return authorPalette[index]
+}
+
+// authorKey returns the key that stands for the author at index when colors are off: its
+// number in the legend, or "+" for the authors beyond the top
+func authorKey(index, top int) string {
+	if index < 0 || index >= top {
+		return "+"
+	}
+	return strconv.Itoa(index + 1)
+}
+
+// shownAuthors returns how many of the graph's authors get their own color or calendar: top,
+// or as many as the palette has colors when top is 0, and never more authors than there are
+func shownAuthors(graph *models.ContributionGraph, top int) int {
+	if top <= 0 || top > len(authorPalette) {
+		top = len(authorPalette)
+	}
+	return min(top, len(graph.Authors))
+}
+
+// countNoun writes n with the singular or plural noun, e.g. "1 other" or "3 others"
+func countNoun(n int, singular, plural string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, singular)
+	}
+	return fmt.Sprintf("%d %s", n, plural)
+}
+
+// busiestAuthorDay returns the highest daily value of any of the authors
+func busiestAuthorDay(authors []models.AuthorContributions) int {
+	busiest := 0
+	for _, author := range authors {
+		for _, value := range author.Daily {
+			busiest = max(busiest, value)
+		}
+	}
+	return busiest
+}
+
+// authorThresholds returns the activity level thresholds shared by the calendars of authors:
+// quarters of the busiest day of any of them
+func authorThresholds(authors []models.AuthorContributions) []int {
+	busiest := busiestAuthorDay(authors)
+	return []int{busiest / 4, busiest / 2, busiest * 3 / 4}
+}
+
+// RenderDominantAuthorGraph renders the contribution graph with each day in the color of the
+// author with the most activity that day; days of authors beyond the top are gray. The shade
+// still follows the day's total. Without colors, days show their author's key from the legend
+func (cgr *ContributionGraphRenderer) RenderDominantAuthorGraph(graph *models.ContributionGraph, top int, config models.RenderConfig) (string, error) {
+	if graph == nil {
+		return "", fmt.Errorf("contribution graph cannot be nil")
+	}
+	top = shownAuthors(graph, top)
+
+	var result strings.Builder
+	cgr.renderCalendar(&result, calendarStart(graph), graph.EndDate, func(date string) string {
+		return cgr.dominantCell(graph, date, top)
+	})
+
+	if config.ShowLegend {
+		result.WriteString("\n")
+		result.WriteString(cgr.RenderAuthorLegend(graph, top))
+	}
+
+	return result.String(), nil
+}
+
+// dominantCell returns the cell of a date in the dominant author graph
+func (cgr *ContributionGraphRenderer) dominantCell(graph *models.ContributionGraph, date string, top int) string {
+	author := graph.DominantAuthor(date)
+	switch {
+	case author < 0 && !cgr.useColors:
+		return "·"
+	case author < 0:
+		return cgr.getCommitCell(0)
+	case !cgr.useColors:
+		return authorKey(author, top)
+	}
+
+	level := max(graph.ActivityLevel(graph.DailyValues[date]), 1)
+	return cgr.authorCell(author, top, level)
+}
+
+// authorCell returns the glyph of an activity level in the color of an author
+func (cgr *ContributionGraphRenderer) authorCell(index, top, level int) string {
+	char := cgr.getCommitChar(level)
+	if !cgr.useColors {
+		return char
+	}
+	return ColorEscape(authorColor(index, top), cgr.colorDepth) + char + ColorReset
+}
+
+// authorSwatch returns the legend mark of an author: a block in its color, or its key
+func (cgr *ContributionGraphRenderer) authorSwatch(index, top int) string {
+	if !cgr.useColors {
+		return authorKey(index, top)
+	}
+	return cgr.authorCell(index, top, 4)
+}
+
+// RenderAuthorLegend maps the colors, or the keys without colors, of a dominant author graph
+// to the top authors and their totals; the remaining authors share one entry
+func (cgr *ContributionGraphRenderer) RenderAuthorLegend(graph *models.ContributionGraph, top int) string {
+	top = shownAuthors(graph, top)
+	label := models.MetricLabel(graph.Metric)
+
+	entries := make([]string, 0, top+1)
+	for i, author := range graph.Authors[:top] {
+		entries = append(entries, fmt.Sprintf("%s %s (%d)", cgr.authorSwatch(i, top), author.Name, author.Total))
+	}
+	if others := graph.Authors[top:]; len(others) > 0 {
+		total := 0
+		for _, author := range others {
+			total += author.Total
+		}
+		entries = append(entries, fmt.Sprintf("%s %s (%d)", cgr.authorSwatch(top, top), countNoun(len(others), "other", "others"), total))
+	}
+
+	var result strings.Builder
+	result.WriteString(wrapEntries(entries, cgr.width()))
+	if cgr.useColors {
+		fmt.Fprintf(&result, "\nEach day takes the color of the %s with the most %s; the shade follows the day's total", graph.AuthorNoun(), label)
+	} else {
+		fmt.Fprintf(&result, "\nEach day shows the key of the %s with the most %s; · marks days without activity", graph.AuthorNoun(), label)
+	}
+
+	return result.String()
+}
+
+// RenderAuthorMultiples renders one small calendar per top author over the graph's weeks, side
+// by side when they fit. The calendars share one scale, so their shades compare
+func (cgr *ContributionGraphRenderer) RenderAuthorMultiples(graph *models.ContributionGraph, top int, config models.RenderConfig) (string, error) {
+	if graph == nil {
+		return "", fmt.Errorf("contribution graph cannot be nil")
+	}
+	top = shownAuthors(graph, top)
+	if top == 0 {
+		return fmt.Sprintf("No %s activity in the selected range.\n", graph.AuthorNoun()), nil
+	}
+
+	start := calendarStart(graph)
+	weeks := min(int(graph.EndDate.Sub(start).Hours()/24)/7+1, maxGraphWeeks)
+	authors := graph.Authors[:top]
+	thresholds := authorThresholds(authors)
+
+	days := []string{"S", "M", "T", "W", "T", "F", "S"}
+	blocks := make([][]string, 0, top)
+	for i, author := range authors {
+		heading := truncateRunes(fmt.Sprintf("%s (%d)", author.Name, author.Total), weeks)
+		block := []string{cgr.authorSwatch(i, top) + " " + heading}
+		for day := 0; day < 7; day++ {
+			var row strings.Builder
+			row.WriteString(days[day] + " ")
+			for week := 0; week < weeks; week++ {
+				date := start.AddDate(0, 0, week*7+day)
+				if date.After(graph.EndDate) {
+					break
+				}
+				level := models.ActivityLevel(author.Daily[date.Format("2006-01-02")], thresholds)
+				if level == 0 {
+					row.WriteString(cgr.getCommitCell(0))
+					continue
+				}
+				row.WriteString(cgr.authorCell(i, top, level))
+			}
+			block = append(block, row.String())
+		}
+		blocks = append(blocks, block)
+	}
+
+	var result strings.Builder
+	layoutBlocks(&result, blocks, weeks+2, cgr.width())
+
+	if config.ShowLegend {
+		label := models.MetricLabel(graph.Metric)
+		fmt.Fprintf(&result, "Less %s %s %s %s More (most active day: %d %s, the same scale on every calendar)",
+			cgr.getCommitChar(0), cgr.getCommitChar(1), cgr.getCommitChar(2), cgr.getCommitChar(3),
+			busiestAuthorDay(authors), label)
+		if others := len(graph.Authors) - top; others > 0 {
+			fmt.Fprintf(&result, "\n%s not shown", countNoun(others, "more "+graph.AuthorNoun(), "more "+graph.AuthorNoun()+"s"))
+		}
+	}
+
+	return result.String(), nil
+}
+
+// width returns the columns the renderer fits wide output to
+func (cgr *ContributionGraphRenderer) width() int {
+	if cgr.config.Width > 0 {
+		return cgr.config.Width
+	}
+	return DefaultTerminalWidth
+}
+
+// wrapEntries joins legend entries on lines of at most width visible columns
+func wrapEntries(entries []string, width int) string {
+	const gap = "   "
+	var result strings.Builder
+	lineWidth := 0
+	for _, entry := range entries {
+		entryWidth := len([]rune(ansiSequence.ReplaceAllString(entry, "")))
+		switch {
+		case lineWidth == 0:
+		case lineWidth+len(gap)+entryWidth > width:
+			result.WriteString("\n")
+			lineWidth = 0
+		default:
+			result.WriteString(gap)
+			lineWidth += len(gap)
+		}
+		result.WriteString(entry)
+		lineWidth += entryWidth
+	}
+	return result.String()
+}
+
+// Contribution graph SVG geometry, in pixels
+const (
+	contribSVGCell    = 11 // side of a day's square
+	contribSVGStep    = 13 // side of a day's square and the gap to the next
+	contribSVGLeft    = 32 // room for the weekday labels
+	contribSVGTitle   = 28 // title row
+	contribSVGMonths  = 16 // month label row above each calendar
+	contribSVGHeading = 18 // author heading above each small calendar
+	contribSVGGap     = 12 // space after each calendar
+	contribSVGLegend  = 18 // height of a legend row
+)
+
+// svgCell is what a day's square of an SVG calendar shows
+type svgCell struct {
+	fill    string
+	opacity float64
+	tooltip string
+}
+
+// levelOpacity returns the opacity of an activity level drawn in an author's color
+func levelOpacity(level int) float64 {
+	return 0.2 + 0.2*float64(min(level, 4))
+}
+
+// RenderContributionGraphSVG renders the contribution graph as a standalone SVG image: the
+// total in the theme's colors when mode is empty, or one of the per-author modes with top
+// authors in their own colors
+func (cgr *ContributionGraphRenderer) RenderContributionGraphSVG(graph *models.ContributionGraph, title, mode string, top int) (string, error) {
+	if graph == nil {
+		return "", fmt.Errorf("contribution graph cannot be nil")
+	}
+
+	start := calendarStart(graph)
+	weeks := min(int(graph.EndDate.Sub(start).Hours()/24)/7+1, maxGraphWeeks)
+	width := max(contribSVGLeft+weeks*contribSVGStep+16, 360)
+	label := models.MetricLabel(graph.Metric)
+	top = shownAuthors(graph, top)
+
+	var body strings.Builder
+	y := contribSVGTitle
+	switch mode {
+	case models.ByAuthorDominant:
+		y = cgr.writeSVGCalendar(&body, y, start, graph.EndDate, func(date string) svgCell {
+			value := graph.DailyValues[date]
+			author := graph.DominantAuthor(date)
+			if author < 0 {
+				return svgCell{cgr.theme.LevelColor(0).Hex(), 1, fmt.Sprintf("%s: no %s", date, label)}
+			}
+			name := "others"
+			if author < top {
+				name = graph.Authors[author].Name
+			}
+			return svgCell{authorColor(author, top).Hex(), levelOpacity(graph.ActivityLevel(value)),
+				fmt.Sprintf("%s: %d %s, mostly %s", date, value, label, name)}
+		})
+		y = cgr.writeSVGAuthorLegend(&body, y, width, graph, top)
+	case models.ByAuthorMultiples:
+		authors := graph.Authors[:top]
+		thresholds := authorThresholds(authors)
+		for i, author := range authors {
+			fmt.Fprintf(&body, `  <text x="%d" y="%d" font-weight="bold" fill="%s">%s (%d %s)</text>`+"\n",
+				contribSVGLeft, y+13, authorColor(i, top).Hex(), html.EscapeString(author.Name), author.Total, label)
+			y += contribSVGHeading
+			y = cgr.writeSVGCalendar(&body, y, start, graph.EndDate, func(date string) svgCell {
+				value := author.Daily[date]
+				level := models.ActivityLevel(value, thresholds)
+				if level == 0 {
+					return svgCell{cgr.theme.LevelColor(0).Hex(), 1, fmt.Sprintf("%s: no %s by %s", date, label, author.Name)}
+				}
+				return svgCell{authorColor(i, top).Hex(), levelOpacity(level),
+					fmt.Sprintf("%s: %d %s by %s", date, value, label, author.Name)}
+			})
+		}
+		if others := len(graph.Authors) - top; others > 0 {
+			fmt.Fprintf(&body, `  <text x="%d" y="%d" fill="#57606a">%s not shown</text>`+"\n",
+				contribSVGLeft, y+12, countNoun(others, "more "+graph.AuthorNoun(), "more "+graph.AuthorNoun()+"s"))
+			y += contribSVGLegend
+		}
+	default:
+		y = cgr.writeSVGCalendar(&body, y, start, graph.EndDate, func(date string) svgCell {
+			value := graph.DailyValues[date]
+			return svgCell{cgr.theme.LevelColor(graph.ActivityLevel(value)).Hex(), 1, fmt.Sprintf("%s: %d %s", date, value, label)}
+		})
+		x := contribSVGLeft
+		fmt.Fprintf(&body, `  <text x="%d" y="%d" fill="#57606a">Less</text>`+"\n", x, y+10)
+		x += 32
+		for level := 0; level <= 4; level++ {
+			fmt.Fprintf(&body, `  <rect x="%d" y="%d" width="%d" height="%d" rx="2" fill="%s"/>`+"\n",
+				x, y+1, contribSVGCell, contribSVGCell, cgr.theme.LevelColor(level).Hex())
+			x += contribSVGStep
+		}
+		fmt.Fprintf(&body, `  <text x="%d" y="%d" fill="#57606a">More</text>`+"\n", x+4, y+10)
+		y += contribSVGLegend
+	}
+
+	var svg strings.Builder
+	svg.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="11">`+"\n",
+		width, y, width, y)
+	fmt.Fprintf(&svg, "  <title>%s</title>\n", html.EscapeString(title))
+	if title != "" {
+		fmt.Fprintf(&svg, `  <text x="%d" y="16" font-size="13" font-weight="bold" fill="#57606a">%s</text>`+"\n",
+			contribSVGLeft, html.EscapeString(title))
+	}
+	svg.WriteString(body.String())
+	svg.WriteString("</svg>\n")
+	return svg.String(), nil
+}
+
+// writeSVGCalendar writes the month labels, weekday labels and day squares of a calendar from
+// start, a Sunday, to end at height y, and returns the height below it
+func (cgr *ContributionGraphRenderer) writeSVGCalendar(svg *strings.Builder, y int, start, end time.Time, cell func(date string) svgCell) int {
+	top := y + contribSVGMonths
+	lastLabel := -3
+	for week := 0; week < maxGraphWeeks; week++ {
+		sunday := start.AddDate(0, 0, week*7)
+		if sunday.After(end) {
+			break
+		}
+		x := contribSVGLeft + week*contribSVGStep
+
+		for day := 0; day < 7; day++ {
+			date := sunday.AddDate(0, 0, day)
+			if date.After(end) {
+				break
+			}
+			if date.Day() == 1 && week-lastLabel >= 3 {
+				fmt.Fprintf(svg, `  <text x="%d" y="%d" fill="#57606a">%s</text>`+"\n", x, y+11, date.Format("Jan"))
+				lastLabel = week
+			}
+
+			key := date.Format("2006-01-02")
+			square := cell(key)
+			opacity := ""
+			if square.opacity < 1 {
+				opacity = fmt.Sprintf(` fill-opacity="%.1f"`, square.opacity)
+			}
+			fmt.Fprintf(svg, `  <rect x="%d" y="%d" width="%d" height="%d" rx="2" fill="%s"%s><title>%s</title></rect>`+"\n",
+				x, top+day*contribSVGStep, contribSVGCell, contribSVGCell, square.fill, opacity, html.EscapeString(square.tooltip))
+		}
+	}
+
+	for _, day := range []int{1, 3, 5} {
+		fmt.Fprintf(svg, `  <text x="%d" y="%d" text-anchor="end" fill="#57606a">%s</text>`+"\n",
+			contribSVGLeft-6, top+day*contribSVGStep+9, time.Weekday(day).String()[:3])
+	}
+
+	return top + 7*contribSVGStep + contribSVGGap
+}
+
+// writeSVGAuthorLegend writes a swatch and the total of each top author, and of the others,
+// flowing over rows of the image width, and returns the height below it
+func (cgr *ContributionGraphRenderer) writeSVGAuthorLegend(svg *strings.Builder, y, width int, graph *models.ContributionGraph, top int) int {
+	label := models.MetricLabel(graph.Metric)
+	type entry struct {
+		color models.RGB
+		text  string
+	}
+	entries := make([]entry, 0, top+1)
+	for i, author := range graph.Authors[:top] {
+		entries = append(entries, entry{authorColor(i, top), fmt.Sprintf("%s (%d)", author.Name, author.Total)})
+	}
+	if others := graph.Authors[top:]; len(others) > 0 {
+		total := 0
+		for _, author := range others {
+			total += author.Total
+		}
+		entries = append(entries, entry{otherAuthorsColor, fmt.Sprintf("%s (%d)", countNoun(len(others), "other", "others"), total)})
+	}
+
+	x := contribSVGLeft
+	for _, item := range entries {
+		// Roughly 6.5 pixels per character of the 11 pixel sans-serif font
+		itemWidth := contribSVGStep + 4 + len([]rune(item.text))*13/2 + 16
+		if x > contribSVGLeft && x+itemWidth > width {
+			x = contribSVGLeft
+			y += contribSVGLegend
+		}
+		fmt.Fprintf(svg, `  <rect x="%d" y="%d" width="%d" height="%d" rx="2" fill="%s"/>`+"\n",
+			x, y+1, contribSVGCell, contribSVGCell, item.color.Hex())
+		fmt.Fprintf(svg, `  <text x="%d" y="%d" fill="#57606a">%s</text>`+"\n",
+			x+contribSVGStep+4, y+10, html.EscapeString(item.text))
+		x += itemWidth
+	}
+	y += contribSVGLegend
+
+	fmt.Fprintf(svg, `  <text x="%d" y="%d" fill="#57606a">Days take the color of the %s with the most %s; the shade follows the day's total</text>`+"\n",
+		contribSVGLeft, y+10, graph.AuthorNoun(), html.EscapeString(label))
+	return y + contribSVGLegend
+}
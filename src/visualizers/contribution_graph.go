@@ -10,26 +10,48 @@ import (
 	"time"
 )
 
+// maxGraphWeeks is the most week columns a calendar spans: a year starting on a Saturday and
+// ending on a Sunday touches 54 weeks
+const maxGraphWeeks = 54
+
 // ContributionGraphRenderer implements the ContributionGraphVisualizer interface
 type ContributionGraphRenderer struct {
 	config     models.RenderConfig
 	useColors  bool
-	colorTheme string
+	theme      *models.Theme
+	colorDepth ColorDepth
 }
 
 // NewContributionGraphRenderer creates a new contribution graph renderer
 func NewContributionGraphRenderer(config models.RenderConfig) *ContributionGraphRenderer {
+	theme, _ := models.ResolveTheme(models.DefaultThemeName, nil)
 	return &ContributionGraphRenderer{
 		config:     config,
-		useColors:  true, // Enable colors by default
-		colorTheme: "github", // Default to GitHub-style colors
+		useColors:  true,  // Enable colors by default
+		theme:      theme, // Default to GitHub-style colors
+		colorDepth: DetectColorDepth(),
 	}
 }
 
-// SetColorOptions configures color settings for the renderer
+// SetColorOptions configures color settings for the renderer; unknown built-in theme names
+// keep the default theme
 func (cgr *ContributionGraphRenderer) SetColorOptions(useColors bool, theme string) {
 	cgr.useColors = useColors
-	cgr.colorTheme = theme
+	if resolved, err := models.ResolveTheme(theme, nil); err == nil {
+		cgr.theme = resolved
+	}
+}
+
+// SetTheme sets the palette of the activity levels, such as a theme from the configuration file
+func (cgr *ContributionGraphRenderer) SetTheme(theme *models.Theme) {
+	if theme != nil {
+		cgr.theme = theme
+	}
+}
+
+// SetColorDepth overrides the color depth detected from the environment
+func (cgr *ContributionGraphRenderer) SetColorDepth(depth ColorDepth) {
+	cgr.colorDepth = depth
 }
 
 // RenderContributionGraph renders the GitHub-style contribution graph
@@ -39,21 +61,61 @@ func (cgr *ContributionGraphRenderer) RenderContributionGraph(graph *models.Cont
 	}
 
 	var result strings.Builder
+	cgr.renderCalendar(&result, calendarStart(graph), graph.EndDate, cgr.levelCell(graph))
 
-	// Calculate the grid dimensions (52 weeks * 7 days)
-	startDate := graph.StartDate
-	endDate := graph.EndDate
+	// Add legend if enabled
+	if config.ShowLegend {
+		result.WriteString("\n")
+		result.WriteString(cgr.RenderGraphLegend(graph))
+	}
+
+	return result.String(), nil
+}
 
-	// Ensure we have a full year view
-	if endDate.Sub(startDate) < 365*24*time.Hour {
-		startDate = endDate.AddDate(-1, 0, 0)
+// RenderYear renders the calendar of one year, January to December, shaded against the
+// busiest day of the whole graph so stacked years compare
+func (cgr *ContributionGraphRenderer) RenderYear(graph *models.ContributionGraph, year int) (string, error) {
+	if graph == nil {
+		return "", fmt.Errorf("contribution graph cannot be nil")
+	}
+
+	location := graph.StartDate.Location()
+	startDate := time.Date(year, time.January, 1, 0, 0, 0, 0, location)
+	endDate := time.Date(year, time.December, 31, 0, 0, 0, 0, location)
+	for startDate.Weekday() != time.Sunday {
+		startDate = startDate.AddDate(0, 0, -1)
+	}
+
+	var result strings.Builder
+	cgr.renderCalendar(&result, startDate, endDate, cgr.levelCell(graph))
+	return result.String(), nil
+}
+
+// calendarStart returns the Sunday the calendar of graph starts on: the graph always spans at
+// least a year ending on its end date
+func calendarStart(graph *models.ContributionGraph) time.Time {
+	startDate := graph.StartDate
+	if graph.EndDate.Sub(startDate) < 365*24*time.Hour {
+		startDate = graph.EndDate.AddDate(-1, 0, 0)
 	}
 
 	// Adjust start date to Sunday for proper week alignment
 	for startDate.Weekday() != time.Sunday {
 		startDate = startDate.AddDate(0, 0, -1)
 	}
+	return startDate
+}
 
+// levelCell returns the cell of a date shaded and colored by the activity level of its value
+func (cgr *ContributionGraphRenderer) levelCell(graph *models.ContributionGraph) func(date string) string {
+	return func(date string) string {
+		return cgr.getCommitCell(graph.ActivityLevel(graph.DailyValues[date]))
+	}
+}
+
+// renderCalendar writes the month labels, day-of-week indicators and the cell of each date from
+// startDate, a Sunday, to endDate
+func (cgr *ContributionGraphRenderer) renderCalendar(result *strings.Builder, startDate, endDate time.Time, cell func(date string) string) {
 	// Render month labels
 	monthLabels := cgr.RenderMonthLabels(startDate, endDate)
 	result.WriteString(monthLabels)
@@ -61,63 +123,63 @@ func (cgr *ContributionGraphRenderer) RenderContributionGraph(graph *models.Cont
 
 	// Render day-of-week indicators and contribution cells
 	dayLabels := cgr.RenderDayIndicators()
-	contributionCells := cgr.renderContributionCells(graph, startDate, endDate)
+	contributionCells := cgr.renderContributionCells(startDate, endDate, cell)
 
 	// Combine day labels with contribution cells
 	dayLines := strings.Split(dayLabels, "\n")
 	cellLines := strings.Split(contributionCells, "\n")
 
 	for i := 0; i < len(dayLines) && i < len(cellLines); i++ {
-		if strings.TrimSpace(dayLines[i]) != "" {
-			result.WriteString(dayLines[i])
-			result.WriteString(" ")
-			result.WriteString(cellLines[i])
-			result.WriteString("\n")
-		}
-	}
-
-	// Add legend if enabled
-	if config.ShowLegend {
+		result.WriteString(dayLines[i])
+		result.WriteString(" ")
+		result.WriteString(cellLines[i])
 		result.WriteString("\n")
-		result.WriteString(cgr.renderLegend(graph.MaxCommits))
 	}
-
-	return result.String(), nil
 }
 
-// RenderMonthLabels renders the month labels above the contribution graph
+// RenderMonthLabels renders the month labels above the contribution graph, each over the
+// week column holding the first of its month; the cells are one column per week
 func (cgr *ContributionGraphRenderer) RenderMonthLabels(startDate, endDate time.Time) string {
-	var result strings.Builder
-
-	// Add spacing for day-of-week labels
-	result.WriteString("    ")
-
 	// Adjust start date to Sunday for proper week alignment
 	current := startDate
 	for current.Weekday() != time.Sunday {
 		current = current.AddDate(0, 0, -1)
 	}
 
-	weekCount := 0
-	lastMonth := -1
-
-	for weekCount < 53 && current.Before(endDate.AddDate(0, 0, 7)) {
-		currentMonth := int(current.Month())
+	type monthLabel struct {
+		column int
+		name   string
+	}
+	var labels []monthLabel
 
-		// Add month label at the beginning of each month
-		if currentMonth != lastMonth && (current.Day() <= 7 || weekCount == 0) {
-			monthName := current.Format("Jan")
-			result.WriteString(fmt.Sprintf("%-4s", monthName))
-			lastMonth = currentMonth
-		} else {
-			result.WriteString("    ") // Add spacing
+	for week := 0; week < maxGraphWeeks && !current.After(endDate); week++ {
+		for day := current; day.Before(current.AddDate(0, 0, 7)); day = day.AddDate(0, 0, 1) {
+			if day.Day() == 1 && !day.Before(startDate) && !day.After(endDate) {
+				labels = append(labels, monthLabel{week, day.Format("Jan")})
+			}
 		}
-
 		current = current.AddDate(0, 0, 7) // Move to next week
-		weekCount++
 	}
 
-	return result.String()
+	// The partial month at the start is labeled when there is room before the next label
+	first := startDate
+	if len(labels) == 0 || labels[0].column >= 4 && first.Day() != 1 {
+		labels = append([]monthLabel{{0, first.Format("Jan")}}, labels...)
+	}
+
+	var result []rune
+	for _, label := range labels {
+		if label.column < len(result)+1 && len(result) > 0 {
+			continue // No room next to the previous label
+		}
+		for len(result) < label.column {
+			result = append(result, ' ')
+		}
+		result = append(result, []rune(label.name)...)
+	}
+
+	// Add spacing for day-of-week labels
+	return "  " + string(result)
 }
 
 // RenderDayIndicators renders the day-of-week indicators (S, M, T, W, T, F, S)
@@ -136,8 +198,8 @@ func (cgr *ContributionGraphRenderer) RenderDayIndicators() string {
 	return result.String()
 }
 
-// renderContributionCells renders the actual contribution cells
-func (cgr *ContributionGraphRenderer) renderContributionCells(graph *models.ContributionGraph, startDate, endDate time.Time) string {
+// renderContributionCells renders the actual contribution cells, one column per week
+func (cgr *ContributionGraphRenderer) renderContributionCells(startDate, endDate time.Time, cell func(date string) string) string {
 	var lines [7]strings.Builder // One for each day of the week
 
 	current := startDate
@@ -145,19 +207,14 @@ func (cgr *ContributionGraphRenderer) renderContributionCells(graph *models.Cont
 
 	for current.Before(endDate) || current.Equal(endDate) {
 		weekday := int(current.Weekday())
-		dateStr := current.Format("2006-01-02")
-		commits := graph.DailyCommits[dateStr]
-
-		// Get the appropriate character for the commit count
-		cell := cgr.getCommitCell(commits, graph.MaxCommits)
-		lines[weekday].WriteString(cell)
+		lines[weekday].WriteString(cell(current.Format("2006-01-02")))
 
 		current = current.AddDate(0, 0, 1)
 
 		// Move to next week after Saturday
 		if current.Weekday() == time.Sunday {
 			weekCount++
-			if weekCount > 52 { // Limit to one year
+			if weekCount >= maxGraphWeeks { // Limit to one year
 				break
 			}
 		}
@@ -175,143 +232,79 @@ func (cgr *ContributionGraphRenderer) renderContributionCells(graph *models.Cont
 	return result.String()
 }
 
-// getCommitCell returns the appropriate character/symbol for the commit count with colors
-func (cgr *ContributionGraphRenderer) getCommitCell(commits, maxCommits int) string {
+// getCommitCell returns the appropriate character/symbol for the activity level with colors
+func (cgr *ContributionGraphRenderer) getCommitCell(level int) string {
 	// Get the base character
-	char := cgr.getCommitChar(commits, maxCommits)
+	char := cgr.getCommitChar(level)
 
 	// Return with or without colors based on configuration
 	if !cgr.useColors {
 		return char
 	}
 
-	return cgr.getColoredCell(char, commits, maxCommits)
+	return cgr.getColoredCell(char, level)
 }
 
-// getCommitChar returns the appropriate character for the commit count
-func (cgr *ContributionGraphRenderer) getCommitChar(commits, maxCommits int) string {
-	if commits == 0 {
+// getCommitChar returns the appropriate character for the activity level
+func (cgr *ContributionGraphRenderer) getCommitChar(level int) string {
+	switch level {
+	case 0:
 		return "░" // Light shade for no commits
-	} else if commits <= maxCommits/4 {
+	case 1:
 		return "▒" // Medium shade for low activity
-	} else if commits <= maxCommits/2 {
+	case 2:
 		return "▓" // Dark shade for medium activity
-	} else if commits <= maxCommits*3/4 {
-		return "█" // Full block for high activity
-	} else {
-		return "█" // Full block for very high activity
-	}
-}
-
-// getColoredCell returns the character with appropriate colors
-func (cgr *ContributionGraphRenderer) getColoredCell(char string, commits, maxCommits int) string {
-	colors := cgr.getColorScheme()
-
-	if commits == 0 {
-		return colors.noActivity + char + colors.reset
-	} else if commits <= maxCommits/4 {
-		return colors.lowActivity + char + colors.reset
-	} else if commits <= maxCommits/2 {
-		return colors.mediumActivity + char + colors.reset
-	} else if commits <= maxCommits*3/4 {
-		return colors.highActivity + char + colors.reset
-	} else {
-		return colors.veryHighActivity + char + colors.reset
+	default:
+		return "█" // Full block for high and very high activity
 	}
 }
 
-// ColorScheme defines colors for different activity levels
-type ColorScheme struct {
-	reset            string
-	noActivity       string
-	lowActivity      string
-	mediumActivity   string
-	highActivity     string
-	veryHighActivity string
+// getColoredCell returns the character with the color of the activity level
+func (cgr *ContributionGraphRenderer) getColoredCell(char string, level int) string {
+	return cgr.levelColor(level) + char + ColorReset
 }
 
-// getColorScheme returns the color scheme based on the current theme
-func (cgr *ContributionGraphRenderer) getColorScheme() ColorScheme {
-	switch cgr.colorTheme {
-	case "github":
-		return ColorScheme{
-			reset:            "\033[0m",
-			noActivity:       "\033[90m",   // Dark gray
-			lowActivity:      "\033[92m",   // Light green
-			mediumActivity:   "\033[32m",   // Medium green
-			highActivity:     "\033[32;1m", // Bold green
-			veryHighActivity: "\033[33;1m", // Bold yellow-green
-		}
-	case "blue":
-		return ColorScheme{
-			reset:            "\033[0m",
-			noActivity:       "\033[90m",   // Dark gray
-			lowActivity:      "\033[94m",   // Light blue
-			mediumActivity:   "\033[34m",   // Medium blue
-			highActivity:     "\033[34;1m", // Bold blue
-			veryHighActivity: "\033[36;1m", // Bold cyan
-		}
-	case "fire":
-		return ColorScheme{
-			reset:            "\033[0m",
-			noActivity:       "\033[90m",   // Dark gray
-			lowActivity:      "\033[93m",   // Light yellow
-			mediumActivity:   "\033[33m",   // Yellow
-			highActivity:     "\033[31;1m", // Bold red
-			veryHighActivity: "\033[35;1m", // Bold magenta
-		}
-	default:
-		// Default to GitHub theme
-		return ColorScheme{
-			reset:            "\033[0m",
-			noActivity:       "\033[90m",   // Dark gray
-			lowActivity:      "\033[92m",   // Light green
-			mediumActivity:   "\033[32m",   // Medium green
-			highActivity:     "\033[32;1m", // Bold green
-			veryHighActivity: "\033[33;1m", // Bold yellow-green
-		}
-	}
+// levelColor returns the escape sequence of the theme's color for an activity level
+func (cgr *ContributionGraphRenderer) levelColor(level int) string {
+	return LevelEscape(cgr.theme, level, cgr.colorDepth)
 }
 
 // RenderLegend renders the legend showing commit levels with colors (public for testing)
 func (cgr *ContributionGraphRenderer) RenderLegend(maxCommits int) string {
-	return cgr.renderLegend(maxCommits)
+	graph := &models.ContributionGraph{MaxValue: maxCommits}
+	return cgr.RenderGraphLegend(graph)
 }
 
-// renderLegend renders the legend showing commit levels with colors
-func (cgr *ContributionGraphRenderer) renderLegend(maxCommits int) string {
+// RenderGraphLegend renders the legend of graph with the daily values of each activity level,
+// naming the metric its days count
+func (cgr *ContributionGraphRenderer) RenderGraphLegend(graph *models.ContributionGraph) string {
+	return cgr.renderLegend(models.LevelRanges(graph.LevelThresholds()), models.MetricLabel(graph.Metric))
+}
+
+// renderLegend renders the legend showing activity levels with colors, the ranges counting label per day
+func (cgr *ContributionGraphRenderer) renderLegend(ranges []string, label string) string {
 	var result strings.Builder
-	colors := cgr.getColorScheme()
-
-	result.WriteString("Less ")
-
-	if cgr.useColors {
-		result.WriteString(colors.noActivity + "░" + colors.reset + " ")
-		result.WriteString(colors.lowActivity + "▒" + colors.reset + " ")
-		result.WriteString(colors.mediumActivity + "▓" + colors.reset + " ")
-		result.WriteString(colors.highActivity + "█" + colors.reset + " ")
-		result.WriteString(colors.veryHighActivity + "█" + colors.reset)
-	} else {
-		result.WriteString("░ ▒ ▓ █ █")
+
+	result.WriteString("Less")
+	for level := 0; level <= 4; level++ {
+		result.WriteString(" ")
+		result.WriteString(cgr.getCommitCell(level))
 	}
 
 	result.WriteString(" More\n")
 
-	// Add numeric legend
-	if cgr.useColors {
-		result.WriteString(fmt.Sprintf("%s0%s   %s%d%s   %s%d%s   %s%d%s   %s%d+%s",
-			colors.noActivity, colors.reset,
-			colors.lowActivity, maxCommits/4, colors.reset,
-			colors.mediumActivity, maxCommits/2, colors.reset,
-			colors.highActivity, maxCommits*3/4, colors.reset,
-			colors.veryHighActivity, maxCommits, colors.reset))
-	} else {
-		result.WriteString(fmt.Sprintf("0   %d   %d   %d   %d+",
-			maxCommits/4,
-			maxCommits/2,
-			maxCommits*3/4,
-			maxCommits))
+	// Add the daily values of each level
+	values := append([]string{"0"}, ranges...)
+	for level, value := range values {
+		if level > 0 {
+			result.WriteString("   ")
+		}
+		if cgr.useColors {
+			value = cgr.levelColor(level) + value + ColorReset
+		}
+		result.WriteString(value)
 	}
+	fmt.Fprintf(&result, "   %s per day", label)
 
 	return result.String()
 }
@@ -319,7 +312,7 @@ func (cgr *ContributionGraphRenderer) renderLegend(maxCommits int) string {
 // GetDayCommits returns the commit count for a specific day (used for interactive selection)
 func (cgr *ContributionGraphRenderer) GetDayCommits(graph *models.ContributionGraph, date time.Time) int {
 	dateStr := date.Format("2006-01-02")
-	return graph.DailyCommits[dateStr]
+	return graph.DailyValues[dateStr]
 }
 
 // GetDateFromPosition calculates the date from a position in the contribution graph
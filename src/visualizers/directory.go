@@ -0,0 +1,98 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Directory and module statistics tables
+
+package visualizers
+
+import (
+	"fmt"
+	"git-stats/models"
+	"strings"
+)
+
+// RenderDirectoryStatistics renders the activity of each directory or module as a table
+func (cr *ChartsRenderer) RenderDirectoryStatistics(directories []models.DirectoryStats, config models.RenderConfig) (string, error) {
+	var result strings.Builder
+
+	modules := 0
+	for i := range directories {
+		if directories[i].IsModule() {
+			modules++
+		}
+	}
+
+	title := "Directory Statistics"
+	if modules > 0 {
+		title = "Module Statistics"
+	}
+	result.WriteString(title + "\n")
+	result.WriteString(strings.Repeat("=", len(title)) + "\n\n")
+
+	if len(directories) == 0 {
+		result.WriteString("No file changes found.\n")
+		return result.String(), nil
+	}
+
+	headers, rows := DirectoryTable(directories)
+	table, err := cr.RenderTable(headers, rows, config)
+	if err != nil {
+		return "", err
+	}
+	result.WriteString(table)
+
+	summary := countNoun(len(directories)-modules, "directory", "directories")
+	if modules > 0 {
+		summary = countNoun(modules, "module", "modules") + ", " + summary + " outside modules"
+	}
+	result.WriteString("\n" + summary + "\n")
+
+	return result.String(), nil
+}
+
+// DirectoryTable returns the headers and rows of the directory statistics table, one row per
+// directory with its top authors and a sparkline of its last 12 weeks. The Module column, naming
+// the manifest of module roots, appears only when a directory is a module
+func DirectoryTable(directories []models.DirectoryStats) ([]string, [][]string) {
+	hasModules := false
+	for i := range directories {
+		hasModules = hasModules || directories[i].IsModule()
+	}
+
+	headers := []string{"Directory"}
+	if hasModules {
+		headers = append(headers, "Module")
+	}
+	headers = append(headers, "Files", "Commits", "Churn", "Authors", "Top Authors", "First Change", "Last Change", "Trend", "Last 12 Weeks")
+
+	var rows [][]string
+	for i := range directories {
+		directory := &directories[i]
+
+		row := []string{directory.Path}
+		if hasModules {
+			manifest := directory.Manifest
+			if manifest == "" {
+				manifest = "-"
+			}
+			row = append(row, manifest)
+		}
+
+		authors := make([]string, len(directory.TopAuthors))
+		for j, author := range directory.TopAuthors {
+			authors[j] = fmt.Sprintf("%s %.0f%%", author.Name, author.Percentage)
+		}
+
+		rows = append(rows, append(row,
+			fmt.Sprintf("%d", directory.Files),
+			fmt.Sprintf("%d", directory.Commits),
+			fmt.Sprintf("+%d -%d", directory.Insertions, directory.Deletions),
+			fmt.Sprintf("%d", directory.Authors),
+			strings.Join(authors, ", "),
+			directory.FirstChange.Format("2006-01-02"),
+			directory.LastChange.Format("2006-01-02"),
+			directory.Trend,
+			Sparkline(directory.WeeklyCommits),
+		))
+	}
+
+	return headers, rows
+}
@@ -0,0 +1,110 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Lines of code growth charts
+
+package visualizers
+
+import (
+	"fmt"
+	"git-stats/models"
+	"strings"
+)
+
+// growthChartGroups is how many of the largest groups share the breakdown chart
+const growthChartGroups = 5
+
+// growthTableGroups is how many groups the breakdown table lists before summarizing the rest
+const growthTableGroups = 15
+
+// RenderCodeGrowth renders the cumulative net lines of code as a line chart with the lines added
+// and deleted, followed by a chart and table of the largest groups when growth has a breakdown
+func (cr *ChartsRenderer) RenderCodeGrowth(growth *models.CodeGrowth, config models.RenderConfig) (string, error) {
+	if growth == nil {
+		return "", fmt.Errorf("code growth cannot be nil")
+	}
+	if len(growth.Total.Points) == 0 {
+		return "No line changes in the selected range.\n", nil
+	}
+
+	var result strings.Builder
+	labels := make([]string, len(growth.Total.Points))
+	for i, point := range growth.Total.Points {
+		labels[i] = models.PeriodLabel(point.Period, growth.Interval)
+	}
+
+	title := fmt.Sprintf("Net Lines of Code per %s", models.GrowthTitle(growth.Interval))
+	result.WriteString(title + "\n")
+	result.WriteString(strings.Repeat("-", len(title)) + "\n")
+	chart, err := cr.RenderLineChart(labels, []ChartSeries{growthChartSeries(&growth.Total)}, config)
+	if err != nil {
+		return "", err
+	}
+	result.WriteString(chart)
+	result.WriteString(fmt.Sprintf("\nLines added: %d, deleted: %d, net: %+d\n",
+		growth.Total.Insertions(), growth.Total.Deletions(), growth.Total.Final()))
+	if len(growth.Exclude) > 0 {
+		result.WriteString(fmt.Sprintf("Excluded %s: %s, %d changed lines\n",
+			strings.Join(growth.Exclude, ", "), countNoun(growth.ExcludedFiles, "file", "files"), growth.ExcludedLines))
+	}
+
+	if len(growth.Groups) == 0 {
+		return result.String(), nil
+	}
+
+	title = "Net Lines by " + models.GrowthTitle(growth.Breakdown)
+	result.WriteString("\n" + title + "\n")
+	result.WriteString(strings.Repeat("-", len(title)) + "\n")
+
+	var series []ChartSeries
+	for i := 0; i < len(growth.Groups) && i < growthChartGroups; i++ {
+		series = append(series, growthChartSeries(&growth.Groups[i]))
+	}
+	if chart, err := cr.RenderLineChart(labels, series, config); err == nil {
+		result.WriteString(chart)
+	}
+	result.WriteString("\n")
+
+	headers, rows := GrowthTable(growth)
+	table, err := cr.RenderTable(headers, rows, config)
+	if err != nil {
+		return "", err
+	}
+	result.WriteString(table)
+	if hidden := len(growth.Groups) - growthTableGroups; hidden > 0 {
+		result.WriteString(fmt.Sprintf("%s not shown\n", countNoun(hidden, "more group", "more groups")))
+	}
+
+	return result.String(), nil
+}
+
+// GrowthTable returns the headers and rows of the largest groups of growth with their share of
+// the net lines
+func GrowthTable(growth *models.CodeGrowth) ([]string, [][]string) {
+	headers := []string{models.GrowthTitle(growth.Breakdown), "Added", "Deleted", "Net Lines", "Share"}
+
+	var rows [][]string
+	total := growth.Total.Final()
+	for i := 0; i < len(growth.Groups) && i < growthTableGroups; i++ {
+		group := &growth.Groups[i]
+		share := "-"
+		if total > 0 {
+			share = fmt.Sprintf("%.1f%%", float64(group.Final())/float64(total)*100)
+		}
+		rows = append(rows, []string{
+			group.Name,
+			fmt.Sprintf("%d", group.Insertions()),
+			fmt.Sprintf("%d", group.Deletions()),
+			fmt.Sprintf("%d", group.Final()),
+			share,
+		})
+	}
+	return headers, rows
+}
+
+// growthChartSeries returns the running totals of series as a line chart series
+func growthChartSeries(series *models.GrowthSeries) ChartSeries {
+	values := make([]float64, len(series.Points))
+	for i, point := range series.Points {
+		values[i] = float64(point.Total)
+	}
+	return ChartSeries{Name: series.Name, Values: values}
+}
@@ -0,0 +1,107 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Contributor comparison screen for the GUI
+
+//go:build gui
+// +build gui
+
+package visualizers
+
+import (
+	"fmt"
+	"git-stats/models"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+)
+
+// handleContributorSelection moves the contributor selection with [ and ]; it reports whether event was used
+func (gui *GUIInterface) handleContributorSelection(event *tcell.EventKey) bool {
+	switch event.Rune() {
+	case ']':
+		gui.state.MoveContributorSelection(1)
+	case '[':
+		gui.state.MoveContributorSelection(-1)
+	default:
+		return false
+	}
+
+	gui.detailPanel.ScrollToBeginning()
+	gui.updateDisplay()
+	return true
+}
+
+// openComparison compares the marked contributors over the commits currently shown
+func (gui *GUIInterface) openComparison() {
+	selectors := gui.state.MarkedSelectors()
+	switch {
+	case gui.compareHandler == nil:
+		gui.state.StatusMessage = "Comparison is not available"
+	case len(selectors) < 2:
+		gui.state.StatusMessage = fmt.Sprintf("Mark at least two contributors with %s to compare them",
+			gui.options.KeyMap.Key(ActionMarkContributor))
+	default:
+		comparison, err := gui.compareHandler(selectors)
+		if err != nil {
+			gui.state.StatusMessage = fmt.Sprintf("Comparison failed: %v", err)
+			break
+		}
+
+		text, err := gui.renderComparison(comparison)
+		if err != nil {
+			gui.state.StatusMessage = fmt.Sprintf("Comparison failed: %v", err)
+			break
+		}
+
+		names := make([]string, 0, len(comparison.Contributors))
+		for _, contributor := range comparison.Contributors {
+			names = append(names, contributor.Name)
+		}
+
+		gui.comparisonView.SetText(text)
+		gui.comparisonView.ScrollToBeginning()
+		gui.comparisonView.SetTitle(fmt.Sprintf("Compare: %s", EscapeTags(strings.Join(names, " vs "))))
+		gui.showingComparison = true
+		gui.state.StatusMessage = fmt.Sprintf("Comparing %d contributors", len(names))
+		gui.app.SetRoot(gui.comparisonLayout, true)
+		gui.app.SetFocus(gui.comparisonView)
+		return
+	}
+	gui.updateDisplay()
+}
+
+// renderComparison renders comparison for the comparison view, as wide as the main layout
+func (gui *GUIInterface) renderComparison(comparison *models.ContributorComparison) (string, error) {
+	_, _, width, _ := gui.layout.GetRect()
+	if width <= 2 {
+		width = 80
+	}
+
+	renderer := NewComparisonRenderer(models.RenderConfig{Width: width - 2, ShowLegend: true})
+	renderer.SetColorOptions(false)
+	return renderer.RenderComparison(comparison)
+}
+
+// closeComparison returns from the comparison view to the main layout
+func (gui *GUIInterface) closeComparison() {
+	gui.showingComparison = false
+	gui.app.SetRoot(gui.layout, true)
+	gui.updateDisplay()
+}
+
+// handleComparisonInput handles keys while the comparison is shown; unhandled keys scroll it
+func (gui *GUIInterface) handleComparisonInput(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyEscape, tcell.KeyBackspace, tcell.KeyBackspace2:
+		gui.closeComparison()
+		return nil
+	case tcell.KeyCtrlC:
+		gui.app.Stop()
+		return nil
+	}
+
+	if event.Rune() == 'q' || event.Rune() == 'Q' {
+		gui.closeComparison()
+		return nil
+	}
+	return event
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Export requests from the GUI
+
+package visualizers
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"git-stats/models"
+)
+
+// Export formats offered by the GUI export form
+const (
+	ExportFormatJSON       = "json"
+	ExportFormatCSV        = "csv"
+	ExportFormatMarkdown   = "markdown"
+	ExportFormatScreenshot = "screenshot" // plain text of the rendered screen
+)
+
+// What an export does when its file already exists
+const (
+	ExportIfExistsBackup    = "backup" // back up the existing file, then replace it
+	ExportIfExistsOverwrite = "overwrite"
+	ExportIfExistsAppend    = "append"
+	ExportIfExistsFail      = "fail"
+)
+
+// GUIExport is an export of the current view requested from the GUI export form
+type GUIExport struct {
+	Format     string
+	Path       string
+	IfExists   string
+	Data       *models.AnalysisResult // data behind the current view, limited to its selection
+	Screenshot string                 // rendered screen, used by the screenshot format
+}
+
+// GUIExportFunc writes export and returns the path of the written file
+type GUIExportFunc func(export GUIExport) (string, error)
+
+// ExportFileExtension returns the file extension used for an export format
+func ExportFileExtension(format string) string {
+	switch format {
+	case ExportFormatJSON:
+		return ".json"
+	case ExportFormatCSV:
+		return ".csv"
+	case ExportFormatMarkdown:
+		return ".md"
+	default:
+		return ".txt"
+	}
+}
+
+// DefaultExportPath returns the suggested export file name for view, e.g.
+// git-stats-files-20240131-150405.json
+func DefaultExportPath(view ViewType, format string, now time.Time) string {
+	return fmt.Sprintf("git-stats-%s-%s%s", strings.ToLower(view.String()), now.Format("20060102-150405"),
+		ExportFileExtension(format))
+}
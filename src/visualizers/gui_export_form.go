@@ -0,0 +1,208 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Export form for the GUI
+
+//go:build gui
+// +build gui
+
+package visualizers
+
+import (
+	"fmt"
+	"git-stats/models"
+	"strings"
+	"time"
+
+	"github.com/rivo/tview"
+)
+
+// exportFormats are the export form's format choices, in display order
+var exportFormats = []struct{ label, format string }{
+	{"JSON", ExportFormatJSON},
+	{"CSV", ExportFormatCSV},
+	{"Markdown", ExportFormatMarkdown},
+	{"Screenshot (plain text)", ExportFormatScreenshot},
+}
+
+// exportIfExists are the export form's choices for an existing file, in display order
+var exportIfExists = []struct{ label, mode string }{
+	{"Back up and overwrite", ExportIfExistsBackup},
+	{"Overwrite", ExportIfExistsOverwrite},
+	{"Append", ExportIfExistsAppend},
+	{"Fail", ExportIfExistsFail},
+}
+
+// openExportForm asks for the format and file of an export of the current view; the screen is
+// captured before the form covers it
+func (gui *GUIInterface) openExportForm() {
+	if gui.exportHandler == nil {
+		gui.state.StatusMessage = "Export is not available"
+		gui.updateDisplay()
+		return
+	}
+	if gui.state.Data == nil {
+		gui.state.StatusMessage = "Nothing to export yet"
+		gui.updateDisplay()
+		return
+	}
+
+	screenshot := gui.captureScreen()
+	data := gui.exportData()
+	view := gui.state.CurrentView
+
+	labels := make([]string, len(exportFormats))
+	for i, choice := range exportFormats {
+		labels[i] = choice.label
+	}
+	modes := make([]string, len(exportIfExists))
+	for i, choice := range exportIfExists {
+		modes[i] = choice.label
+	}
+
+	format := exportFormats[0].format
+	path := tview.NewInputField().
+		SetLabel("File").
+		SetText(DefaultExportPath(view, format, time.Now())).
+		SetFieldWidth(44)
+
+	form := tview.NewForm().SetItemPadding(0)
+	form.AddDropDown("Format", labels, 0, func(option string, index int) {
+		// Keep a custom file name, only swapping the extension of the previous format
+		next := exportFormats[index].format
+		if text := path.GetText(); strings.HasSuffix(text, ExportFileExtension(format)) {
+			path.SetText(strings.TrimSuffix(text, ExportFileExtension(format)) + ExportFileExtension(next))
+		}
+		format = next
+	})
+	form.AddFormItem(path)
+	form.AddDropDown("If file exists", modes, 0, nil)
+
+	form.AddButton("Export", func() {
+		mode, _ := form.GetFormItemByLabel("If file exists").(*tview.DropDown).GetCurrentOption()
+		export := GUIExport{
+			Format:     format,
+			Path:       strings.TrimSpace(path.GetText()),
+			IfExists:   exportIfExists[mode].mode,
+			Data:       data,
+			Screenshot: screenshot,
+		}
+
+		if export.Path == "" {
+			gui.state.StatusMessage = "Export needs a file name"
+		} else if written, err := gui.exportHandler(export); err != nil {
+			gui.state.StatusMessage = fmt.Sprintf("Export failed: %v", err)
+		} else {
+			gui.state.StatusMessage = fmt.Sprintf("Exported %s view to %s", view, written)
+		}
+		gui.closeFilterEditor()
+	}).
+		AddButton("Cancel", gui.closeFilterEditor).
+		SetCancelFunc(gui.closeFilterEditor)
+	form.SetBorder(true).SetTitle(fmt.Sprintf(" Export %s view ", view))
+
+	// The form handles its own keys like the filter form
+	gui.editingFilter = true
+	gui.app.SetRoot(centerPrimitive(form, 64, 7), true)
+	gui.app.SetFocus(form)
+}
+
+// exportData returns the data behind the current view, limited to the selected day, contributor
+// or path; the result is already filtered by the active GUI filters
+func (gui *GUIInterface) exportData() *models.AnalysisResult {
+	data := gui.state.Data
+	selection := &models.ViewSelection{
+		View:    gui.state.CurrentView.String(),
+		Filters: gui.state.FilterSummary,
+	}
+	result := &models.AnalysisResult{
+		Repository: data.Repository,
+		TimeRange:  data.TimeRange,
+		Selection:  selection,
+	}
+
+	switch gui.state.CurrentView {
+	case ContributionView:
+		result.ContribGraph = data.ContribGraph
+		selection.Date = gui.state.SelectedDate
+		selection.Commits = gui.state.SelectedCommits
+	case StatisticsView:
+		result.Summary = data.Summary
+	case ContributorsView:
+		result.Contributors = data.Contributors
+		if index := gui.state.SelectedContributor; index >= 0 && index < len(data.Contributors) {
+			contributor := data.Contributors[index]
+			result.Contributors = []models.Contributor{contributor}
+			selection.Contributor = fmt.Sprintf("%s <%s>", contributor.Name, contributor.Email)
+		}
+	case HealthView:
+		result.HealthMetrics = data.HealthMetrics
+	case FilesView:
+		if data.FileTree != nil {
+			result.FileTree = data.FileTree
+			if node := data.FileTree.Find(gui.state.SelectedPath); node != nil {
+				result.FileTree = node
+				selection.Path = node.Path
+			}
+		}
+	case GraphView:
+		result.CommitGraph = data.CommitGraph
+		selection.Commits = gui.selectedGraphCommits()
+	}
+
+	return result
+}
+
+// selectedGraphCommits returns the commit, or the commits of the collapsed run, selected in the graph view
+func (gui *GUIInterface) selectedGraphCommits() []models.Commit {
+	graph := gui.state.Data.CommitGraph
+	if graph == nil {
+		return nil
+	}
+
+	for i, row := range graph.Rows {
+		if row.Commit.Hash != gui.state.SelectedGraphCommit {
+			continue
+		}
+		end := i + 1
+		if run := gui.state.SelectedGraphRun; run > 0 && i+run <= len(graph.Rows) {
+			end = i + run
+		}
+		commits := make([]models.Commit, 0, end-i)
+		for _, row := range graph.Rows[i:end] {
+			commits = append(commits, row.Commit)
+		}
+		return commits
+	}
+	return nil
+}
+
+// captureScreen returns the last drawn screen as plain text, one line per row without
+// trailing spaces
+func (gui *GUIInterface) captureScreen() string {
+	if gui.screen == nil {
+		return ""
+	}
+
+	var text strings.Builder
+	width, height := gui.screen.Size()
+	for y := 0; y < height; y++ {
+		var line strings.Builder
+		for x := 0; x < width; {
+			mainc, combc, _, cellWidth := gui.screen.GetContent(x, y)
+			if mainc == 0 {
+				mainc = ' '
+			}
+			line.WriteRune(mainc)
+			line.WriteString(string(combc))
+
+			// Wide characters cover the next cell as well
+			if cellWidth < 1 {
+				cellWidth = 1
+			}
+			x += cellWidth
+		}
+		text.WriteString(strings.TrimRight(line.String(), " "))
+		text.WriteString("\n")
+	}
+
+	return text.String()
+}
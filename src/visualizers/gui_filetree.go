@@ -0,0 +1,360 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - File tree explorer for the GUI
+
+//go:build gui
+// +build gui
+
+package visualizers
+
+import (
+	"fmt"
+	"git-stats/models"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// FileTreeSort is the metric the file tree is sorted by
+type FileTreeSort int
+
+const (
+	SortByName FileTreeSort = iota
+	SortByCommits
+	SortByChurn
+	SortByAuthors
+	SortByLastModified
+)
+
+// fileTreeSorts lists the sort orders in the order they are cycled through
+var fileTreeSorts = []FileTreeSort{SortByName, SortByCommits, SortByChurn, SortByAuthors, SortByLastModified}
+
+// String returns the display name of the sort order
+func (fs FileTreeSort) String() string {
+	switch fs {
+	case SortByName:
+		return "name"
+	case SortByCommits:
+		return "commits"
+	case SortByChurn:
+		return "churn"
+	case SortByAuthors:
+		return "authors"
+	case SortByLastModified:
+		return "last modified"
+	default:
+		return "unknown"
+	}
+}
+
+// FileTreeWidget shows the repository as a collapsible directory tree
+type FileTreeWidget struct {
+	*tview.TreeView
+	State  *GUIState
+	SortBy FileTreeSort
+	// OnChange is called when the current node changes, OnSelect when a node is chosen with Enter
+	OnChange func()
+	OnSelect func(node *models.FileTreeNode)
+	expanded map[string]bool // paths of the expanded directories
+}
+
+// NewFileTreeWidget creates the file tree explorer
+func NewFileTreeWidget(state *GUIState) *FileTreeWidget {
+	widget := &FileTreeWidget{
+		TreeView: tview.NewTreeView(),
+		State:    state,
+		expanded: map[string]bool{"": true},
+	}
+
+	widget.SetBorder(true)
+	widget.SetGraphicsColor(tcell.ColorGray)
+	widget.SetChangedFunc(func(node *tview.TreeNode) {
+		if treeNode, ok := node.GetReference().(*models.FileTreeNode); ok {
+			widget.State.SelectedPath = treeNode.Path
+		}
+		if widget.OnChange != nil {
+			widget.OnChange()
+		}
+	})
+	// Space and mouse clicks select a node; directories expand or collapse
+	widget.SetSelectedFunc(func(node *tview.TreeNode) {
+		if treeNode, ok := node.GetReference().(*models.FileTreeNode); ok && treeNode.IsDir {
+			widget.setExpanded(treeNode, !widget.expanded[treeNode.Path])
+		}
+	})
+
+	return widget
+}
+
+// Rebuild recreates the tree from State.Data, keeping the expanded directories and the
+// current node; when the current node no longer exists its closest ancestor is selected
+func (ftw *FileTreeWidget) Rebuild() {
+	ftw.SetTitle(fmt.Sprintf("Files (sorted by %s)", ftw.SortBy))
+
+	var data *models.FileTreeNode
+	if ftw.State.Data != nil {
+		data = ftw.State.Data.FileTree
+	}
+	if data == nil || len(data.Children) == 0 {
+		empty := tview.NewTreeNode("No changed files").SetSelectable(false)
+		ftw.SetRoot(empty).SetCurrentNode(nil)
+		return
+	}
+
+	rootName := "."
+	if ftw.State.Data.Repository != nil && ftw.State.Data.Repository.Name != "" {
+		rootName = ftw.State.Data.Repository.Name
+	}
+
+	nodes := make(map[string]*tview.TreeNode)
+	root := ftw.buildNode(data, rootName, nodes)
+
+	path := ftw.State.SelectedPath
+	current := nodes[path]
+	for current == nil && path != "" {
+		path = parentPath(path)
+		current = nodes[path]
+	}
+	ftw.State.SelectedPath = path
+	ftw.SetRoot(root).SetCurrentNode(current)
+}
+
+// buildNode creates the tree node for node and, when it is expanded, its children
+func (ftw *FileTreeWidget) buildNode(node *models.FileTreeNode, name string, nodes map[string]*tview.TreeNode) *tview.TreeNode {
+	expanded := node.IsDir && ftw.expanded[node.Path]
+
+	marker := "  "
+	if node.IsDir {
+		marker = "▸ "
+		if expanded {
+			marker = "▾ "
+		}
+		name += "/"
+	}
+
+	label := fmt.Sprintf("%s%s (%d commits, +%d/-%d, %d authors, %s, %s)",
+		marker, name, node.Commits, node.Insertions, node.Deletions, node.Authors,
+		node.TopAuthor, node.LastModified.Format("2006-01-02"))
+
+	treeNode := tview.NewTreeNode(EscapeTags(label)).
+		SetReference(node).
+		SetExpanded(expanded)
+	if node.IsDir {
+		treeNode.SetColor(tcell.ColorDodgerBlue)
+	}
+	nodes[node.Path] = treeNode
+
+	if expanded {
+		for _, child := range ftw.sortedChildren(node) {
+			treeNode.AddChild(ftw.buildNode(child, child.Name, nodes))
+		}
+	}
+	return treeNode
+}
+
+// sortedChildren returns the children of node in the current sort order; metrics sort
+// descending and ties are ordered by name
+func (ftw *FileTreeWidget) sortedChildren(node *models.FileTreeNode) []*models.FileTreeNode {
+	children := append([]*models.FileTreeNode(nil), node.Children...)
+	sort.SliceStable(children, func(i, j int) bool {
+		a, b := children[i], children[j]
+		switch ftw.SortBy {
+		case SortByCommits:
+			if a.Commits != b.Commits {
+				return a.Commits > b.Commits
+			}
+		case SortByChurn:
+			if a.Churn() != b.Churn() {
+				return a.Churn() > b.Churn()
+			}
+		case SortByAuthors:
+			if a.Authors != b.Authors {
+				return a.Authors > b.Authors
+			}
+		case SortByLastModified:
+			if !a.LastModified.Equal(b.LastModified) {
+				return a.LastModified.After(b.LastModified)
+			}
+		default:
+			if a.IsDir != b.IsDir {
+				return a.IsDir
+			}
+		}
+		return a.Name < b.Name
+	})
+	return children
+}
+
+// CycleSort switches to the next sort order
+func (ftw *FileTreeWidget) CycleSort() {
+	for i, order := range fileTreeSorts {
+		if order == ftw.SortBy {
+			ftw.SortBy = fileTreeSorts[(i+1)%len(fileTreeSorts)]
+			break
+		}
+	}
+	ftw.Rebuild()
+}
+
+// Selected returns the model node of the current tree node, or nil
+func (ftw *FileTreeWidget) Selected() *models.FileTreeNode {
+	current := ftw.GetCurrentNode()
+	if current == nil {
+		return nil
+	}
+	node, _ := current.GetReference().(*models.FileTreeNode)
+	return node
+}
+
+// setExpanded expands or collapses the directory node
+func (ftw *FileTreeWidget) setExpanded(node *models.FileTreeNode, expanded bool) {
+	if node.Path == "" {
+		// The root always stays expanded
+		return
+	}
+	if expanded {
+		ftw.expanded[node.Path] = true
+	} else {
+		delete(ftw.expanded, node.Path)
+	}
+	ftw.State.SelectedPath = node.Path
+	ftw.Rebuild()
+}
+
+// HandleInput expands directories with →, collapses them (or moves to the parent) with ←,
+// chooses the current node with Enter and leaves the other keys to the tree view
+func (ftw *FileTreeWidget) HandleInput(event *tcell.EventKey) *tcell.EventKey {
+	node := ftw.Selected()
+
+	expand, collapse := event.Key() == tcell.KeyRight, event.Key() == tcell.KeyLeft
+
+	switch {
+	case node == nil:
+	case event.Key() == tcell.KeyEnter:
+		if ftw.OnSelect != nil {
+			ftw.OnSelect(node)
+		}
+		return nil
+	case expand:
+		if node.IsDir && !ftw.expanded[node.Path] {
+			ftw.setExpanded(node, true)
+		}
+		return nil
+	case collapse:
+		if node.IsDir && node.Path != "" && ftw.expanded[node.Path] {
+			ftw.setExpanded(node, false)
+		} else if node.Path != "" {
+			ftw.State.SelectedPath = parentPath(node.Path)
+			ftw.Rebuild()
+		}
+		if ftw.OnChange != nil {
+			ftw.OnChange()
+		}
+		return nil
+	}
+
+	if handler := ftw.InputHandler(); handler != nil {
+		handler(event, func(p tview.Primitive) {})
+	}
+	return nil
+}
+
+// parentPath returns the directory containing path, or "" for the repository root
+func parentPath(path string) string {
+	if index := strings.LastIndex(path, "/"); index >= 0 {
+		return path[:index]
+	}
+	return ""
+}
+
+// filterToPath filters the other views to the file or directory node; the root clears the path filter
+func (gui *GUIInterface) filterToPath(node *models.FileTreeNode) {
+	filter := gui.filter
+	switch {
+	case node.Path == "":
+		filter.IncludePaths = nil
+	case node.IsDir:
+		filter.IncludePaths = []string{node.Path + "/*"}
+	default:
+		filter.IncludePaths = []string{node.Path}
+	}
+	gui.applyFilter(filter)
+}
+
+// syncPanes shows the file tree or the commit graph in place of the contribution graph in
+// their views
+func (gui *GUIInterface) syncPanes() {
+	var left tview.Primitive = gui.contributionGraph
+	switch gui.state.CurrentView {
+	case FilesView:
+		left = gui.fileTree
+	case GraphView:
+		left = gui.commitGraph
+	}
+	if left == gui.leftPane {
+		return
+	}
+
+	gui.leftPane = left
+	size := gui.options.GraphPaneSize
+	gui.panes.Clear().
+		AddItem(left, 0, size, true).
+		AddItem(gui.splitter, 1, 0, false).
+		AddItem(gui.detailPanel, 0, 100-size, false)
+	gui.app.SetFocus(left)
+}
+
+// updateFilesDetails updates content for the files view with the selected file or directory
+func (dpw *DetailPanelWidget) updateFilesDetails(content *strings.Builder) {
+	tree := dpw.State.Data.FileTree
+	if tree == nil || len(tree.Children) == 0 {
+		content.WriteString("[yellow]No changed files in the selected commits[white]\n")
+		return
+	}
+
+	node := tree.Find(dpw.State.SelectedPath)
+	if node == nil {
+		node = tree
+	}
+
+	path, kind := node.Path, "File"
+	if node.IsDir {
+		kind = "Directory"
+		path += "/"
+	}
+	if node.Path == "" {
+		path, kind = "/", "Repository"
+	}
+
+	content.WriteString(fmt.Sprintf("[yellow]%s:[white] %s\n\n", kind, EscapeTags(path)))
+	content.WriteString(fmt.Sprintf("[green]Commits:[white] %d\n", node.Commits))
+	content.WriteString(fmt.Sprintf("[green]Churn:[white] %d lines ([green]+%d[white] / [red]-%d[white])\n",
+		node.Churn(), node.Insertions, node.Deletions))
+	if node != tree && tree.Churn() > 0 {
+		content.WriteString(fmt.Sprintf("[green]Share of churn:[white] %.1f%%\n", float64(node.Churn())*100/float64(tree.Churn())))
+	}
+	content.WriteString(fmt.Sprintf("[green]Authors:[white] %d\n", node.Authors))
+	content.WriteString(fmt.Sprintf("[green]Top author:[white] %s\n", EscapeTags(node.TopAuthor)))
+	content.WriteString(fmt.Sprintf("[green]Last modified:[white] %s\n", node.LastModified.Format("2006-01-02 15:04")))
+
+	if node.IsDir {
+		// List the most changed entries so large directories can be explored without expanding them
+		children := append([]*models.FileTreeNode(nil), node.Children...)
+		sort.SliceStable(children, func(i, j int) bool { return children[i].Churn() > children[j].Churn() })
+		if len(children) > 5 {
+			children = children[:5]
+		}
+
+		content.WriteString(fmt.Sprintf("\n[yellow]Most changed (%d entries):[white]\n", len(node.Children)))
+		for _, child := range children {
+			name := child.Name
+			if child.IsDir {
+				name += "/"
+			}
+			content.WriteString(fmt.Sprintf("  %-28s %6d lines %4d commits\n", EscapeTags(truncateString(name, 28)), child.Churn(), child.Commits))
+		}
+	}
+
+	content.WriteString("\n[gray]Enter shows only this subtree in the other views; the root clears the path filter[white]\n")
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Live filter values for the GUI
+
+package visualizers
+
+import (
+	"strings"
+	"time"
+
+	"git-stats/models"
+)
+
+// GUIFilter holds the values of the GUI filter bar and form; empty or zero fields are ignored
+type GUIFilter struct {
+	Author        string
+	Message       string
+	Since         string
+	Until         string
+	IncludePaths  []string
+	ExcludePaths  []string
+	MinInsertions int
+	MaxInsertions int
+	MinDeletions  int
+	MaxDeletions  int
+	MinFiles      int
+	MaxFiles      int
+}
+
+// GUIFilterFunc re-analyzes the loaded commits with filter, returning the new result and a
+// summary of the active filters
+type GUIFilterFunc func(filter GUIFilter) (*models.AnalysisResult, string, error)
+
+// IsEmpty reports whether the filter has no active criteria
+func (f GUIFilter) IsEmpty() bool {
+	return f.Author == "" && f.Message == "" && f.Since == "" && f.Until == "" &&
+		len(f.IncludePaths) == 0 && len(f.ExcludePaths) == 0 && !f.HasSizeBounds()
+}
+
+// HasSizeBounds reports whether any insertion, deletion or file count bound is set
+func (f GUIFilter) HasSizeBounds() bool {
+	return f.MinInsertions > 0 || f.MaxInsertions > 0 ||
+		f.MinDeletions > 0 || f.MaxDeletions > 0 ||
+		f.MinFiles > 0 || f.MaxFiles > 0
+}
+
+// ParseFilterList splits a comma-separated form value into trimmed, non-empty entries
+func ParseFilterList(value string) []string {
+	var entries []string
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// GUIAnalysisRequest is a filter change, repository refresh or history load the GUI runs in the
+// background
+type GUIAnalysisRequest struct {
+	Filter  GUIFilter
+	Reload  bool      // re-read the repository and keep the current selection
+	History time.Time // load older commits back to this date and keep the selection; zero otherwise
+}
+
+// Merge folds next, made while r waits for a running analysis, into r: a reload or history date
+// is added to r, loading back to the earliest date asked for, and a filter change replaces r's filter
+func (r GUIAnalysisRequest) Merge(next GUIAnalysisRequest) GUIAnalysisRequest {
+	if next.Reload {
+		// Keep the newer pending filter but re-read the repository with it
+		r.Reload = true
+	} else if next.History.IsZero() {
+		r.Filter = next.Filter
+	}
+	if !next.History.IsZero() && (r.History.IsZero() || next.History.Before(r.History)) {
+		r.History = next.History
+	}
+	return r
+}
+
+// Handler returns the function that runs r: refresh for a reload, history for a history load and
+// filter otherwise. A reload with a history date refreshes, then loads back to the date, so
+// merging the two drops neither
+func (r GUIAnalysisRequest) Handler(filter, refresh GUIFilterFunc, history GUIHistoryFunc) GUIFilterFunc {
+	if r.History.IsZero() || history == nil {
+		if r.Reload {
+			return refresh
+		}
+		return filter
+	}
+	if !r.Reload {
+		return func(f GUIFilter) (*models.AnalysisResult, string, error) {
+			return history(r.History, f)
+		}
+	}
+	return func(f GUIFilter) (*models.AnalysisResult, string, error) {
+		if _, _, err := refresh(f); err != nil {
+			return nil, "", err
+		}
+		return history(r.History, f)
+	}
+}
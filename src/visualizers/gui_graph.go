@@ -0,0 +1,295 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Commit graph view for the GUI
+
+//go:build gui
+// +build gui
+
+package visualizers
+
+import (
+	"fmt"
+	"git-stats/models"
+	"sort"
+	"strings"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// CommitGraphWidget shows the commit graph, one line per commit or collapsed run of commits
+type CommitGraphWidget struct {
+	*tview.Table
+	State  *GUIState
+	MinRun int  // shortest run of linear commits that is collapsed; 0 shows every commit
+	ASCII  bool // draw the lanes with ASCII characters
+	// OnChange is called when the current line changes, OnSelect when a commit is chosen with Enter
+	OnChange func()
+	OnSelect func(row models.CommitGraphRow)
+	lines    []CommitGraphLine
+	graph    *models.CommitGraph // graph the lines and expanded runs belong to
+	expanded map[int]bool        // first hidden row of the expanded runs
+}
+
+// NewCommitGraphWidget creates the commit graph view
+func NewCommitGraphWidget(state *GUIState) *CommitGraphWidget {
+	widget := &CommitGraphWidget{
+		Table:    tview.NewTable(),
+		State:    state,
+		MinRun:   DefaultCollapseRun,
+		expanded: make(map[int]bool),
+	}
+
+	widget.SetBorder(true)
+	widget.SetSelectable(true, false)
+	widget.SetSelectedStyle(tcell.StyleDefault.Background(tcell.ColorNavy))
+	widget.SetSelectionChangedFunc(func(row, column int) {
+		widget.selectLine(row)
+		if widget.OnChange != nil {
+			widget.OnChange()
+		}
+	})
+
+	return widget
+}
+
+// Rebuild renders the lines of State.Data's commit graph, keeping the selected commit; runs
+// stay expanded until the graph is rebuilt by a refresh or filter
+func (cgw *CommitGraphWidget) Rebuild() {
+	var graph *models.CommitGraph
+	if cgw.State.Data != nil {
+		graph = cgw.State.Data.CommitGraph
+	}
+	if graph != cgw.graph {
+		cgw.graph = graph
+		cgw.expanded = make(map[int]bool)
+	}
+
+	cgw.Clear()
+	if graph == nil || len(graph.Rows) == 0 {
+		cgw.lines = nil
+		cgw.SetTitle("Commit graph")
+		cgw.SetCell(0, 0, tview.NewTableCell("No commits").SetSelectable(false))
+		return
+	}
+
+	merges := 0
+	for _, row := range graph.Rows {
+		if row.Merge() {
+			merges++
+		}
+	}
+	cgw.SetTitle(fmt.Sprintf("Commit graph (%d commits, %d merges)", len(graph.Rows), merges))
+
+	renderer := NewCommitGraphRenderer(models.RenderConfig{})
+	renderer.SetASCII(cgw.ASCII)
+	cgw.lines = CollapseCommitGraph(graph, cgw.MinRun, cgw.expanded)
+	for i, text := range renderer.RenderLines(graph, cgw.lines) {
+		cgw.SetCell(i, 0, tview.NewTableCell(tview.TranslateANSI(EscapeTags(text))).SetExpansion(1))
+	}
+
+	// Keep the selected commit, or select the run that now hides it
+	selected := 0
+	if index := cgw.rowIndex(cgw.State.SelectedGraphCommit); index >= 0 {
+		for i, line := range cgw.lines {
+			if line.Row == index && line.Hidden == cgw.State.SelectedGraphRun ||
+				line.Hidden > 0 && index >= line.Row && index < line.Row+line.Hidden {
+				selected = i
+				break
+			}
+		}
+	}
+	cgw.Select(selected, 0)
+	cgw.selectLine(selected)
+}
+
+// rowIndex returns the index of the commit with hash in the graph, or -1
+func (cgw *CommitGraphWidget) rowIndex(hash string) int {
+	if cgw.graph == nil || hash == "" {
+		return -1
+	}
+	for i, row := range cgw.graph.Rows {
+		if row.Commit.Hash == hash {
+			return i
+		}
+	}
+	return -1
+}
+
+// selectLine records the commit or collapsed run of line in the state
+func (cgw *CommitGraphWidget) selectLine(line int) {
+	if line < 0 || line >= len(cgw.lines) {
+		return
+	}
+	cgw.State.SelectedGraphCommit = cgw.graph.Rows[cgw.lines[line].Row].Commit.Hash
+	cgw.State.SelectedGraphRun = cgw.lines[line].Hidden
+}
+
+// Selected returns the current line, false when the graph is empty
+func (cgw *CommitGraphWidget) Selected() (CommitGraphLine, bool) {
+	line, _ := cgw.GetSelection()
+	if line < 0 || line >= len(cgw.lines) {
+		return CommitGraphLine{}, false
+	}
+	return cgw.lines[line], true
+}
+
+// setRunExpanded expands or collapses the run whose first hidden row is key
+func (cgw *CommitGraphWidget) setRunExpanded(key int, expanded bool) {
+	if expanded {
+		cgw.expanded[key] = true
+	} else {
+		delete(cgw.expanded, key)
+	}
+	cgw.Rebuild()
+}
+
+// runKey returns the first hidden row of the expanded run containing row, or -1
+func (cgw *CommitGraphWidget) runKey(row int) int {
+	rows := cgw.graph.Rows
+	if !rows[row].Linear {
+		return -1
+	}
+	start := row
+	for start > 0 && rows[start-1].Linear && rows[start-1].Graph == rows[row].Graph {
+		start--
+	}
+	if !cgw.expanded[start+1] {
+		return -1
+	}
+	return start + 1
+}
+
+// HandleInput expands a collapsed run with Enter or →, collapses the run around the current
+// commit with ←, opens a commit with Enter and leaves the other keys to the table
+func (cgw *CommitGraphWidget) HandleInput(event *tcell.EventKey) *tcell.EventKey {
+	line, ok := cgw.Selected()
+
+	expand, collapse := event.Key() == tcell.KeyRight, event.Key() == tcell.KeyLeft
+
+	switch {
+	case !ok:
+	case event.Key() == tcell.KeyEnter && line.Hidden == 0:
+		if cgw.OnSelect != nil {
+			cgw.OnSelect(cgw.graph.Rows[line.Row])
+		}
+		return nil
+	case event.Key() == tcell.KeyEnter || expand:
+		if line.Hidden > 0 {
+			cgw.State.SelectedGraphCommit = cgw.graph.Rows[line.Row].Commit.Hash
+			cgw.State.SelectedGraphRun = 0
+			cgw.setRunExpanded(line.Row, true)
+			cgw.State.StatusMessage = fmt.Sprintf("Expanded %d commits", line.Hidden)
+		}
+		if cgw.OnChange != nil {
+			cgw.OnChange()
+		}
+		return nil
+	case collapse:
+		if key := cgw.runKey(line.Row); key >= 0 && line.Hidden == 0 {
+			cgw.State.SelectedGraphRun = 0
+			cgw.setRunExpanded(key, false)
+			cgw.State.StatusMessage = "Collapsed linear commits"
+		}
+		if cgw.OnChange != nil {
+			cgw.OnChange()
+		}
+		return nil
+	}
+
+	if handler := cgw.InputHandler(); handler != nil {
+		handler(event, func(p tview.Primitive) {})
+	}
+	return nil
+}
+
+// openGraphCommit opens a commit of the graph in the commit view; [ and ] then walk the
+// other commits of its day
+func (gui *GUIInterface) openGraphCommit(row models.CommitGraphRow) {
+	gui.state.SelectDate(row.Commit.AuthorDate)
+	gui.contributionGraph.updateSelectedCommits()
+	for i, commit := range gui.state.SelectedCommits {
+		if commit.Hash == row.Commit.Hash {
+			gui.openCommit(i)
+			return
+		}
+	}
+
+	gui.state.StatusMessage = fmt.Sprintf("Commit %s is not available for drill-down", truncateString(row.Commit.Hash, 12))
+	gui.updateDisplay()
+}
+
+// updateGraphDetails updates content for the graph view with the selected commit or collapsed run
+func (dpw *DetailPanelWidget) updateGraphDetails(content *strings.Builder) {
+	graph := dpw.State.Data.CommitGraph
+	if graph == nil || len(graph.Rows) == 0 {
+		content.WriteString("[yellow]No commits in the selected range[white]\n")
+		return
+	}
+
+	index := 0
+	for i, row := range graph.Rows {
+		if row.Commit.Hash == dpw.State.SelectedGraphCommit {
+			index = i
+			break
+		}
+	}
+
+	if run := dpw.State.SelectedGraphRun; run > 0 && index+run <= len(graph.Rows) {
+		dpw.writeGraphRun(content, graph.Rows[index:index+run])
+		return
+	}
+
+	row := graph.Rows[index]
+	commit := row.Commit
+	content.WriteString(fmt.Sprintf("[yellow]Commit:[white] %s\n", commit.Hash))
+	if len(row.Refs) > 0 {
+		content.WriteString(fmt.Sprintf("[yellow]Refs:[white] %s\n", EscapeTags(strings.Join(row.Refs, ", "))))
+	}
+	if len(commit.ParentHashes) > 0 {
+		parents := make([]string, len(commit.ParentHashes))
+		for i, parent := range commit.ParentHashes {
+			parents[i] = truncateString(parent, 7)
+		}
+		label := "Parent"
+		if row.Merge() {
+			label = "Merge of"
+		}
+		content.WriteString(fmt.Sprintf("[yellow]%s:[white] %s\n", label, strings.Join(parents, " ")))
+	}
+	content.WriteString(fmt.Sprintf("[green]Author:[white] %s <%s>\n", EscapeTags(commit.Author.Name), EscapeTags(commit.Author.Email)))
+	content.WriteString(fmt.Sprintf("[green]Date:[white] %s\n", commit.AuthorDate.Format("2006-01-02 15:04")))
+	content.WriteString(fmt.Sprintf("[green]Changes:[white] %d files, [green]+%d[white] / [red]-%d[white]\n\n",
+		commit.Stats.FilesChanged, commit.Stats.Insertions, commit.Stats.Deletions))
+	content.WriteString(EscapeTags(commit.Message) + "\n")
+
+	content.WriteString("\n[gray]Enter opens the commit with its diff; ← collapses the linear commits around it[white]\n")
+}
+
+// writeGraphRun writes the authors, dates and size of a collapsed run of commits
+func (dpw *DetailPanelWidget) writeGraphRun(content *strings.Builder, rows []models.CommitGraphRow) {
+	counts := make(map[string]int)
+	var names []string
+	insertions, deletions := 0, 0
+	for _, row := range rows {
+		name := row.Commit.Author.Name
+		if counts[name] == 0 {
+			names = append(names, name)
+		}
+		counts[name]++
+		insertions += row.Commit.Stats.Insertions
+		deletions += row.Commit.Stats.Deletions
+	}
+	sort.SliceStable(names, func(i, j int) bool { return counts[names[i]] > counts[names[j]] })
+
+	content.WriteString(fmt.Sprintf("[yellow]Collapsed:[white] %d linear commits\n", len(rows)))
+	content.WriteString(fmt.Sprintf("[green]From:[white] %s\n", rows[len(rows)-1].Commit.AuthorDate.Format("2006-01-02 15:04")))
+	content.WriteString(fmt.Sprintf("[green]To:[white] %s\n", rows[0].Commit.AuthorDate.Format("2006-01-02 15:04")))
+	content.WriteString(fmt.Sprintf("[green]Changes:[white] [green]+%d[white] / [red]-%d[white]\n\n", insertions, deletions))
+
+	content.WriteString("[yellow]Authors:[white]\n")
+	for _, name := range names {
+		content.WriteString(fmt.Sprintf("  %-28s %4d commits\n", EscapeTags(truncateString(name, 28)), counts[name]))
+	}
+
+	content.WriteString("\n[gray]Enter or → shows every commit of the run[white]\n")
+}
@@ -0,0 +1,295 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Configurable key bindings for the GUI
+
+package visualizers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"git-stats/models"
+)
+
+// GUIAction names a GUI command that can be bound to a key in the configuration
+type GUIAction string
+
+const (
+	ActionQuit            GUIAction = "quit"
+	ActionHelp            GUIAction = "help"
+	ActionContribView     GUIAction = "contrib_view"
+	ActionStatsView       GUIAction = "stats_view"
+	ActionTeamView        GUIAction = "team_view"
+	ActionHealthView      GUIAction = "health_view"
+	ActionRefresh         GUIAction = "refresh"
+	ActionExport          GUIAction = "export"
+	ActionSearch          GUIAction = "search"
+	ActionFilter          GUIAction = "filter"
+	ActionClearFilter     GUIAction = "clear_filter"
+	ActionToggleDetails   GUIAction = "toggle_details"
+	ActionMarkContributor GUIAction = "mark_contributor"
+	ActionCompare         GUIAction = "compare"
+	ActionFilesView       GUIAction = "files_view"
+	ActionSortTree        GUIAction = "sort_tree"
+	ActionGraphView       GUIAction = "graph_view"
+)
+
+// actionInfo describes a bindable action for the help modal and status bar
+type actionInfo struct {
+	action      GUIAction
+	key         string
+	description string
+	hint        string
+}
+
+// guiActions lists the bindable actions with their default keys, in display order
+var guiActions = []actionInfo{
+	{ActionContribView, "c", "Contribution view", "Contrib"},
+	{ActionStatsView, "s", "Statistics view", "Stats"},
+	{ActionTeamView, "t", "Team/Contributors view", "Team"},
+	{ActionHealthView, "h", "Health metrics view", "Health"},
+	{ActionFilesView, "p", "Files view", "Files"},
+	{ActionGraphView, "b", "Commit graph view", "Graph"},
+	{ActionSearch, "/", "Search commit messages", "Search"},
+	{ActionFilter, "f", "Filter form (author, message, dates, paths, size)", "Filter"},
+	{ActionClearFilter, "x", "Clear all filters", "Clear"},
+	{ActionToggleDetails, "d", "Toggle details", "Details"},
+	{ActionMarkContributor, "m", "Mark contributor for comparison", "Mark"},
+	{ActionCompare, "v", "Compare marked contributors", "Compare"},
+	{ActionSortTree, "o", "Sort the file tree", "Sort"},
+	{ActionRefresh, "r", "Refresh (re-read repository)", "Refresh"},
+	{ActionExport, "e", "Export the current view", "Export"},
+	{ActionHelp, "?", "Toggle this help", "Help"},
+	{ActionQuit, "q", "Quit", "Quit"},
+}
+
+// reservedKeys are navigation keys handled by the views that cannot be rebound
+var reservedKeys = map[string]bool{
+	"j": true, "k": true, "g": true, "G": true, ",": true, ".": true, "<": true, ">": true,
+	"[": true, "]": true, "1": true, "2": true, "3": true, "4": true, "5": true, "6": true,
+	"esc": true, "tab": true, "backtab": true, "enter": true, "backspace": true,
+	"left": true, "right": true, "up": true, "down": true, "pgup": true, "pgdn": true,
+	"f1": true, "f2": true, "f3": true, "f4": true, "ctrl-c": true,
+}
+
+// namedKeys are the non-character keys that may be bound, in addition to single characters
+var namedKeys = func() map[string]bool {
+	names := map[string]bool{"home": true, "end": true, "insert": true, "delete": true}
+	for i := 5; i <= 12; i++ {
+		names[fmt.Sprintf("f%d", i)] = true
+	}
+	for c := 'a'; c <= 'z'; c++ {
+		// Ctrl-H, Ctrl-I and Ctrl-M are indistinguishable from Backspace, Tab and Enter
+		if c != 'c' && c != 'h' && c != 'i' && c != 'm' {
+			names["ctrl-"+string(c)] = true
+		}
+	}
+	return names
+}()
+
+// KeyBinding is a bound action as shown in the help modal and status bar
+type KeyBinding struct {
+	Action      GUIAction
+	Key         string
+	Description string
+	Hint        string
+}
+
+// KeyMap maps keys to GUI actions
+type KeyMap struct {
+	byKey    map[string]GUIAction
+	byAction map[GUIAction]string
+}
+
+// Bounds of the contribution graph pane width, in percent of the window
+const (
+	DefaultGraphPaneSize = 67
+	MinGraphPaneSize     = 10
+	MaxGraphPaneSize     = 90
+)
+
+// GUIOptions holds the configurable GUI behavior
+type GUIOptions struct {
+	KeyMap          *KeyMap
+	DefaultView     ViewType
+	RefreshInterval time.Duration // 0 disables auto-refresh
+	ShowHelp        bool          // show the help modal on startup
+	GraphPaneSize   int           // contribution graph pane width in percent; 0 uses DefaultGraphPaneSize
+	// GraphCollapseRun is the shortest run of linear commits the commit graph collapses; 0 shows every commit
+	GraphCollapseRun int
+	GraphASCII       bool // draw the commit graph lanes with ASCII characters
+	// Theme colors the contribution graph; nil uses the default theme
+	Theme *models.Theme
+}
+
+// DefaultKeyBindings returns the default action to key bindings
+func DefaultKeyBindings() map[string]string {
+	bindings := make(map[string]string, len(guiActions))
+	for _, info := range guiActions {
+		bindings[string(info.action)] = info.key
+	}
+	return bindings
+}
+
+// DefaultKeyMap returns the key map with the default bindings
+func DefaultKeyMap() *KeyMap {
+	keyMap, err := NewKeyMap(nil)
+	if err != nil {
+		panic(fmt.Sprintf("invalid default key bindings: %v", err))
+	}
+	return keyMap
+}
+
+// NewKeyMap builds a key map from action to key bindings; actions missing from bindings keep
+// their default key. Unknown actions, unsupported or reserved keys and keys bound to more
+// than one action are errors.
+func NewKeyMap(bindings map[string]string) (*KeyMap, error) {
+	keys := DefaultKeyBindings()
+
+	// Sort for deterministic error messages
+	actions := make([]string, 0, len(bindings))
+	for action := range bindings {
+		actions = append(actions, action)
+	}
+	sort.Strings(actions)
+
+	for _, action := range actions {
+		if _, known := keys[action]; !known {
+			return nil, fmt.Errorf("unknown GUI action %q in key bindings", action)
+		}
+		key, err := NormalizeKeyName(bindings[action])
+		if err != nil {
+			return nil, fmt.Errorf("invalid key for %s: %w", action, err)
+		}
+		keys[action] = key
+	}
+
+	keyMap := &KeyMap{
+		byKey:    make(map[string]GUIAction),
+		byAction: make(map[GUIAction]string),
+	}
+	for _, info := range guiActions {
+		key := keys[string(info.action)]
+		if reservedKeys[key] {
+			return nil, fmt.Errorf("key %q for %s is reserved for navigation", key, info.action)
+		}
+		if other, taken := keyMap.byKey[key]; taken {
+			return nil, fmt.Errorf("key %q is bound to both %s and %s", key, other, info.action)
+		}
+		keyMap.byKey[key] = info.action
+		keyMap.byAction[info.action] = key
+	}
+
+	return keyMap, nil
+}
+
+// NormalizeKeyName validates a key name from the configuration and returns its canonical form:
+// single characters are kept as-is (case-sensitive), named keys such as "F5" or "Ctrl+R" are
+// lower-cased with "-" as the modifier separator
+func NormalizeKeyName(name string) (string, error) {
+	if utf8.RuneCountInString(name) == 1 {
+		r, _ := utf8.DecodeRuneInString(name)
+		if !unicode.IsPrint(r) || unicode.IsSpace(r) {
+			return "", fmt.Errorf("unsupported key %q", name)
+		}
+		return name, nil
+	}
+
+	key := strings.ToLower(strings.TrimSpace(name))
+	key = strings.ReplaceAll(key, "+", "-")
+	switch key {
+	case "escape":
+		key = "esc"
+	case "pageup":
+		key = "pgup"
+	case "pagedown":
+		key = "pgdn"
+	}
+
+	if !namedKeys[key] && !reservedKeys[key] {
+		return "", fmt.Errorf("unsupported key %q", name)
+	}
+	return key, nil
+}
+
+// Action returns the action bound to key, a canonical key name. An upper-case letter falls
+// back to the binding of its lower-case form when it is not bound itself.
+func (km *KeyMap) Action(key string) (GUIAction, bool) {
+	if action, ok := km.byKey[key]; ok {
+		return action, true
+	}
+
+	if r, size := utf8.DecodeRuneInString(key); size == len(key) && unicode.IsUpper(r) {
+		lower := string(unicode.ToLower(r))
+		if !reservedKeys[key] {
+			action, ok := km.byKey[lower]
+			return action, ok
+		}
+	}
+	return "", false
+}
+
+// Key returns the display name of the key bound to action
+func (km *KeyMap) Key(action GUIAction) string {
+	return DisplayKeyName(km.byAction[action])
+}
+
+// Bindings returns every binding in display order
+func (km *KeyMap) Bindings() []KeyBinding {
+	bindings := make([]KeyBinding, 0, len(guiActions))
+	for _, info := range guiActions {
+		bindings = append(bindings, KeyBinding{
+			Action:      info.action,
+			Key:         km.Key(info.action),
+			Description: info.description,
+			Hint:        info.hint,
+		})
+	}
+	return bindings
+}
+
+// Binding returns the binding of action
+func (km *KeyMap) Binding(action GUIAction) KeyBinding {
+	for _, binding := range km.Bindings() {
+		if binding.Action == action {
+			return binding
+		}
+	}
+	return KeyBinding{Action: action}
+}
+
+// DisplayKeyName formats a canonical key name for display, e.g. "ctrl-r" as "Ctrl-R"
+func DisplayKeyName(key string) string {
+	if utf8.RuneCountInString(key) <= 1 {
+		return key
+	}
+
+	parts := strings.Split(key, "-")
+	for i, part := range parts {
+		parts[i] = strings.ToUpper(part[:1]) + part[1:]
+	}
+	return strings.Join(parts, "-")
+}
+
+// ParseViewName converts a configured view name into a ViewType
+func ParseViewName(name string) (ViewType, error) {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case "", "contrib", "contribution":
+		return ContributionView, nil
+	case "summary", "stats", "statistics":
+		return StatisticsView, nil
+	case "contributors", "team":
+		return ContributorsView, nil
+	case "health":
+		return HealthView, nil
+	case "files", "tree":
+		return FilesView, nil
+	case "graph", "log":
+		return GraphView, nil
+	default:
+		return ContributionView, fmt.Errorf("unknown GUI view %q", name)
+	}
+}
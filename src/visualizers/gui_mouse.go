@@ -0,0 +1,186 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Mouse support and resizable panes for the GUI
+
+//go:build gui
+// +build gui
+
+package visualizers
+
+import (
+	"fmt"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// viewTab is the screen columns covered by a drawn view tab
+type viewTab struct {
+	view       ViewType
+	start, end int
+}
+
+// ViewTabsWidget shows the views as a row of clickable tabs
+type ViewTabsWidget struct {
+	*tview.Box
+	State    *GUIState
+	OnSelect func(view ViewType)
+	tabs     []viewTab
+}
+
+// NewViewTabsWidget creates the view tab bar
+func NewViewTabsWidget(state *GUIState) *ViewTabsWidget {
+	return &ViewTabsWidget{
+		Box:   tview.NewBox(),
+		State: state,
+	}
+}
+
+// Draw renders one tab per view, highlighting the current one
+func (vtw *ViewTabsWidget) Draw(screen tcell.Screen) {
+	vtw.Box.DrawForSubclass(screen, vtw)
+	x, y, width, _ := vtw.GetInnerRect()
+
+	vtw.tabs = vtw.tabs[:0]
+	column := x
+	for i, view := range guiViews {
+		label := fmt.Sprintf(" %d %s ", i+1, view)
+		style := tcell.StyleDefault.Foreground(tcell.ColorGray)
+		if view == vtw.State.CurrentView {
+			style = tcell.StyleDefault.Foreground(tcell.ColorBlack).Background(tcell.ColorDarkCyan)
+		}
+
+		start := column
+		for _, r := range label {
+			if column >= x+width {
+				break
+			}
+			screen.SetContent(column, y, r, nil, style)
+			column++
+		}
+		vtw.tabs = append(vtw.tabs, viewTab{view: view, start: start, end: column})
+		column++
+	}
+}
+
+// MouseHandler switches to the clicked view
+func (vtw *ViewTabsWidget) MouseHandler() func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+	return vtw.WrapMouseHandler(func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+		x, y := event.Position()
+		if !vtw.InRect(x, y) || action != tview.MouseLeftClick {
+			return false, nil
+		}
+
+		for _, tab := range vtw.tabs {
+			if x >= tab.start && x < tab.end && vtw.OnSelect != nil {
+				vtw.OnSelect(tab.view)
+				break
+			}
+		}
+		return true, nil
+	})
+}
+
+// SplitterWidget is the draggable bar between the contribution graph and the detail panel
+type SplitterWidget struct {
+	*tview.Box
+	// OnDrag is called with the pointer column while dragging, OnDragEnd once the button is released
+	OnDrag    func(x int)
+	OnDragEnd func()
+	dragging  bool
+}
+
+// NewSplitterWidget creates a vertical splitter
+func NewSplitterWidget() *SplitterWidget {
+	return &SplitterWidget{Box: tview.NewBox()}
+}
+
+// Draw renders the splitter as a vertical line, highlighted while dragging
+func (sw *SplitterWidget) Draw(screen tcell.Screen) {
+	sw.Box.DrawForSubclass(screen, sw)
+	x, y, _, height := sw.GetInnerRect()
+
+	style := tcell.StyleDefault.Foreground(tcell.ColorGray)
+	if sw.dragging {
+		style = tcell.StyleDefault.Foreground(tcell.ColorYellow)
+	}
+	for row := 0; row < height; row++ {
+		screen.SetContent(x, y+row, '│', nil, style)
+	}
+	if height > 2 {
+		screen.SetContent(x, y+height/2, '┃', nil, style)
+	}
+}
+
+// MouseHandler starts a drag on press and captures the mouse until the button is released
+func (sw *SplitterWidget) MouseHandler() func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+	return sw.WrapMouseHandler(func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+		x, y := event.Position()
+
+		if !sw.dragging {
+			if action == tview.MouseLeftDown && sw.InRect(x, y) {
+				sw.dragging = true
+				return true, sw
+			}
+			return false, nil
+		}
+
+		switch action {
+		case tview.MouseMove:
+			if sw.OnDrag != nil {
+				sw.OnDrag(x)
+			}
+		case tview.MouseLeftUp:
+			sw.dragging = false
+			if sw.OnDragEnd != nil {
+				sw.OnDragEnd()
+			}
+			return true, nil
+		}
+		return true, sw
+	})
+}
+
+// handleMouse hides the day tooltip once the pointer leaves the contribution graph
+func (gui *GUIInterface) handleMouse(event *tcell.EventMouse, action tview.MouseAction) (*tcell.EventMouse, tview.MouseAction) {
+	if action == tview.MouseMove && gui.contributionGraph != nil && !gui.contributionGraph.InRect(event.Position()) {
+		if gui.contributionGraph.ClearHover() {
+			// Consuming the move redraws the screen without the tooltip
+			return nil, action
+		}
+	}
+	return event, action
+}
+
+// resizePanes moves the splitter to screen column x
+func (gui *GUIInterface) resizePanes(x int) {
+	left, _, width, _ := gui.panes.GetRect()
+	if width <= 0 {
+		return
+	}
+	gui.setGraphPaneSize((x - left) * 100 / width)
+}
+
+// setGraphPaneSize sets the left pane width in percent; the detail panel gets the rest
+func (gui *GUIInterface) setGraphPaneSize(size int) {
+	if size < MinGraphPaneSize {
+		size = MinGraphPaneSize
+	} else if size > MaxGraphPaneSize {
+		size = MaxGraphPaneSize
+	}
+
+	gui.options.GraphPaneSize = size
+	gui.panes.ResizeItem(gui.leftPane, 0, size).
+		ResizeItem(gui.detailPanel, 0, 100-size)
+}
+
+// savePaneSize persists the pane width after a drag
+func (gui *GUIInterface) savePaneSize() {
+	size := gui.options.GraphPaneSize
+	gui.state.StatusMessage = fmt.Sprintf("Graph pane: %d%%", size)
+	if gui.paneSizeHandler != nil {
+		if err := gui.paneSizeHandler(size); err != nil {
+			gui.state.StatusMessage = fmt.Sprintf("Failed to save pane size: %v", err)
+		}
+	}
+	gui.updateDisplay()
+}
@@ -4,10 +4,33 @@
 package visualizers
 
 import (
+	"errors"
 	"git-stats/models"
 	"time"
 )
 
+// ErrGUIUnavailable is returned when the binary was built without GUI support
+var ErrGUIUnavailable = errors.New("GUI mode requires building with -tags gui")
+
+// CommitSource supplies real commit data for the GUI's commit drill-down
+type CommitSource interface {
+	CommitsForDate(date time.Time) []models.Commit
+	CommitDetail(hash string) (*models.CommitDetail, error)
+}
+
+// GUILoadFunc produces the data shown by the GUI, reporting each stage to progress
+type GUILoadFunc func(progress func(stage string)) (*models.AnalysisResult, error)
+
+// GUICompareFunc compares the contributors selected by email over the commits currently shown
+type GUICompareFunc func(selectors []string) (*models.ContributorComparison, error)
+
+// GUIHistoryFunc loads the commits back to since, older than those loaded so far, and re-applies
+// filter, returning the new result and filter summary like GUIFilterFunc
+type GUIHistoryFunc func(since time.Time, filter GUIFilter) (*models.AnalysisResult, string, error)
+
+// GUIPaneSizeFunc persists the contribution graph pane width, in percent, after it is resized
+type GUIPaneSizeFunc func(graphPaneSize int) error
+
 // Visualizer interface for rendering output
 type Visualizer interface {
 	Render(data *models.AnalysisResult, config models.RenderConfig) (string, error)
@@ -37,6 +60,7 @@ type ChartsVisualizer interface {
 type GUIVisualizer interface {
 	Initialize() error
 	Run(data *models.AnalysisResult) error
+	RunWithLoader(load GUILoadFunc) error
 	Cleanup() error
 	HandleInput() error
 	Render() error
@@ -0,0 +1,234 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Braille line charts and sparklines
+
+package visualizers
+
+import (
+	"fmt"
+	"git-stats/models"
+	"math"
+	"strings"
+)
+
+// lineChartHeight is the number of text rows of a line chart, four Braille dots each
+const lineChartHeight = 8
+
+// minLineChartWidth is the narrowest plot area, in characters, a line chart gets
+const minLineChartWidth = 10
+
+// brailleDots are the bits of the Braille dots of a cell by dot row and dot column
+var brailleDots = [4][2]rune{{0x01, 0x08}, {0x02, 0x10}, {0x04, 0x20}, {0x40, 0x80}}
+
+// ChartSeries is one named line of a line chart; its values line up with the chart's labels
+type ChartSeries struct {
+	Name   string
+	Values []float64
+}
+
+// Sparkline renders values as one glyph each on a scale from zero to their maximum
+func Sparkline(values []int) string {
+	maxValue := 0
+	for _, value := range values {
+		if value > maxValue {
+			maxValue = value
+		}
+	}
+
+	var line strings.Builder
+	for _, value := range values {
+		line.WriteRune(sparkGlyph(value, maxValue))
+	}
+	return line.String()
+}
+
+// RenderLineChart renders the series as lines of Braille dots over a shared y axis with tick
+// labels on the left and the labels under the x axis. Charts of several series get a legend
+func (cr *ChartsRenderer) RenderLineChart(labels []string, series []ChartSeries, config models.RenderConfig) (string, error) {
+	if len(labels) == 0 || len(series) == 0 {
+		return "", fmt.Errorf("line chart needs labels and at least one series")
+	}
+	for _, s := range series {
+		if len(s.Values) != len(labels) {
+			return "", fmt.Errorf("series %q has %d values for %d labels", s.Name, len(s.Values), len(labels))
+		}
+	}
+
+	low, high := lineChartRange(series)
+	ticks := make([]string, lineChartHeight)
+	tickWidth := 0
+	for _, row := range []int{0, lineChartHeight / 2, lineChartHeight - 1} {
+		value := high - (high-low)*float64(row)/float64(lineChartHeight-1)
+		ticks[row] = formatTick(value)
+		if len(ticks[row]) > tickWidth {
+			tickWidth = len(ticks[row])
+		}
+	}
+
+	width := config.Width
+	if width <= 0 {
+		width = DefaultTerminalWidth
+	}
+	plotWidth := width - tickWidth - 2
+	if plotWidth < minLineChartWidth {
+		plotWidth = minLineChartWidth
+	}
+
+	// Plot every series into a grid of Braille cells; a cell takes the color of the last series in it
+	cells := make([][]rune, lineChartHeight)
+	owners := make([][]int, lineChartHeight)
+	for row := range cells {
+		cells[row] = make([]rune, plotWidth)
+		owners[row] = make([]int, plotWidth)
+	}
+	dotsWide, dotsHigh := plotWidth*2, lineChartHeight*4
+	dotX := func(i int) int {
+		if len(labels) == 1 {
+			return 0
+		}
+		return i * (dotsWide - 1) / (len(labels) - 1)
+	}
+	dotY := func(value float64) int {
+		return dotsHigh - 1 - int(math.Round((value-low)/(high-low)*float64(dotsHigh-1)))
+	}
+
+	for index, s := range series {
+		plot := func(x, y int) {
+			cells[y/4][x/2] |= brailleDots[y%4][x%2]
+			owners[y/4][x/2] = index
+		}
+		for i, value := range s.Values {
+			if i == 0 {
+				plot(dotX(0), dotY(value))
+				continue
+			}
+			drawDotLine(dotX(i-1), dotY(s.Values[i-1]), dotX(i), dotY(value), plot)
+		}
+	}
+
+	var result strings.Builder
+	for row := range cells {
+		axis := "│"
+		if ticks[row] != "" {
+			axis = "┤"
+		}
+		var line strings.Builder
+		for col, bits := range cells[row] {
+			if bits == 0 {
+				line.WriteByte(' ')
+				continue
+			}
+			glyph := string(0x2800 + bits)
+			if cr.useColors {
+				glyph = seriesColor(owners[row][col]) + glyph + ColorReset
+			}
+			line.WriteString(glyph)
+		}
+		result.WriteString(strings.TrimRight(fmt.Sprintf("%*s %s%s", tickWidth, ticks[row], axis, line.String()), " ") + "\n")
+	}
+	result.WriteString(strings.Repeat(" ", tickWidth+1) + "└" + strings.Repeat("─", plotWidth) + "\n")
+	result.WriteString(strings.Repeat(" ", tickWidth+2) + lineChartLabels(labels, plotWidth, func(i int) int { return dotX(i) / 2 }) + "\n")
+
+	if len(series) > 1 {
+		legend := make([]string, len(series))
+		for i, s := range series {
+			marker := "⣿"
+			if cr.useColors {
+				marker = seriesColor(i) + marker + ColorReset
+			}
+			legend[i] = marker + " " + s.Name
+		}
+		result.WriteString(strings.Repeat(" ", tickWidth+2) + strings.Join(legend, "   ") + "\n")
+	}
+
+	return result.String(), nil
+}
+
+// lineChartRange returns the y axis range of the series; it always includes zero
+func lineChartRange(series []ChartSeries) (low, high float64) {
+	for _, s := range series {
+		for _, value := range s.Values {
+			low = math.Min(low, value)
+			high = math.Max(high, value)
+		}
+	}
+	if high == low {
+		high = low + 1
+	}
+	return low, high
+}
+
+// drawDotLine plots the dots of the line from (x0, y0) to (x1, y1)
+func drawDotLine(x0, y0, x1, y1 int, plot func(x, y int)) {
+	dx, dy := abs(x1-x0), -abs(y1-y0)
+	stepX, stepY := 1, 1
+	if x0 > x1 {
+		stepX = -1
+	}
+	if y0 > y1 {
+		stepY = -1
+	}
+
+	for err := dx + dy; ; {
+		plot(x0, y0)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		if e2 := 2 * err; e2 >= dy {
+			err += dy
+			x0 += stepX
+		} else {
+			err += dx
+			y0 += stepY
+		}
+	}
+}
+
+// lineChartLabels places the x axis labels under their points from left to right, skipping
+// those that would overlap the previous label or the last one, which is always shown
+func lineChartLabels(labels []string, width int, column func(i int) int) string {
+	line := []rune(strings.Repeat(" ", width))
+	startOf := func(i int) int {
+		start := column(i) - len([]rune(labels[i]))/2
+		if end := start + len([]rune(labels[i])); end > width {
+			start -= end - width
+		}
+		if start < 0 {
+			start = 0
+		}
+		return start
+	}
+
+	last := len(labels) - 1
+	lastStart := startOf(last)
+	next := 0
+	for i := 0; i < last; i++ {
+		start := startOf(i)
+		if start < next || start+len([]rune(labels[i]))+2 > lastStart {
+			continue
+		}
+		copy(line[start:], []rune(labels[i]))
+		next = start + len([]rune(labels[i])) + 2
+	}
+	if lastStart >= next {
+		copy(line[lastStart:], []rune(labels[last]))
+	}
+
+	return strings.TrimRight(string(line), " ")
+}
+
+// formatTick formats a y axis value, shortening thousands and millions; only values below ten
+// keep a decimal
+func formatTick(value float64) string {
+	switch magnitude := math.Abs(value); {
+	case magnitude >= 1e6:
+		return fmt.Sprintf("%.1fM", value/1e6)
+	case magnitude >= 1e4:
+		return fmt.Sprintf("%.0fk", value/1e3)
+	case magnitude >= 1e3:
+		return fmt.Sprintf("%.1fk", value/1e3)
+	case magnitude >= 10 || value == math.Trunc(value):
+		return fmt.Sprintf("%.0f", value)
+	default:
+		return fmt.Sprintf("%.1f", value)
+	}
+}
@@ -9,8 +9,11 @@ package visualizers
 import (
 	"fmt"
 	"git-stats/models"
+	"sort"
+	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/gdamore/tcell/v2"
 	"github.com/rivo/tview"
@@ -24,8 +27,13 @@ const (
 	StatisticsView
 	ContributorsView
 	HealthView
+	FilesView
+	GraphView
 )
 
+// guiViews lists the views in tab order
+var guiViews = []ViewType{ContributionView, StatisticsView, ContributorsView, HealthView, FilesView, GraphView}
+
 // String returns the string representation of ViewType
 func (vt ViewType) String() string {
 	switch vt {
@@ -37,6 +45,10 @@ func (vt ViewType) String() string {
 		return "Contributors"
 	case HealthView:
 		return "Health"
+	case FilesView:
+		return "Files"
+	case GraphView:
+		return "Graph"
 	default:
 		return "Unknown"
 	}
@@ -52,11 +64,27 @@ type GUIState struct {
 	ShowHelp        bool
 	StatusMessage   string
 	Data            *models.AnalysisResult
+	Source          CommitSource // supplies real commits for drill-down; nil when unavailable
+	FilterSummary   string       // active GUI filters, empty when unfiltered
+	// SelectedContributor is the index into Data.Contributors shown as a profile, or -1
+	SelectedContributor int
+	// MarkedContributors holds the lowercase emails of the contributors marked for comparison
+	MarkedContributors map[string]bool
+	// SelectedPath is the file or directory selected in the files view, empty for the repository root
+	SelectedPath string
+	// SelectedGraphCommit is the hash of the commit selected in the graph view, or of the first
+	// commit of the selected collapsed run; SelectedGraphRun is the run's length, 0 for a commit
+	SelectedGraphCommit string
+	SelectedGraphRun    int
 }
 
-// NewGUIState creates a new GUI state with default values
+// NewGUIState creates a new GUI state with default values; the view shows the year ending
+// with the analyzed time range, so -year and -until open on that year
 func NewGUIState(data *models.AnalysisResult) *GUIState {
 	now := time.Now()
+	if data != nil && !data.TimeRange.End.IsZero() && data.TimeRange.End.Before(now) {
+		now = data.TimeRange.End
+	}
 	yearAgo := now.AddDate(-1, 0, 0)
 
 	return &GUIState{
@@ -67,6 +95,9 @@ func NewGUIState(data *models.AnalysisResult) *GUIState {
 		ShowHelp:      false,
 		StatusMessage: "Ready",
 		Data:          data,
+
+		SelectedContributor: -1,
+		MarkedContributors:  make(map[string]bool),
 	}
 }
 
@@ -84,32 +115,67 @@ func (gs *GUIState) SelectDate(date time.Time) {
 	// Update selected commits for the date if contribution graph data is available
 	if gs.Data != nil && gs.Data.ContribGraph != nil {
 		dateStr := date.Format("2006-01-02")
-		if commits, exists := gs.Data.ContribGraph.DailyCommits[dateStr]; exists {
-			gs.StatusMessage = fmt.Sprintf("Selected: %s (%d commits)", dateStr, commits)
+		label := models.MetricLabel(gs.Data.ContribGraph.Metric)
+		if commits, exists := gs.Data.ContribGraph.DailyValues[dateStr]; exists {
+			gs.StatusMessage = fmt.Sprintf("Selected: %s (%d %s)", dateStr, commits, label)
 		} else {
-			gs.StatusMessage = fmt.Sprintf("Selected: %s (0 commits)", dateStr)
+			gs.StatusMessage = fmt.Sprintf("Selected: %s (0 %s)", dateStr, label)
 		}
 	}
 }
 
-// NavigateMonth moves the view by the specified number of months
+// NavigateMonth moves the view and the selected date by the specified number of months
 func (gs *GUIState) NavigateMonth(months int) {
 	gs.ViewStartDate = gs.ViewStartDate.AddDate(0, months, 0)
 	gs.ViewEndDate = gs.ViewEndDate.AddDate(0, months, 0)
+	gs.SelectedDate = gs.SelectedDate.AddDate(0, months, 0)
 	gs.StatusMessage = fmt.Sprintf("Viewing: %s to %s",
 		gs.ViewStartDate.Format("Jan 2006"),
 		gs.ViewEndDate.Format("Jan 2006"))
 }
 
-// NavigateYear moves the view by the specified number of years
+// NavigateYear moves the view and the selected date by the specified number of years
 func (gs *GUIState) NavigateYear(years int) {
 	gs.ViewStartDate = gs.ViewStartDate.AddDate(years, 0, 0)
 	gs.ViewEndDate = gs.ViewEndDate.AddDate(years, 0, 0)
+	gs.SelectedDate = gs.SelectedDate.AddDate(years, 0, 0)
 	gs.StatusMessage = fmt.Sprintf("Viewing: %s to %s",
 		gs.ViewStartDate.Format("Jan 2006"),
 		gs.ViewEndDate.Format("Jan 2006"))
 }
 
+// ShowDate moves the view so it ends with the year containing date and selects date
+func (gs *GUIState) ShowDate(date time.Time) {
+	if date.Before(gs.ViewStartDate) || date.After(gs.ViewEndDate) {
+		gs.ViewEndDate = date.AddDate(0, 6, 0)
+		if now := time.Now(); gs.ViewEndDate.After(now) {
+			gs.ViewEndDate = now
+		}
+		gs.ViewStartDate = gs.ViewEndDate.AddDate(-1, 0, 0)
+	}
+	gs.SelectDate(date)
+}
+
+// HistoryNeeded reports whether the view starts before the loaded commits while the repository
+// has older ones, and the date to load history from: a year before the view so paging on
+// does not load again right away
+func (gs *GUIState) HistoryNeeded() (time.Time, bool) {
+	if gs.Data == nil || gs.Data.Repository == nil || gs.Data.TimeRange.Start.IsZero() {
+		return time.Time{}, false
+	}
+
+	loaded, first := gs.Data.TimeRange.Start, gs.Data.Repository.FirstCommit
+	if !gs.ViewStartDate.Before(loaded) || first.IsZero() || !first.Before(loaded) {
+		return time.Time{}, false
+	}
+
+	since := gs.ViewStartDate.AddDate(-1, 0, 0)
+	if since.Before(first) {
+		since = first.AddDate(0, 0, -1)
+	}
+	return since, true
+}
+
 // GetCommitsForDate returns commits for a specific date
 func (gs *GUIState) GetCommitsForDate(date time.Time) []models.Commit {
 	if gs.Data == nil {
@@ -127,6 +193,76 @@ func (gs *GUIState) UpdateSelectedCommits(commits []models.Commit) {
 	gs.SelectedCommits = commits
 }
 
+// SelectContributor selects the contributor matching author and reports whether one was found
+func (gs *GUIState) SelectContributor(author models.Author) bool {
+	if gs.Data == nil {
+		return false
+	}
+
+	index := FindContributor(gs.Data.Contributors, author)
+	if index < 0 {
+		gs.StatusMessage = fmt.Sprintf("No contributor profile for %s", author.Name)
+		return false
+	}
+
+	gs.SelectedContributor = index
+	gs.StatusMessage = fmt.Sprintf("Showing profile: %s", gs.Data.Contributors[index].Name)
+	return true
+}
+
+// MoveContributorSelection selects the contributor delta places from the current one
+func (gs *GUIState) MoveContributorSelection(delta int) {
+	if gs.Data == nil || len(gs.Data.Contributors) == 0 {
+		return
+	}
+
+	index := gs.SelectedContributor + delta
+	if gs.SelectedContributor < 0 {
+		index = 0
+	}
+	if index < 0 {
+		index = 0
+	} else if index >= len(gs.Data.Contributors) {
+		index = len(gs.Data.Contributors) - 1
+	}
+
+	gs.SelectedContributor = index
+	gs.StatusMessage = fmt.Sprintf("Showing profile: %s", gs.Data.Contributors[index].Name)
+}
+
+// ToggleContributorMark marks or unmarks the selected contributor for comparison
+func (gs *GUIState) ToggleContributorMark() {
+	if gs.Data == nil || gs.SelectedContributor < 0 || gs.SelectedContributor >= len(gs.Data.Contributors) {
+		gs.StatusMessage = "Select a contributor in the contributors view first"
+		return
+	}
+
+	contributor := gs.Data.Contributors[gs.SelectedContributor]
+	key := strings.ToLower(contributor.Email)
+	if gs.MarkedContributors[key] {
+		delete(gs.MarkedContributors, key)
+		gs.StatusMessage = fmt.Sprintf("Unmarked %s (%d marked)", contributor.Name, len(gs.MarkedContributors))
+	} else {
+		gs.MarkedContributors[key] = true
+		gs.StatusMessage = fmt.Sprintf("Marked %s (%d marked)", contributor.Name, len(gs.MarkedContributors))
+	}
+}
+
+// IsMarked reports whether contributor is marked for comparison
+func (gs *GUIState) IsMarked(contributor models.Contributor) bool {
+	return gs.MarkedContributors[strings.ToLower(contributor.Email)]
+}
+
+// MarkedSelectors returns the emails of the marked contributors, sorted
+func (gs *GUIState) MarkedSelectors() []string {
+	selectors := make([]string, 0, len(gs.MarkedContributors))
+	for email := range gs.MarkedContributors {
+		selectors = append(selectors, email)
+	}
+	sort.Strings(selectors)
+	return selectors
+}
+
 // ToggleHelp toggles the help display
 func (gs *GUIState) ToggleHelp() {
 	gs.ShowHelp = !gs.ShowHelp
@@ -148,12 +284,20 @@ type KeyCommand struct {
 // ContributionGraphWidget handles the contribution graph display
 type ContributionGraphWidget struct {
 	*tview.Box
-	Data         *models.ContributionGraph
-	State        *GUIState
-	SelectedDay  time.Time
-	ViewOffset   int
-	CellWidth    int
-	CellHeight   int
+	Data        *models.ContributionGraph
+	State       *GUIState
+	Theme       *models.Theme // cell colors; nil uses the default theme
+	SelectedDay time.Time
+	ViewOffset  int
+	CellWidth   int
+	CellHeight  int
+	// OnChange is called after the mouse selects a day or moves the view
+	OnChange func()
+	// OnHistory is called when the view moves before the loaded commits, with the date to load from
+	OnHistory func(since time.Time)
+	hovering  bool
+	hoverX    int
+	hoverY    int
 }
 
 // NewContributionGraphWidget creates a new contribution graph widget
@@ -190,6 +334,8 @@ func (cgw *ContributionGraphWidget) Draw(screen tcell.Screen) {
 
 	// Draw contribution cells
 	cgw.drawContributionCells(screen, x+4, y+3, width-4, height-3)
+
+	cgw.drawTooltip(screen)
 }
 
 // drawMonthLabels draws the month labels at the top
@@ -220,14 +366,8 @@ func (cgw *ContributionGraphWidget) drawContributionCells(screen tcell.Screen, x
 		return // Not enough space for a week
 	}
 
-	// Calculate the starting date (should be a Sunday)
-	startDate := cgw.State.ViewStartDate
-	for startDate.Weekday() != time.Sunday {
-		startDate = startDate.AddDate(0, 0, -1)
-	}
-
 	// Draw cells for each week
-	currentDate := startDate
+	currentDate := cgw.firstCellDate()
 	weekOffset := 0
 
 	for weekOffset*3 < width && currentDate.Before(cgw.State.ViewEndDate.AddDate(0, 0, 7)) {
@@ -242,8 +382,8 @@ func (cgw *ContributionGraphWidget) drawContributionCells(screen tcell.Screen, x
 			// Get commit count for this date
 			dateStr := currentDate.Format("2006-01-02")
 			commits := 0
-			if cgw.Data.DailyCommits != nil {
-				commits = cgw.Data.DailyCommits[dateStr]
+			if cgw.Data.DailyValues != nil {
+				commits = cgw.Data.DailyValues[dateStr]
 			}
 
 			// Determine cell style based on commit count
@@ -264,63 +404,177 @@ func (cgw *ContributionGraphWidget) drawContributionCells(screen tcell.Screen, x
 	}
 }
 
-// getCellStyle returns the appropriate style for a cell based on commit count with enhanced colors
-func (cgw *ContributionGraphWidget) getCellStyle(commits int) tcell.Style {
-	base := tcell.StyleDefault
+// firstCellDate returns the date of the top-left cell, the Sunday on or before the view start
+func (cgw *ContributionGraphWidget) firstCellDate() time.Time {
+	startDate := cgw.State.ViewStartDate
+	for startDate.Weekday() != time.Sunday {
+		startDate = startDate.AddDate(0, 0, -1)
+	}
+	return startDate
+}
 
-	// Calculate activity level based on max commits for better scaling
-	maxCommits := 1
-	if cgw.Data != nil {
-		maxCommits = cgw.Data.MaxCommits
-		if maxCommits == 0 {
-			maxCommits = 1
-		}
+// DateAt returns the date of the cell drawn at screen position x, y, mirroring drawContributionCells
+func (cgw *ContributionGraphWidget) DateAt(x, y int) (time.Time, bool) {
+	if cgw.Data == nil {
+		return time.Time{}, false
 	}
 
-	switch {
-	case commits == 0:
-		// No activity - dark gray
-		return base.Foreground(tcell.ColorDarkGray).Background(tcell.ColorBlack)
-	case commits <= maxCommits/4:
-		// Low activity - light green
-		return base.Foreground(tcell.ColorLightGreen).Background(tcell.ColorDarkGreen)
-	case commits <= maxCommits/2:
-		// Medium activity - medium green
-		return base.Foreground(tcell.ColorGreen).Background(tcell.ColorDarkGreen)
-	case commits <= maxCommits*3/4:
-		// High activity - bright green
-		return base.Foreground(tcell.ColorLime).Background(tcell.ColorGreen)
-	default:
-		// Very high activity - yellow-green
-		return base.Foreground(tcell.ColorYellow).Background(tcell.ColorGreen)
+	innerX, innerY, width, height := cgw.GetInnerRect()
+	cellsX, cellsY := innerX+4, innerY+3
+	if height-3 < 7 || x < cellsX || y < cellsY || y >= cellsY+7 {
+		return time.Time{}, false
 	}
+
+	// Cells are two columns wide with a one column gap between weeks
+	column := x - cellsX
+	if column%3 == 2 || x-column%3 >= innerX+width-2 {
+		return time.Time{}, false
+	}
+
+	weekStart := cgw.firstCellDate().AddDate(0, 0, column/3*7)
+	if !weekStart.Before(cgw.State.ViewEndDate.AddDate(0, 0, 7)) {
+		return time.Time{}, false
+	}
+	return weekStart.AddDate(0, 0, y-cellsY), true
 }
 
-// getCellChar returns the appropriate character for a cell based on commit count with better scaling
-func (cgw *ContributionGraphWidget) getCellChar(commits int) rune {
-	// Calculate activity level based on max commits for better scaling
-	maxCommits := 1
-	if cgw.Data != nil {
-		maxCommits = cgw.Data.MaxCommits
-		if maxCommits == 0 {
-			maxCommits = 1
+// SetHover records the mouse position used for the day tooltip
+func (cgw *ContributionGraphWidget) SetHover(x, y int) {
+	cgw.hovering = true
+	cgw.hoverX, cgw.hoverY = x, y
+}
+
+// ClearHover hides the day tooltip and reports whether it was shown
+func (cgw *ContributionGraphWidget) ClearHover() bool {
+	wasHovering := cgw.hovering
+	cgw.hovering = false
+	return wasHovering
+}
+
+// drawTooltip shows the commit count of the day under the mouse next to the pointer
+func (cgw *ContributionGraphWidget) drawTooltip(screen tcell.Screen) {
+	if !cgw.hovering {
+		return
+	}
+	date, ok := cgw.DateAt(cgw.hoverX, cgw.hoverY)
+	if !ok {
+		return
+	}
+
+	dateStr := date.Format("2006-01-02")
+	commits := cgw.Data.DailyValues[dateStr]
+	label := models.MetricLabel(cgw.Data.Metric)
+	if commits == 1 && label == models.MetricCommits {
+		label = "commit"
+	}
+	text := fmt.Sprintf(" %s %s: %d %s ", date.Format("Mon"), dateStr, commits, label)
+
+	// Place the tooltip above the pointer, or below it on the top rows, and keep it inside the box
+	innerX, innerY, width, _ := cgw.GetInnerRect()
+	tipY := cgw.hoverY - 1
+	if tipY < innerY {
+		tipY = cgw.hoverY + 1
+	}
+	tipX := cgw.hoverX + 2
+	if tipX+len(text) > innerX+width {
+		tipX = innerX + width - len(text)
+	}
+	if tipX < innerX {
+		tipX = innerX
+	}
+
+	style := tcell.StyleDefault.Foreground(tcell.ColorBlack).Background(tcell.ColorYellow)
+	for i, r := range text {
+		if tipX+i >= innerX+width {
+			break
 		}
+		screen.SetContent(tipX+i, tipY, r, nil, style)
 	}
+}
 
-	switch {
-	case commits == 0:
+// MouseHandler selects the clicked day and tracks the pointer for the day tooltip
+func (cgw *ContributionGraphWidget) MouseHandler() func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+	return cgw.WrapMouseHandler(func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+		x, y := event.Position()
+		if !cgw.InRect(x, y) {
+			return false, nil
+		}
+
+		switch action {
+		case tview.MouseMove:
+			cgw.SetHover(x, y)
+			return true, nil
+		case tview.MouseLeftClick:
+			if date, ok := cgw.DateAt(x, y); ok {
+				cgw.State.SelectDate(date)
+				cgw.updateSelectedCommits()
+				cgw.changed()
+			}
+			return true, nil
+		case tview.MouseScrollUp:
+			cgw.State.NavigateMonth(-1)
+			cgw.navigated()
+			cgw.changed()
+			return true, nil
+		case tview.MouseScrollDown:
+			cgw.State.NavigateMonth(1)
+			cgw.navigated()
+			cgw.changed()
+			return true, nil
+		}
+		return false, nil
+	})
+}
+
+// changed notifies OnChange
+func (cgw *ContributionGraphWidget) changed() {
+	if cgw.OnChange != nil {
+		cgw.OnChange()
+	}
+}
+
+// navigated updates the selected commits after the view moved and asks OnHistory for older
+// commits when it moved past the loaded ones
+func (cgw *ContributionGraphWidget) navigated() {
+	cgw.updateSelectedCommits()
+	if since, ok := cgw.State.HistoryNeeded(); ok && cgw.OnHistory != nil {
+		cgw.OnHistory(since)
+	}
+}
+
+// getCellStyle returns the style of a cell in its theme color; tcell degrades the color to what the terminal can show
+func (cgw *ContributionGraphWidget) getCellStyle(commits int) tcell.Style {
+	theme := cgw.Theme
+	if theme == nil {
+		theme, _ = models.ResolveTheme(models.DefaultThemeName, nil)
+	}
+
+	color := theme.LevelColor(cgw.activityLevel(commits))
+	return tcell.StyleDefault.Foreground(tcell.NewRGBColor(int32(color.R), int32(color.G), int32(color.B)))
+}
+
+// getCellChar returns the appropriate character for a cell based on its activity level
+func (cgw *ContributionGraphWidget) getCellChar(commits int) rune {
+	switch cgw.activityLevel(commits) {
+	case 0:
 		return '░' // Light shade for no commits
-	case commits <= maxCommits/4:
+	case 1:
 		return '▒' // Medium shade for low activity
-	case commits <= maxCommits/2:
+	case 2:
 		return '▓' // Dark shade for medium activity
-	case commits <= maxCommits*3/4:
-		return '█' // Full block for high activity
 	default:
-		return '█' // Full block for very high activity (different color)
+		return '█' // Full block for high and very high activity (different color)
 	}
 }
 
+// activityLevel returns the activity level of a daily value under the graph's thresholds
+func (cgw *ContributionGraphWidget) activityLevel(value int) int {
+	if cgw.Data == nil {
+		return models.ActivityLevel(value, nil)
+	}
+	return cgw.Data.ActivityLevel(value)
+}
+
 // HandleInput processes keyboard input for the contribution graph
 func (cgw *ContributionGraphWidget) HandleInput(event *tcell.EventKey) *tcell.EventKey {
 	switch event.Key() {
@@ -328,6 +582,7 @@ func (cgw *ContributionGraphWidget) HandleInput(event *tcell.EventKey) *tcell.Ev
 		// Check for Ctrl modifier for month navigation
 		if event.Modifiers()&tcell.ModCtrl != 0 {
 			cgw.State.NavigateMonth(-1)
+			cgw.navigated()
 			return nil
 		}
 		// Navigate day left
@@ -339,6 +594,7 @@ func (cgw *ContributionGraphWidget) HandleInput(event *tcell.EventKey) *tcell.Ev
 		// Check for Ctrl modifier for month navigation
 		if event.Modifiers()&tcell.ModCtrl != 0 {
 			cgw.State.NavigateMonth(1)
+			cgw.navigated()
 			return nil
 		}
 		// Navigate day right
@@ -350,6 +606,7 @@ func (cgw *ContributionGraphWidget) HandleInput(event *tcell.EventKey) *tcell.Ev
 		// Check for Ctrl modifier for year navigation
 		if event.Modifiers()&tcell.ModCtrl != 0 {
 			cgw.State.NavigateYear(-1)
+			cgw.navigated()
 			return nil
 		}
 		// Navigate week up
@@ -361,6 +618,7 @@ func (cgw *ContributionGraphWidget) HandleInput(event *tcell.EventKey) *tcell.Ev
 		// Check for Ctrl modifier for year navigation
 		if event.Modifiers()&tcell.ModCtrl != 0 {
 			cgw.State.NavigateYear(1)
+			cgw.navigated()
 			return nil
 		}
 		// Navigate week down
@@ -371,17 +629,21 @@ func (cgw *ContributionGraphWidget) HandleInput(event *tcell.EventKey) *tcell.Ev
 	}
 
 	switch event.Rune() {
-	case 'h':
+	case ',':
 		cgw.State.NavigateMonth(-1)
+		cgw.navigated()
 		return nil
-	case 'l':
+	case '.':
 		cgw.State.NavigateMonth(1)
+		cgw.navigated()
 		return nil
-	case 'H':
+	case '<':
 		cgw.State.NavigateYear(-1)
+		cgw.navigated()
 		return nil
-	case 'L':
+	case '>':
 		cgw.State.NavigateYear(1)
+		cgw.navigated()
 		return nil
 	case 'g':
 		// Go to today
@@ -389,9 +651,12 @@ func (cgw *ContributionGraphWidget) HandleInput(event *tcell.EventKey) *tcell.Ev
 		cgw.updateSelectedCommits()
 		return nil
 	case 'G':
-		// Go to first commit date if available
-		if cgw.State.Data != nil && cgw.State.Data.ContribGraph != nil {
-			cgw.State.SelectDate(cgw.State.Data.ContribGraph.StartDate)
+		// Go to the repository's first commit, loading the history up to it
+		if data := cgw.State.Data; data != nil && data.Repository != nil && !data.Repository.FirstCommit.IsZero() {
+			cgw.State.ShowDate(data.Repository.FirstCommit)
+			cgw.navigated()
+		} else if data != nil && data.ContribGraph != nil {
+			cgw.State.SelectDate(data.ContribGraph.StartDate)
 			cgw.updateSelectedCommits()
 		}
 		return nil
@@ -406,34 +671,12 @@ func (cgw *ContributionGraphWidget) updateSelectedCommits() {
 		return
 	}
 
-	// In a real implementation, this would fetch actual commit details
-	// For now, we'll create mock commits based on the commit count
-	dateStr := cgw.State.SelectedDate.Format("2006-01-02")
-	commitCount := 0
-	if cgw.Data != nil && cgw.Data.DailyCommits != nil {
-		commitCount = cgw.Data.DailyCommits[dateStr]
-	}
-
-	// Create mock commits for demonstration
-	commits := make([]models.Commit, commitCount)
-	for i := 0; i < commitCount; i++ {
-		commits[i] = models.Commit{
-			Hash:    fmt.Sprintf("abc123%02d", i),
-			Message: fmt.Sprintf("Commit %d on %s", i+1, dateStr),
-			Author: models.Author{
-				Name:  "Test Author",
-				Email: "test@example.com",
-			},
-			AuthorDate: cgw.State.SelectedDate.Add(time.Duration(i) * time.Hour),
-			Stats: models.CommitStats{
-				FilesChanged: 1 + i,
-				Insertions:   10 + i*5,
-				Deletions:    2 + i,
-			},
-		}
+	if cgw.State.Source == nil {
+		cgw.State.UpdateSelectedCommits(nil)
+		return
 	}
 
-	cgw.State.UpdateSelectedCommits(commits)
+	cgw.State.UpdateSelectedCommits(cgw.State.Source.CommitsForDate(cgw.State.SelectedDate))
 }
 
 // UpdateContent updates the contribution graph content (required for initialization)
@@ -495,39 +738,86 @@ func (dpw *DetailPanelWidget) UpdateContent() {
 		dpw.updateContributorsDetails(&content)
 	case HealthView:
 		dpw.updateHealthDetails(&content)
+	case FilesView:
+		dpw.updateFilesDetails(&content)
+	case GraphView:
+		dpw.updateGraphDetails(&content)
 	}
 
 	dpw.SetText(content.String())
 }
 
+// Scroll moves the panel content by delta lines; the text view clamps the offset to the content when drawn
+func (dpw *DetailPanelWidget) Scroll(delta int) {
+	row, _ := dpw.GetScrollOffset()
+	row += delta
+	if row < 0 {
+		row = 0
+	}
+	dpw.ScrollPos = row
+	dpw.ScrollTo(row, 0)
+}
+
+// MouseHandler scrolls the panel with the mouse wheel; clicks leave the keyboard focus on the graph
+func (dpw *DetailPanelWidget) MouseHandler() func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+	return dpw.WrapMouseHandler(func(action tview.MouseAction, event *tcell.EventMouse, setFocus func(p tview.Primitive)) (consumed bool, capture tview.Primitive) {
+		if !dpw.InRect(event.Position()) {
+			return false, nil
+		}
+
+		switch action {
+		case tview.MouseScrollUp:
+			dpw.Scroll(-3)
+			return true, nil
+		case tview.MouseScrollDown:
+			dpw.Scroll(3)
+			return true, nil
+		}
+		return false, nil
+	})
+}
+
 // updateContributionDetails updates content for contribution view with enhanced commit details
 func (dpw *DetailPanelWidget) updateContributionDetails(content *strings.Builder) {
 	selectedDate := dpw.State.SelectedDate.Format("2006-01-02")
 	commits := 0
 
-	if dpw.State.Data.ContribGraph != nil && dpw.State.Data.ContribGraph.DailyCommits != nil {
-		commits = dpw.State.Data.ContribGraph.DailyCommits[selectedDate]
+	if dpw.State.Data.ContribGraph != nil && dpw.State.Data.ContribGraph.DailyValues != nil {
+		commits = dpw.State.Data.ContribGraph.DailyValues[selectedDate]
+	}
+
+	metric := models.MetricTitle(models.MetricCommits)
+	if dpw.State.Data.ContribGraph != nil {
+		metric = models.MetricTitle(dpw.State.Data.ContribGraph.Metric)
 	}
 
 	content.WriteString(fmt.Sprintf("[yellow]Selected Date:[white] %s\n", selectedDate))
-	content.WriteString(fmt.Sprintf("[yellow]Commits:[white] %d\n\n", commits))
+	content.WriteString(fmt.Sprintf("[yellow]%s:[white] %d\n\n", metric, commits))
 
 	if dpw.State.Data.ContribGraph != nil {
-		content.WriteString(fmt.Sprintf("[yellow]Total Contributions:[white] %d\n", dpw.State.Data.ContribGraph.TotalCommits))
-		content.WriteString(fmt.Sprintf("[yellow]Max Daily Commits:[white] %d\n", dpw.State.Data.ContribGraph.MaxCommits))
+		content.WriteString(fmt.Sprintf("[yellow]Total Contributions:[white] %d\n", dpw.State.Data.ContribGraph.Total))
+		content.WriteString(fmt.Sprintf("[yellow]Max Daily %s:[white] %d\n", metric, dpw.State.Data.ContribGraph.MaxValue))
+		content.WriteString(fmt.Sprintf("[yellow]Levels:[white] %s\n",
+			strings.Join(models.LevelRanges(dpw.State.Data.ContribGraph.LevelThresholds()), "  ")))
 		content.WriteString(fmt.Sprintf("[yellow]Period:[white] %s to %s\n\n",
 			dpw.State.Data.ContribGraph.StartDate.Format("2006-01-02"),
 			dpw.State.Data.ContribGraph.EndDate.Format("2006-01-02")))
 	}
 
+	if dpw.SelectedCommitIndex >= len(dpw.State.SelectedCommits) {
+		dpw.SelectedCommitIndex = 0
+	}
+
 	// Show detailed commit information if available
 	if dpw.ShowDetails && len(dpw.State.SelectedCommits) > 0 {
 		content.WriteString("[cyan]Commit Details:[white]\n")
 
 		// Show navigation hint if there are multiple commits
 		if len(dpw.State.SelectedCommits) > 1 {
-			content.WriteString(fmt.Sprintf("[gray]Use ↑↓ or j/k to navigate commits (%d/%d)[white]\n\n",
+			content.WriteString(fmt.Sprintf("[gray]Use [ and ] to select commits (%d/%d), Enter to open[white]\n\n",
 				dpw.SelectedCommitIndex+1, len(dpw.State.SelectedCommits)))
+		} else {
+			content.WriteString("[gray]Press Enter to open the commit[white]\n\n")
 		}
 
 		for i, commit := range dpw.State.SelectedCommits {
@@ -545,9 +835,9 @@ func (dpw *DetailPanelWidget) updateContributionDetails(content *strings.Builder
 			}
 
 			content.WriteString(fmt.Sprintf("%s%s[green]%s[white]\n", prefix, style, commit.Hash))
-			content.WriteString(fmt.Sprintf("%s%s  %s[white]\n", prefix, style, truncateString(commit.Message, 50)))
+			content.WriteString(fmt.Sprintf("%s%s  %s[white]\n", prefix, style, EscapeTags(truncateString(commit.Message, 50))))
 			content.WriteString(fmt.Sprintf("%s%s  [gray]%s <%s>[white]\n",
-				prefix, style, commit.Author.Name, commit.Author.Email))
+				prefix, style, EscapeTags(commit.Author.Name), EscapeTags(commit.Author.Email)))
 			content.WriteString(fmt.Sprintf("%s%s  [gray]%s[white]\n",
 				prefix, style, commit.AuthorDate.Format("2006-01-02 15:04:05")))
 			content.WriteString(fmt.Sprintf("%s%s  [gray]Files: %d, +%d/-%d lines[white]\n\n",
@@ -555,6 +845,8 @@ func (dpw *DetailPanelWidget) updateContributionDetails(content *strings.Builder
 		}
 	} else if commits == 0 {
 		content.WriteString("[gray]No commits on this date[white]\n")
+	} else if dpw.State.Source == nil {
+		content.WriteString("[gray]Commit details are not available[white]\n")
 	} else {
 		content.WriteString("[gray]Press 'd' to show commit details[white]\n")
 	}
@@ -574,6 +866,67 @@ func (dpw *DetailPanelWidget) updateStatisticsDetails(content *strings.Builder)
 	content.WriteString(fmt.Sprintf("[yellow]Files Changed:[white] %d\n", summary.FilesChanged))
 	content.WriteString(fmt.Sprintf("[yellow]Active Days:[white] %d\n", summary.ActiveDays))
 	content.WriteString(fmt.Sprintf("[yellow]Avg Commits/Day:[white] %.2f\n", summary.AvgCommitsPerDay))
+
+	if len(summary.CommitsByWeek) > 1 {
+		// The last half year fits the panel
+		weeks := summary.CommitsByWeek
+		if len(weeks) > 26 {
+			weeks = weeks[len(weeks)-26:]
+		}
+		commits := make([]int, len(weeks))
+		for i, week := range weeks {
+			commits[i] = week.Commits
+		}
+		content.WriteString(fmt.Sprintf("[yellow]Commits per Week:[white] [green]%s[white]\n", Sparkline(commits)))
+	}
+
+	if growth := dpw.State.Data.Growth; growth != nil && len(growth.Total.Points) > 1 {
+		content.WriteString(fmt.Sprintf("[yellow]Net Lines per %s:[white] %+d [green]%s[white]\n",
+			models.GrowthTitle(growth.Interval), growth.Total.Final(), growthSparkline(&growth.Total)))
+	}
+
+	if summary.PunchCard.Total() > 0 {
+		content.WriteString("\n[yellow]Punch Card:[white]\n")
+		dpw.writePunchCard(content, &summary.PunchCard)
+	}
+}
+
+// growthSparkline draws the running totals of the last 26 periods of series, scaled
+// from their lowest value so that shrinking code still shows its shape
+func growthSparkline(series *models.GrowthSeries) string {
+	points := series.Points
+	if len(points) > 26 {
+		points = points[len(points)-26:]
+	}
+
+	lowest := points[0].Total
+	for _, point := range points {
+		lowest = min(lowest, point.Total)
+	}
+	values := make([]int, len(points))
+	for i, point := range points {
+		values[i] = point.Total - lowest
+	}
+	return Sparkline(values)
+}
+
+// writePunchCard writes a punch card sized to the panel with its busiest hour
+func (dpw *DetailPanelWidget) writePunchCard(content *strings.Builder, card *models.PunchCard) {
+	_, _, width, _ := dpw.GetInnerRect()
+	if width <= 0 {
+		width = 40 // not drawn yet: assume the side panel's usual width
+	}
+	renderer := NewPunchCardRenderer(models.RenderConfig{Width: width})
+	renderer.SetColorOptions(false)
+
+	cardOutput, err := renderer.RenderPunchCard(card)
+	if err != nil {
+		return
+	}
+	content.WriteString(EscapeTags(cardOutput))
+
+	weekday, hour := card.Peak()
+	content.WriteString(fmt.Sprintf("Busiest: %s %02d:00 (%d)\n", weekday.String()[:3], hour, card[weekday][hour]))
 }
 
 // updateContributorsDetails updates content for contributors view
@@ -585,17 +938,68 @@ func (dpw *DetailPanelWidget) updateContributorsDetails(content *strings.Builder
 
 	content.WriteString(fmt.Sprintf("[yellow]Total Contributors:[white] %d\n\n", len(dpw.State.Data.Contributors)))
 
+	selected := dpw.State.SelectedContributor
+	if selected >= 0 && selected < len(dpw.State.Data.Contributors) {
+		dpw.writeContributorProfile(content, dpw.State.Data.Contributors[selected])
+	}
+
+	if marked := len(dpw.State.MarkedContributors); marked > 0 {
+		content.WriteString(fmt.Sprintf("[green]%d marked for comparison[white]\n\n", marked))
+	}
+
+	// Show ten contributors, scrolled so the selected one is visible
+	first := 0
+	if selected >= 10 {
+		first = selected - 9
+	}
 	for i, contributor := range dpw.State.Data.Contributors {
-		if i >= 10 { // Limit to top 10 for display
+		if i < first {
+			continue
+		}
+		if i >= first+10 {
 			break
 		}
-		content.WriteString(fmt.Sprintf("[cyan]%s[white]\n", contributor.Name))
+		marker := ""
+		if i == selected {
+			marker = "[blue]>[white] "
+		}
+		if dpw.State.IsMarked(contributor) {
+			marker += "[green]*[white] "
+		}
+		content.WriteString(fmt.Sprintf("%s[cyan]%s[white]\n", marker, EscapeTags(contributor.Name)))
 		content.WriteString(fmt.Sprintf("  Commits: %d\n", contributor.TotalCommits))
 		content.WriteString(fmt.Sprintf("  Lines: +%d/-%d\n", contributor.TotalInsertions, contributor.TotalDeletions))
 		content.WriteString("\n")
 	}
 }
 
+// writeContributorProfile writes the profile block for a selected contributor
+func (dpw *DetailPanelWidget) writeContributorProfile(content *strings.Builder, contributor models.Contributor) {
+	content.WriteString(fmt.Sprintf("[cyan::b]Profile: %s[-:-:-]\n", EscapeTags(contributor.Name)))
+	content.WriteString(fmt.Sprintf("  Email: %s\n", EscapeTags(contributor.Email)))
+	content.WriteString(fmt.Sprintf("  Commits: %d\n", contributor.TotalCommits))
+	content.WriteString(fmt.Sprintf("  Lines: [green]+%d[white]/[red]-%d[white]\n", contributor.TotalInsertions, contributor.TotalDeletions))
+	content.WriteString(fmt.Sprintf("  Active Days: %d\n", contributor.ActiveDays))
+	if !contributor.FirstCommit.IsZero() {
+		content.WriteString(fmt.Sprintf("  First Commit: %s\n", contributor.FirstCommit.Format("2006-01-02")))
+	}
+	if !contributor.LastCommit.IsZero() {
+		content.WriteString(fmt.Sprintf("  Last Commit: %s\n", contributor.LastCommit.Format("2006-01-02")))
+	}
+	if len(contributor.TopFiles) > 0 {
+		content.WriteString(fmt.Sprintf("  Top Files: %s\n", EscapeTags(strings.Join(contributor.TopFiles, ", "))))
+	}
+	if len(contributor.CommitsByDay) > 0 {
+		weeks := contributor.WeeklyCommits(latestCommit(dpw.State.Data.Contributors), 12)
+		content.WriteString(fmt.Sprintf("  Last 12 Weeks: [green]%s[white]\n", Sparkline(weeks)))
+	}
+	if contributor.PunchCard.Total() > 0 {
+		content.WriteString("  Punch Card:\n")
+		dpw.writePunchCard(content, &contributor.PunchCard)
+	}
+	content.WriteString("\n")
+}
+
 // updateHealthDetails updates content for health view
 func (dpw *DetailPanelWidget) updateHealthDetails(content *strings.Builder) {
 	if dpw.State.Data.HealthMetrics == nil {
@@ -610,6 +1014,20 @@ func (dpw *DetailPanelWidget) updateHealthDetails(content *strings.Builder) {
 	content.WriteString(fmt.Sprintf("[yellow]Active Contributors:[white] %d\n", health.ActiveContributors))
 	content.WriteString(fmt.Sprintf("[yellow]Branch Count:[white] %d\n", health.BranchCount))
 	content.WriteString(fmt.Sprintf("[yellow]Activity Trend:[white] %s\n", health.ActivityTrend))
+
+	if len(health.MonthlyGrowth) > 1 {
+		months := fillMonths(health.MonthlyGrowth)
+		commits := make([]int, len(months))
+		authors := make([]int, len(months))
+		for i, month := range months {
+			commits[i] = month.Commits
+			authors[i] = month.Authors
+		}
+		first, last := months[0].Month, months[len(months)-1].Month
+		content.WriteString(fmt.Sprintf("\n[yellow]Monthly Activity[white] (%s to %s)\n", first.Format("2006-01"), last.Format("2006-01")))
+		content.WriteString(fmt.Sprintf("  Commits: [green]%s[white]\n", Sparkline(commits)))
+		content.WriteString(fmt.Sprintf("  Authors: [cyan]%s[white]\n", Sparkline(authors)))
+	}
 }
 
 // StatusBarWidget displays status information and keyboard shortcuts with enhanced functionality
@@ -619,6 +1037,7 @@ type StatusBarWidget struct {
 	Commands      []KeyCommand
 	ShowShortcuts bool
 	HelpText      string
+	KeyMap        *KeyMap
 }
 
 // NewStatusBarWidget creates a new status bar widget with enhanced functionality
@@ -633,24 +1052,51 @@ func NewStatusBarWidget(state *GUIState) *StatusBarWidget {
 	widget.SetDynamicColors(true)
 	widget.SetTextAlign(tview.AlignLeft)
 
+	widget.SetKeyMap(DefaultKeyMap())
+
+	return widget
+}
+
+// SetKeyMap sets the key bindings shown as shortcuts
+func (sbw *StatusBarWidget) SetKeyMap(keyMap *KeyMap) {
+	sbw.KeyMap = keyMap
+	hint := func(action GUIAction) KeyCommand { return keyCommandFor(keyMap.Binding(action)) }
+
 	// Define comprehensive key commands
-	widget.Commands = []KeyCommand{
-		{Key: tcell.KeyRune, Rune: 'c', Description: "[C]ontrib"},
-		{Key: tcell.KeyRune, Rune: 's', Description: "[S]tats"},
-		{Key: tcell.KeyRune, Rune: 't', Description: "[T]eam"},
-		{Key: tcell.KeyRune, Rune: 'H', Description: "[H]ealth"},
+	sbw.Commands = []KeyCommand{
+		hint(ActionContribView),
+		hint(ActionStatsView),
+		hint(ActionTeamView),
+		hint(ActionHealthView),
+		hint(ActionFilesView),
+		hint(ActionGraphView),
 		{Key: tcell.KeyLeft, Description: "← Day"},
 		{Key: tcell.KeyRight, Description: "→ Day"},
 		{Key: tcell.KeyUp, Description: "↑ Week"},
 		{Key: tcell.KeyDown, Description: "↓ Week"},
-		{Key: tcell.KeyRune, Rune: 'h', Description: "h/l Month"},
-		{Key: tcell.KeyRune, Rune: 'd', Description: "[D]etails"},
+		{Key: tcell.KeyRune, Rune: ',', Description: ",/. Month"},
+		hint(ActionToggleDetails),
 		{Key: tcell.KeyRune, Rune: 'j', Description: "j/k Scroll"},
-		{Key: tcell.KeyRune, Rune: 'q', Description: "[Q]uit"},
-		{Key: tcell.KeyRune, Rune: '?', Description: "[?] Help"},
+		hint(ActionQuit),
+		hint(ActionHelp),
 	}
+}
 
-	return widget
+// keyCommandFor converts a key binding into a status bar command
+func keyCommandFor(binding KeyBinding) KeyCommand {
+	command := KeyCommand{Key: tcell.KeyRune, Description: fmt.Sprintf("%s %s", binding.Key, binding.Hint)}
+	if r, size := utf8.DecodeRuneInString(binding.Key); size == len(binding.Key) {
+		command.Rune = r
+		return command
+	}
+
+	for key, name := range tcell.KeyNames {
+		if strings.EqualFold(name, binding.Key) {
+			command.Key = key
+			break
+		}
+	}
+	return command
 }
 
 // GetText returns the text content (for testing)
@@ -673,13 +1119,18 @@ func (sbw *StatusBarWidget) UpdateStatus() {
 	// Add current view
 	content.WriteString(fmt.Sprintf("View: [cyan]%s[white]", sbw.State.CurrentView.String()))
 
+	if sbw.State.FilterSummary != "" {
+		content.WriteString(fmt.Sprintf(" | [purple]%s[white]", EscapeTags(sbw.State.FilterSummary)))
+	}
+
 	// Add view-specific information
 	switch sbw.State.CurrentView {
 	case ContributionView:
 		if sbw.State.Data != nil && sbw.State.Data.ContribGraph != nil {
 			selectedDate := sbw.State.SelectedDate.Format("2006-01-02")
-			commits := sbw.State.Data.ContribGraph.DailyCommits[selectedDate]
-			content.WriteString(fmt.Sprintf(" | [green]%s: %d commits[white]", selectedDate, commits))
+			graph := sbw.State.Data.ContribGraph
+			content.WriteString(fmt.Sprintf(" | [green]%s: %d %s[white]",
+				selectedDate, graph.DailyValues[selectedDate], models.MetricLabel(graph.Metric)))
 		}
 	case ContributorsView:
 		if sbw.State.Data != nil {
@@ -693,6 +1144,17 @@ func (sbw *StatusBarWidget) UpdateStatus() {
 		if sbw.State.Data != nil && sbw.State.Data.HealthMetrics != nil {
 			content.WriteString(fmt.Sprintf(" | [green]%s trend[white]", sbw.State.Data.HealthMetrics.ActivityTrend))
 		}
+	case FilesView:
+		path := sbw.State.SelectedPath
+		if path == "" {
+			path = "/"
+		}
+		content.WriteString(fmt.Sprintf(" | [green]%s[white]", EscapeTags(path)))
+	case GraphView:
+		if sbw.State.Data != nil && sbw.State.Data.CommitGraph != nil {
+			content.WriteString(fmt.Sprintf(" | [green]%d commits, %d lanes[white]",
+				len(sbw.State.Data.CommitGraph.Rows), sbw.State.Data.CommitGraph.MaxLanes))
+		}
 	}
 
 	// Add keyboard shortcuts if enabled
@@ -703,7 +1165,7 @@ func (sbw *StatusBarWidget) UpdateStatus() {
 			if i > 0 {
 				content.WriteString(" ")
 			}
-			content.WriteString(fmt.Sprintf("[gray]%s[white]", cmd.Description))
+			content.WriteString(fmt.Sprintf("[gray]%s[white]", tview.Escape(cmd.Description)))
 		}
 	} else {
 		content.WriteString(fmt.Sprintf(" | [gray]%s[white]", sbw.HelpText))
@@ -714,12 +1176,18 @@ func (sbw *StatusBarWidget) UpdateStatus() {
 
 // getRelevantCommands returns commands relevant to the current view
 func (sbw *StatusBarWidget) getRelevantCommands() []KeyCommand {
+	hint := func(action GUIAction) KeyCommand { return keyCommandFor(sbw.KeyMap.Binding(action)) }
+
 	baseCommands := []KeyCommand{
-		{Key: tcell.KeyRune, Rune: 'c', Description: "[C]ontrib"},
-		{Key: tcell.KeyRune, Rune: 's', Description: "[S]tats"},
-		{Key: tcell.KeyRune, Rune: 't', Description: "[T]eam"},
-		{Key: tcell.KeyRune, Rune: 'H', Description: "[H]ealth"},
+		hint(ActionContribView),
+		hint(ActionStatsView),
+		hint(ActionTeamView),
+		hint(ActionHealthView),
+		hint(ActionFilesView),
+		hint(ActionGraphView),
 		{Key: tcell.KeyTab, Description: "Tab"},
+		hint(ActionSearch),
+		hint(ActionFilter),
 	}
 
 	switch sbw.State.CurrentView {
@@ -727,12 +1195,32 @@ func (sbw *StatusBarWidget) getRelevantCommands() []KeyCommand {
 		return append(baseCommands, []KeyCommand{
 			{Key: tcell.KeyLeft, Description: "←→ Days"},
 			{Key: tcell.KeyUp, Description: "↑↓ Weeks"},
-			{Key: tcell.KeyRune, Rune: 'h', Description: "h/l Months"},
-			{Key: tcell.KeyRune, Rune: 'H', Description: "H/L Years"},
+			{Key: tcell.KeyRune, Rune: ',', Description: ",/. Months"},
 			{Key: tcell.KeyRune, Rune: 'g', Description: "g Today"},
-			{Key: tcell.KeyRune, Rune: 'd', Description: "[D]etails"},
+			hint(ActionToggleDetails),
+			{Key: tcell.KeyEnter, Description: "⏎ Open commit"},
 		}...)
-	case StatisticsView, ContributorsView, HealthView:
+	case ContributorsView:
+		return append(baseCommands, []KeyCommand{
+			{Key: tcell.KeyRune, Rune: '[', Description: "[ ] Select"},
+			hint(ActionMarkContributor),
+			hint(ActionCompare),
+			{Key: tcell.KeyRune, Rune: 'j', Description: "j/k Scroll"},
+		}...)
+	case FilesView:
+		return append(baseCommands, []KeyCommand{
+			{Key: tcell.KeyUp, Description: "↑↓ Move"},
+			{Key: tcell.KeyRight, Description: "→← Expand/Collapse"},
+			{Key: tcell.KeyEnter, Description: "⏎ Filter to subtree"},
+			hint(ActionSortTree),
+		}...)
+	case GraphView:
+		return append(baseCommands, []KeyCommand{
+			{Key: tcell.KeyUp, Description: "↑↓ Move"},
+			{Key: tcell.KeyRight, Description: "→← Expand/Collapse"},
+			{Key: tcell.KeyEnter, Description: "⏎ Open commit"},
+		}...)
+	case StatisticsView, HealthView:
 		return append(baseCommands, []KeyCommand{
 			{Key: tcell.KeyRune, Rune: 'j', Description: "j/k Scroll"},
 			{Key: tcell.KeyUp, Description: "↑↓ Scroll"},
@@ -740,9 +1228,9 @@ func (sbw *StatusBarWidget) getRelevantCommands() []KeyCommand {
 	}
 
 	return append(baseCommands, []KeyCommand{
-		{Key: tcell.KeyRune, Rune: 'r', Description: "[R]efresh"},
-		{Key: tcell.KeyRune, Rune: 'q', Description: "[Q]uit"},
-		{Key: tcell.KeyRune, Rune: '?', Description: "[?] Help"},
+		hint(ActionRefresh),
+		hint(ActionQuit),
+		hint(ActionHelp),
 	}...)
 }
 
@@ -759,19 +1247,49 @@ func (sbw *StatusBarWidget) GetRelevantCommands() []KeyCommand {
 
 // GUIInterface implements the main GUI interface
 type GUIInterface struct {
-	app              *tview.Application
-	state            *GUIState
-	layout           *tview.Flex
+	app               *tview.Application
+	state             *GUIState
+	layout            *tview.Flex
+	panes             *tview.Flex
+	leftPane          tview.Primitive // the contribution graph, or the file tree or commit graph in their views
+	viewTabs          *ViewTabsWidget
+	splitter          *SplitterWidget
 	contributionGraph *ContributionGraphWidget
-	detailPanel      *DetailPanelWidget
-	statusBar        *StatusBarWidget
-	helpModal        *tview.Modal
+	fileTree          *FileTreeWidget
+	commitGraph       *CommitGraphWidget
+	detailPanel       *DetailPanelWidget
+	statusBar         *StatusBarWidget
+	helpModal         *tview.Modal
+	commitSource      CommitSource
+	commitView        *tview.TextView
+	commitLayout      *tview.Flex
+	commitStatus      *tview.TextView
+	showingCommit     bool
+	comparisonView    *tview.TextView
+	comparisonLayout  *tview.Flex
+	showingComparison bool
+	compareHandler    GUICompareFunc
+	exportHandler     GUIExportFunc
+	screen            tcell.Screen // last drawn screen, captured by screenshot exports
+	filterHandler     GUIFilterFunc
+	refreshHandler    GUIFilterFunc
+	historyHandler    GUIHistoryFunc
+	paneSizeHandler   GUIPaneSizeFunc
+	filter            GUIFilter
+	analysisBusy      bool
+	pendingAnalysis   *GUIAnalysisRequest
+	options           GUIOptions
+	stopRefresh       chan struct{}
+	searchInput       *tview.InputField
+	searchLayout      *tview.Flex
+	editingFilter     bool
 }
 
 // NewGUIInterface creates a new GUI interface
 func NewGUIInterface() *GUIInterface {
 	return &GUIInterface{
-		app: tview.NewApplication(),
+		app:     tview.NewApplication(),
+		options: GUIOptions{KeyMap: DefaultKeyMap(), GraphPaneSize: DefaultGraphPaneSize},
 	}
 }
 
@@ -781,68 +1299,338 @@ func (gui *GUIInterface) Initialize() error {
 	return nil
 }
 
+// SetCommitSource sets the source used to list a day's commits and open their diffs
+func (gui *GUIInterface) SetCommitSource(source CommitSource) {
+	gui.commitSource = source
+}
+
+// SetFilterHandler sets the function used to re-analyze the loaded commits when filters change
+func (gui *GUIInterface) SetFilterHandler(handler GUIFilterFunc) {
+	gui.filterHandler = handler
+}
+
+// SetRefreshHandler sets the function used to re-read the repository and re-apply the active filter
+func (gui *GUIInterface) SetRefreshHandler(handler GUIFilterFunc) {
+	gui.refreshHandler = handler
+}
+
+// SetHistoryHandler sets the function used to load older commits when the contribution graph
+// pages past the loaded history
+func (gui *GUIInterface) SetHistoryHandler(handler GUIHistoryFunc) {
+	gui.historyHandler = handler
+}
+
+// SetCompareHandler sets the function used to compare the marked contributors
+func (gui *GUIInterface) SetCompareHandler(handler GUICompareFunc) {
+	gui.compareHandler = handler
+}
+
+// SetExportHandler sets the function used to write exports of the current view
+func (gui *GUIInterface) SetExportHandler(handler GUIExportFunc) {
+	gui.exportHandler = handler
+}
+
+// SetPaneSizeHandler sets the function used to persist the pane width after it is dragged
+func (gui *GUIInterface) SetPaneSizeHandler(handler GUIPaneSizeFunc) {
+	gui.paneSizeHandler = handler
+}
+
+// SetOptions sets the key bindings, default view and refresh behavior
+func (gui *GUIInterface) SetOptions(options GUIOptions) {
+	if options.KeyMap == nil {
+		options.KeyMap = DefaultKeyMap()
+	}
+	if options.GraphPaneSize == 0 {
+		options.GraphPaneSize = DefaultGraphPaneSize
+	}
+	gui.options = options
+}
+
 // Run starts the GUI with the provided data
 func (gui *GUIInterface) Run(data *models.AnalysisResult) error {
+	gui.buildLayout(data)
+	gui.showMainLayout()
+	defer gui.stopAutoRefresh()
+
+	// Update initial content synchronously before starting the app
+	gui.updateDisplayContent()
+
+	return gui.app.Run()
+}
+
+// RunWithLoader shows a loading screen while load runs, then switches to the main layout
+func (gui *GUIInterface) RunWithLoader(load GUILoadFunc) error {
+	loading := tview.NewTextView().
+		SetTextAlign(tview.AlignCenter).
+		SetDynamicColors(true)
+	loading.SetBorder(true).SetTitle(" Git Stats ")
+
+	setStage := func(stage string) {
+		loading.SetText(fmt.Sprintf("\n\n[yellow]Analyzing repository...[white]\n\n%s\n\n[gray]Press Ctrl+C to cancel", stage))
+	}
+	setStage("Starting...")
+
+	var loadErr error
+	go func() {
+		data, err := load(func(stage string) {
+			gui.app.QueueUpdateDraw(func() { setStage(stage) })
+		})
+
+		gui.app.QueueUpdateDraw(func() {
+			if err != nil {
+				loadErr = err
+				gui.app.Stop()
+				return
+			}
+
+			gui.buildLayout(data)
+			gui.showMainLayout()
+			gui.updateDisplayContent()
+		})
+	}()
+
+	gui.app.SetRoot(loading, true)
+	defer gui.stopAutoRefresh()
+	if err := gui.app.Run(); err != nil {
+		return err
+	}
+
+	return loadErr
+}
+
+// showMainLayout shows the main layout, or the help modal when configured, and starts auto-refresh
+func (gui *GUIInterface) showMainLayout() {
+	gui.app.SetRoot(gui.layout, true)
+	if gui.options.ShowHelp {
+		gui.state.ToggleHelp()
+		gui.app.SetRoot(gui.helpModal, true)
+	}
+	gui.startAutoRefresh()
+}
+
+// startAutoRefresh periodically re-reads the repository when a refresh interval is configured
+func (gui *GUIInterface) startAutoRefresh() {
+	if gui.options.RefreshInterval <= 0 || gui.refreshHandler == nil || gui.stopRefresh != nil {
+		return
+	}
+
+	stop := make(chan struct{})
+	gui.stopRefresh = stop
+	ticker := time.NewTicker(gui.options.RefreshInterval)
+
+	go func() {
+		defer ticker.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-ticker.C:
+				gui.app.QueueUpdateDraw(func() {
+					// Skip this tick while a filter or refresh is still running
+					if !gui.analysisBusy {
+						gui.refresh(false)
+					}
+				})
+			}
+		}
+	}()
+}
+
+// stopAutoRefresh stops the auto-refresh ticker
+func (gui *GUIInterface) stopAutoRefresh() {
+	if gui.stopRefresh != nil {
+		close(gui.stopRefresh)
+		gui.stopRefresh = nil
+	}
+}
+
+// buildLayout creates the widgets and main layout for data
+func (gui *GUIInterface) buildLayout(data *models.AnalysisResult) {
 	// Initialize state
 	gui.state = NewGUIState(data)
+	gui.state.Source = gui.commitSource
+	gui.state.CurrentView = gui.options.DefaultView
 
 	// Create widgets
 	gui.contributionGraph = NewContributionGraphWidget(data.ContribGraph, gui.state)
+	gui.contributionGraph.Theme = gui.options.Theme
+	gui.contributionGraph.OnChange = gui.updateDisplay
+	gui.contributionGraph.OnHistory = gui.loadHistory
+	gui.fileTree = NewFileTreeWidget(gui.state)
+	gui.fileTree.OnChange = gui.updateDisplay
+	gui.fileTree.OnSelect = gui.filterToPath
+	gui.fileTree.Rebuild()
+	gui.commitGraph = NewCommitGraphWidget(gui.state)
+	gui.commitGraph.OnChange = gui.updateDisplay
+	gui.commitGraph.OnSelect = gui.openGraphCommit
+	gui.commitGraph.MinRun = gui.options.GraphCollapseRun
+	gui.commitGraph.ASCII = gui.options.GraphASCII
+	gui.commitGraph.Rebuild()
 	gui.detailPanel = NewDetailPanelWidget(gui.state, "Details")
 	gui.statusBar = NewStatusBarWidget(gui.state)
+	gui.statusBar.SetKeyMap(gui.options.KeyMap)
+	gui.viewTabs = NewViewTabsWidget(gui.state)
+	gui.viewTabs.OnSelect = func(view ViewType) {
+		gui.state.SwitchView(view)
+		gui.updateDisplay()
+	}
+	gui.splitter = NewSplitterWidget()
+	gui.splitter.OnDrag = gui.resizePanes
+	gui.splitter.OnDragEnd = gui.savePaneSize
 
 	// Create help modal
 	gui.helpModal = tview.NewModal().
-		SetText("Git Stats - Keyboard Shortcuts\n\n" +
-			"Navigation (Contribution View):\n" +
-			"  ←→ : Navigate days\n" +
-			"  ↑↓ : Navigate weeks\n" +
-			"  j/k : Navigate weeks\n" +
-			"  h/l : Navigate months\n" +
-			"  H/L : Navigate years\n" +
-			"  Ctrl+←→ : Navigate months\n" +
-			"  Ctrl+↑↓ : Navigate years\n" +
-			"  g : Go to today\n" +
-			"  G : Go to first commit\n\n" +
-			"View Switching:\n" +
-			"  c/1/F1 : Contribution view\n" +
-			"  s/2/F2 : Statistics view\n" +
-			"  t/3/F3 : Team/Contributors view\n" +
-			"  H/4/F4 : Health metrics view\n" +
-			"  Tab : Cycle views forward\n" +
-			"  Shift+Tab : Cycle views backward\n\n" +
-			"Other:\n" +
-			"  d : Toggle details\n" +
-			"  r : Refresh display\n" +
-			"  ? : Toggle this help\n" +
-			"  q/ESC : Quit").
+		SetText(gui.helpText()).
 		AddButtons([]string{"Close"}).
 		SetDoneFunc(func(buttonIndex int, buttonLabel string) {
 			gui.state.ToggleHelp()
 			gui.app.SetRoot(gui.layout, true)
 		})
 
-	// Create main layout
+	// Create main layout; the splitter between the panes sets their relative widths
+	gui.panes = tview.NewFlex().SetDirection(tview.FlexColumn)
+	gui.leftPane = nil
+	gui.syncPanes()
 	gui.layout = tview.NewFlex().SetDirection(tview.FlexRow).
-		AddItem(tview.NewFlex().SetDirection(tview.FlexColumn).
-			AddItem(gui.contributionGraph, 0, 2, true).
-			AddItem(gui.detailPanel, 0, 1, false), 0, 1, true).
+		AddItem(gui.viewTabs, 1, 0, false).
+		AddItem(gui.panes, 0, 1, true).
 		AddItem(gui.statusBar, 1, 0, false)
 
+	// Create commit drill-down layout
+	gui.commitView = tview.NewTextView().
+		SetDynamicColors(true).
+		SetScrollable(true).
+		SetWrap(false)
+	gui.commitView.SetBorder(true).SetTitle("Commit")
+	gui.commitStatus = tview.NewTextView().SetDynamicColors(true)
+	gui.commitLayout = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(gui.commitView, 0, 1, true).
+		AddItem(gui.commitStatus, 1, 0, false)
+
+	// Create contributor comparison layout; the report is plain text, so names need no escaping
+	gui.comparisonView = tview.NewTextView().
+		SetScrollable(true).
+		SetWrap(false)
+	gui.comparisonView.SetBorder(true).SetTitle("Compare Contributors")
+	gui.comparisonLayout = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(gui.comparisonView, 0, 1, true).
+		AddItem(tview.NewTextView().SetDynamicColors(true).
+			SetText("[gray]j/k ↑↓ PgUp/PgDn Scroll | ←→ Pan | q/ESC Back[white]"), 1, 0, false)
+
+	// Create search bar shown below the main layout
+	gui.searchInput = tview.NewInputField().
+		SetLabel("Search messages: ").
+		SetFieldWidth(0)
+	gui.searchInput.SetDoneFunc(func(key tcell.Key) {
+		switch key {
+		case tcell.KeyEnter:
+			filter := gui.filter
+			filter.Message = strings.TrimSpace(gui.searchInput.GetText())
+			gui.applyFilter(filter)
+		case tcell.KeyEscape:
+			gui.closeFilterEditor()
+		}
+	})
+	gui.searchLayout = tview.NewFlex().SetDirection(tview.FlexRow).
+		AddItem(gui.layout, 0, 1, false).
+		AddItem(gui.searchInput, 1, 0, true)
+
 	// Set up input handling
 	gui.app.SetInputCapture(gui.handleGlobalInput)
+	gui.app.EnableMouse(true).SetMouseCapture(gui.handleMouse)
+	gui.app.SetBeforeDrawFunc(func(screen tcell.Screen) bool {
+		gui.screen = screen
+		return false
+	})
+}
 
-	// Set root and run
-	gui.app.SetRoot(gui.layout, true)
+// helpText returns the help modal text for the configured key bindings
+func (gui *GUIInterface) helpText() string {
+	key := gui.options.KeyMap.Key
 
-	// Update initial content synchronously before starting the app
-	gui.updateDisplayContent()
+	refresh := "Refresh (re-read repository)"
+	if gui.options.RefreshInterval > 0 {
+		refresh += fmt.Sprintf(", automatic every %s", gui.options.RefreshInterval)
+	}
 
-	return gui.app.Run()
+	return "Git Stats - Keyboard Shortcuts\n\n" +
+		"Navigation (Contribution View):\n" +
+		"  ←→ : Navigate days\n" +
+		"  ↑↓ : Navigate weeks\n" +
+		"  j/k : Navigate weeks\n" +
+		"  ,/. : Navigate months\n" +
+		"  </> : Navigate years\n" +
+		"  Ctrl+←→ : Navigate months\n" +
+		"  Ctrl+↑↓ : Navigate years\n" +
+		"  g : Go to today\n" +
+		"  G : Go to first commit\n" +
+		"  Older history loads when the view moves past it\n" +
+		"  [ ] : Select commit of the day\n" +
+		"  Enter : Open commit (diff view)\n\n" +
+		"Commit View:\n" +
+		"  j/k/↑↓/PgUp/PgDn : Scroll diff\n" +
+		"  [ ] or p/n : Previous/next commit\n" +
+		"  a : Author profile\n" +
+		"  q/ESC/Backspace : Back\n\n" +
+		"Contributors View:\n" +
+		"  [ ] : Select previous/next contributor\n" +
+		fmt.Sprintf("  %s : Mark/unmark contributor for comparison\n", key(ActionMarkContributor)) +
+		fmt.Sprintf("  %s : Compare marked contributors side by side\n\n", key(ActionCompare)) +
+		"Files View:\n" +
+		"  ↑↓ j/k : Move between files and directories\n" +
+		"  →/← : Expand/collapse directory (← on a file goes to its directory)\n" +
+		"  Space : Toggle directory\n" +
+		"  Enter : Filter the other views to the subtree (root clears it)\n" +
+		fmt.Sprintf("  %s : Sort by name, commits, churn, authors or last modified\n\n", key(ActionSortTree)) +
+		"Graph View:\n" +
+		"  ↑↓ j/k PgUp/PgDn g/G : Move between commits\n" +
+		"  Enter : Open commit (diff view), or expand a collapsed run\n" +
+		"  →/← : Expand the run / collapse the linear commits around the commit\n\n" +
+		"Filtering:\n" +
+		fmt.Sprintf("  %s : Search commit messages\n", key(ActionSearch)) +
+		fmt.Sprintf("  %s : Filter form (author, message, dates, paths, size)\n", key(ActionFilter)) +
+		fmt.Sprintf("  %s : Clear all filters\n\n", key(ActionClearFilter)) +
+		"View Switching:\n" +
+		fmt.Sprintf("  %s/1/F1 : Contribution view\n", key(ActionContribView)) +
+		fmt.Sprintf("  %s/2/F2 : Statistics view\n", key(ActionStatsView)) +
+		fmt.Sprintf("  %s/3/F3 : Team/Contributors view\n", key(ActionTeamView)) +
+		fmt.Sprintf("  %s/4/F4 : Health metrics view\n", key(ActionHealthView)) +
+		fmt.Sprintf("  %s/5 : Files view\n", key(ActionFilesView)) +
+		fmt.Sprintf("  %s/6 : Commit graph view\n", key(ActionGraphView)) +
+		"  Tab : Cycle views forward\n" +
+		"  Shift+Tab : Cycle views backward\n\n" +
+		"Mouse:\n" +
+		"  Click day : Select it (hover shows its commits)\n" +
+		"  Wheel : Scroll details, or months over the graph\n" +
+		"  Click tab : Switch view\n" +
+		"  Drag │ : Resize the graph and detail panes\n\n" +
+		"Other:\n" +
+		fmt.Sprintf("  %s : Toggle details\n", key(ActionToggleDetails)) +
+		fmt.Sprintf("  %s : Export the current view (JSON, CSV, Markdown, screenshot)\n", key(ActionExport)) +
+		fmt.Sprintf("  %s : %s\n", key(ActionRefresh), refresh) +
+		fmt.Sprintf("  %s : Toggle this help\n", key(ActionHelp)) +
+		fmt.Sprintf("  %s/ESC : Quit", key(ActionQuit))
 }
 
 // handleGlobalInput handles global keyboard input with enhanced navigation
 func (gui *GUIInterface) handleGlobalInput(event *tcell.EventKey) *tcell.EventKey {
+	if gui.editingFilter {
+		// The search bar and filter form handle their own keys
+		return event
+	}
+	if gui.showingCommit {
+		return gui.handleCommitViewInput(event)
+	}
+	if gui.showingComparison {
+		return gui.handleComparisonInput(event)
+	}
+
+	// Configurable bindings come first; they cannot use the navigation keys handled below
+	if action, ok := gui.options.KeyMap.Action(keyEventName(event)); ok {
+		gui.runAction(action)
+		return nil
+	}
+
 	switch event.Key() {
 	case tcell.KeyEscape:
 		gui.app.Stop()
@@ -879,40 +1667,26 @@ func (gui *GUIInterface) handleGlobalInput(event *tcell.EventKey) *tcell.EventKe
 	}
 
 	switch event.Rune() {
-	case 'q', 'Q':
-		gui.app.Stop()
-		return nil
-	case '?':
-		gui.state.ToggleHelp()
-		if gui.state.ShowHelp {
-			gui.app.SetRoot(gui.helpModal, true)
-		} else {
-			gui.app.SetRoot(gui.layout, true)
-		}
-		return nil
-	case 'c', 'C':
-		gui.state.SwitchView(ContributionView)
+	case '5':
+		gui.state.SwitchView(FilesView)
 		gui.updateDisplay()
 		return nil
-	case 's', 'S':
-		gui.state.SwitchView(StatisticsView)
+	case '6':
+		gui.state.SwitchView(GraphView)
 		gui.updateDisplay()
 		return nil
-	case 't', 'T':
-		gui.state.SwitchView(ContributorsView)
-		gui.updateDisplay()
-		return nil
-	case 'H':
-		gui.state.SwitchView(HealthView)
-		gui.updateDisplay()
-		return nil
-	case 'd', 'D':
-		// Toggle detail panel visibility
-		if gui.detailPanel != nil {
-			gui.detailPanel.ShowDetails = !gui.detailPanel.ShowDetails
-			gui.updateDisplay()
+	}
+	if event.Rune() < '1' || event.Rune() > '4' {
+		// The tree and the commit graph use j/k themselves
+		switch gui.state.CurrentView {
+		case FilesView:
+			return gui.fileTree.HandleInput(event)
+		case GraphView:
+			return gui.commitGraph.HandleInput(event)
 		}
-		return nil
+	}
+
+	switch event.Rune() {
 	case 'j':
 		// Scroll down in detail panel or handle view-specific navigation
 		if gui.state.CurrentView == ContributionView {
@@ -941,11 +1715,6 @@ func (gui *GUIInterface) handleGlobalInput(event *tcell.EventKey) *tcell.EventKe
 			gui.scrollDetailPanel(-1)
 		}
 		return nil
-	case 'r', 'R':
-		// Refresh display
-		gui.updateDisplay()
-		gui.state.StatusMessage = "Display refreshed"
-		return nil
 	case '1':
 		gui.state.SwitchView(ContributionView)
 		gui.updateDisplay()
@@ -967,10 +1736,18 @@ func (gui *GUIInterface) handleGlobalInput(event *tcell.EventKey) *tcell.EventKe
 	// Handle view-specific input
 	switch gui.state.CurrentView {
 	case ContributionView:
+		if gui.handleCommitSelection(event) {
+			return nil
+		}
 		if gui.contributionGraph != nil {
 			return gui.contributionGraph.HandleInput(event)
 		}
-	case StatisticsView, ContributorsView, HealthView:
+	case ContributorsView:
+		if gui.handleContributorSelection(event) {
+			return nil
+		}
+		return gui.handleTextViewInput(event)
+	case StatisticsView, HealthView:
 		// Handle scrolling for text-based views
 		return gui.handleTextViewInput(event)
 	}
@@ -978,9 +1755,420 @@ func (gui *GUIInterface) handleGlobalInput(event *tcell.EventKey) *tcell.EventKe
 	return event
 }
 
+// runAction performs a key-bound action
+func (gui *GUIInterface) runAction(action GUIAction) {
+	switch action {
+	case ActionQuit:
+		gui.app.Stop()
+		return
+	case ActionHelp:
+		gui.state.ToggleHelp()
+		if gui.state.ShowHelp {
+			gui.app.SetRoot(gui.helpModal, true)
+		} else {
+			gui.app.SetRoot(gui.layout, true)
+		}
+		return
+	case ActionContribView:
+		gui.state.SwitchView(ContributionView)
+	case ActionStatsView:
+		gui.state.SwitchView(StatisticsView)
+	case ActionTeamView:
+		gui.state.SwitchView(ContributorsView)
+	case ActionHealthView:
+		gui.state.SwitchView(HealthView)
+	case ActionFilesView:
+		gui.state.SwitchView(FilesView)
+	case ActionGraphView:
+		gui.state.SwitchView(GraphView)
+	case ActionSortTree:
+		if gui.state.CurrentView != FilesView {
+			gui.state.StatusMessage = "Sort the file tree in the files view"
+		} else {
+			gui.fileTree.CycleSort()
+			gui.state.StatusMessage = fmt.Sprintf("Files sorted by %s", gui.fileTree.SortBy)
+		}
+	case ActionToggleDetails:
+		// Toggle detail panel visibility
+		if gui.detailPanel != nil {
+			gui.detailPanel.ShowDetails = !gui.detailPanel.ShowDetails
+		}
+	case ActionSearch:
+		gui.openSearch()
+		return
+	case ActionFilter:
+		gui.openFilterForm()
+		return
+	case ActionClearFilter:
+		if !gui.filter.IsEmpty() {
+			gui.applyFilter(GUIFilter{})
+		}
+		return
+	case ActionRefresh:
+		gui.refresh(true)
+		return
+	case ActionMarkContributor:
+		if gui.state.CurrentView != ContributorsView {
+			gui.state.StatusMessage = "Mark contributors in the contributors view"
+		} else {
+			gui.state.ToggleContributorMark()
+		}
+	case ActionCompare:
+		gui.openComparison()
+		return
+	case ActionExport:
+		gui.openExportForm()
+		return
+	}
+	gui.updateDisplay()
+}
+
+// keyEventName returns the canonical key name of event as used by KeyMap
+func keyEventName(event *tcell.EventKey) string {
+	if event.Key() == tcell.KeyRune {
+		return string(event.Rune())
+	}
+	if name, ok := tcell.KeyNames[event.Key()]; ok {
+		return strings.ToLower(name)
+	}
+	return ""
+}
+
+// handleCommitSelection selects and opens commits of the selected day; it reports whether event was used
+func (gui *GUIInterface) handleCommitSelection(event *tcell.EventKey) bool {
+	if gui.detailPanel == nil {
+		return false
+	}
+
+	count := len(gui.state.SelectedCommits)
+	switch {
+	case event.Key() == tcell.KeyEnter:
+		if count > 0 {
+			gui.openCommit(gui.detailPanel.SelectedCommitIndex)
+		}
+		return true
+	case event.Rune() == ']':
+		if gui.detailPanel.SelectedCommitIndex < count-1 {
+			gui.detailPanel.SelectedCommitIndex++
+			gui.updateDisplay()
+		}
+		return true
+	case event.Rune() == '[':
+		if gui.detailPanel.SelectedCommitIndex > 0 {
+			gui.detailPanel.SelectedCommitIndex--
+			gui.updateDisplay()
+		}
+		return true
+	}
+
+	return false
+}
+
+// openCommit shows the full message, file list and diff of a commit of the selected day
+func (gui *GUIInterface) openCommit(index int) {
+	commits := gui.state.SelectedCommits
+	if index < 0 || index >= len(commits) || gui.state.Source == nil {
+		return
+	}
+
+	gui.detailPanel.SelectedCommitIndex = index
+	commit := commits[index]
+
+	detail, err := gui.state.Source.CommitDetail(commit.Hash)
+	if err != nil {
+		gui.commitView.SetText(fmt.Sprintf("[red]Failed to load commit %s:[white] %s", commit.Hash, EscapeTags(err.Error())))
+	} else {
+		gui.commitView.SetText(FormatCommitDetail(detail, index, len(commits)))
+	}
+	gui.commitView.ScrollToBeginning()
+	gui.commitView.SetTitle(fmt.Sprintf("Commit %s", truncateString(commit.Hash, 12)))
+	gui.commitStatus.SetText("[gray]j/k ↑↓ PgUp/PgDn Scroll | [ ] Prev/Next commit | a Author profile | q/ESC Back[white]")
+
+	gui.showingCommit = true
+	gui.state.StatusMessage = fmt.Sprintf("Opened commit %s", truncateString(commit.Hash, 12))
+	gui.app.SetRoot(gui.commitLayout, true)
+	gui.app.SetFocus(gui.commitView)
+}
+
+// closeCommit returns from the commit view to the main layout
+func (gui *GUIInterface) closeCommit() {
+	gui.showingCommit = false
+	gui.app.SetRoot(gui.layout, true)
+	gui.updateDisplay()
+}
+
+// handleCommitViewInput handles keys while a commit is open; unhandled keys scroll the diff
+func (gui *GUIInterface) handleCommitViewInput(event *tcell.EventKey) *tcell.EventKey {
+	switch event.Key() {
+	case tcell.KeyEscape, tcell.KeyBackspace, tcell.KeyBackspace2:
+		gui.closeCommit()
+		return nil
+	case tcell.KeyCtrlC:
+		gui.app.Stop()
+		return nil
+	}
+
+	index := gui.detailPanel.SelectedCommitIndex
+	switch event.Rune() {
+	case 'q', 'Q':
+		gui.closeCommit()
+		return nil
+	case ']', 'n':
+		if index < len(gui.state.SelectedCommits)-1 {
+			gui.openCommit(index + 1)
+		}
+		return nil
+	case '[', 'p':
+		if index > 0 {
+			gui.openCommit(index - 1)
+		}
+		return nil
+	case 'a':
+		author := gui.state.SelectedCommits[index].Author
+		gui.showingCommit = false
+		gui.app.SetRoot(gui.layout, true)
+		gui.state.SwitchView(ContributorsView)
+		gui.state.SelectContributor(author)
+		gui.updateDisplay()
+		return nil
+	}
+
+	return event
+}
+
+// openSearch shows the message search bar below the main layout
+func (gui *GUIInterface) openSearch() {
+	gui.editingFilter = true
+	gui.searchInput.SetText(gui.filter.Message)
+	gui.app.SetRoot(gui.searchLayout, true)
+	gui.app.SetFocus(gui.searchInput)
+}
+
+// openFilterForm shows the filter form pre-filled with the active filter
+func (gui *GUIInterface) openFilterForm() {
+	f := gui.filter
+	form := tview.NewForm().SetItemPadding(0)
+	form.AddInputField("Author", f.Author, 40, nil, nil).
+		AddInputField("Message", f.Message, 40, nil, nil).
+		AddInputField("Since", f.Since, 28, nil, nil).
+		AddInputField("Until", f.Until, 28, nil, nil).
+		AddInputField("Include paths", strings.Join(f.IncludePaths, ", "), 40, nil, nil).
+		AddInputField("Exclude paths", strings.Join(f.ExcludePaths, ", "), 40, nil, nil).
+		AddInputField("Min insertions", formatBound(f.MinInsertions), 8, tview.InputFieldInteger, nil).
+		AddInputField("Max insertions", formatBound(f.MaxInsertions), 8, tview.InputFieldInteger, nil).
+		AddInputField("Min deletions", formatBound(f.MinDeletions), 8, tview.InputFieldInteger, nil).
+		AddInputField("Max deletions", formatBound(f.MaxDeletions), 8, tview.InputFieldInteger, nil).
+		AddInputField("Min files", formatBound(f.MinFiles), 8, tview.InputFieldInteger, nil).
+		AddInputField("Max files", formatBound(f.MaxFiles), 8, tview.InputFieldInteger, nil)
+
+	form.GetFormItemByLabel("Since").(*tview.InputField).SetPlaceholder("2024-01-31 or 6 months ago")
+	form.GetFormItemByLabel("Include paths").(*tview.InputField).SetPlaceholder("src/, *.go")
+
+	form.AddButton("Apply", func() { gui.applyFilter(readFilterForm(form)) }).
+		AddButton("Clear", func() { gui.applyFilter(GUIFilter{}) }).
+		AddButton("Cancel", gui.closeFilterEditor).
+		SetCancelFunc(gui.closeFilterEditor)
+	form.SetBorder(true).SetTitle(" Filter commits ")
+
+	gui.editingFilter = true
+	gui.app.SetRoot(centerPrimitive(form, 64, 18), true)
+	gui.app.SetFocus(form)
+}
+
+// closeFilterEditor hides the search bar or filter form
+func (gui *GUIInterface) closeFilterEditor() {
+	gui.editingFilter = false
+	gui.app.SetRoot(gui.layout, true)
+	gui.updateDisplay()
+}
+
+// applyFilter re-runs the analysis with filter in the background and updates every view when done
+func (gui *GUIInterface) applyFilter(filter GUIFilter) {
+	gui.closeFilterEditor()
+
+	if gui.filterHandler == nil {
+		gui.state.StatusMessage = "Filtering is not available"
+		gui.updateDisplay()
+		return
+	}
+
+	gui.state.StatusMessage = "Applying filters..."
+	gui.updateDisplay()
+	gui.runAnalysis(GUIAnalysisRequest{Filter: filter})
+}
+
+// refresh re-reads the repository, keeping the active filter and selection; without a refresh
+// handler it only redraws
+func (gui *GUIInterface) refresh(manual bool) {
+	if gui.refreshHandler == nil {
+		gui.state.StatusMessage = "Display refreshed"
+		gui.updateDisplay()
+		return
+	}
+
+	if manual {
+		gui.state.StatusMessage = "Refreshing..."
+		gui.updateDisplay()
+	}
+	gui.runAnalysis(GUIAnalysisRequest{Filter: gui.filter, Reload: true})
+}
+
+// loadHistory loads the commits back to since in the background so the contribution graph can
+// page past the history loaded at startup
+func (gui *GUIInterface) loadHistory(since time.Time) {
+	if gui.historyHandler == nil {
+		gui.state.StatusMessage = "Older history is not loaded"
+		gui.updateDisplay()
+		return
+	}
+
+	gui.state.StatusMessage = fmt.Sprintf("Loading history since %s...", since.Format("Jan 2006"))
+	gui.updateDisplay()
+	gui.runAnalysis(GUIAnalysisRequest{Filter: gui.filter, History: since})
+}
+
+// runAnalysis runs request in the background; requests made while one is running are merged
+// and run afterwards so results are applied in order
+func (gui *GUIInterface) runAnalysis(request GUIAnalysisRequest) {
+	if gui.analysisBusy {
+		if gui.pendingAnalysis == nil {
+			gui.pendingAnalysis = &request
+		} else {
+			merged := gui.pendingAnalysis.Merge(request)
+			gui.pendingAnalysis = &merged
+		}
+		return
+	}
+
+	handler := request.Handler(gui.filterHandler, gui.refreshHandler, gui.historyHandler)
+	gui.analysisBusy = true
+
+	go func() {
+		data, summary, err := handler(request.Filter)
+
+		gui.app.QueueUpdateDraw(func() {
+			gui.analysisBusy = false
+			if err != nil {
+				if request.Reload {
+					gui.state.StatusMessage = fmt.Sprintf("Refresh failed: %v", err)
+				} else if !request.History.IsZero() {
+					gui.state.StatusMessage = fmt.Sprintf("Loading history failed: %v", err)
+				} else {
+					gui.state.StatusMessage = fmt.Sprintf("Filter failed: %v", err)
+				}
+				gui.updateDisplay()
+			} else {
+				gui.showAnalysis(request, data, summary)
+			}
+
+			if next := gui.pendingAnalysis; next != nil {
+				gui.pendingAnalysis = nil
+				gui.runAnalysis(*next)
+			}
+		})
+	}()
+}
+
+// showAnalysis replaces the displayed analysis; a refresh keeps the selected contributor and commit
+func (gui *GUIInterface) showAnalysis(request GUIAnalysisRequest, data *models.AnalysisResult, summary string) {
+	var selectedAuthor *models.Author
+	if index := gui.state.SelectedContributor; index >= 0 && gui.state.Data != nil && index < len(gui.state.Data.Contributors) {
+		contributor := gui.state.Data.Contributors[index]
+		selectedAuthor = &models.Author{Name: contributor.Name, Email: contributor.Email}
+	}
+	selectedHash := ""
+	if index := gui.detailPanel.SelectedCommitIndex; index < len(gui.state.SelectedCommits) {
+		selectedHash = gui.state.SelectedCommits[index].Hash
+	}
+
+	gui.filter = request.Filter
+	gui.state.Data = data
+	gui.state.SelectedContributor = -1
+	gui.state.FilterSummary = ""
+	if !request.Filter.IsEmpty() {
+		gui.state.FilterSummary = summary
+	}
+
+	gui.contributionGraph.Data = data.ContribGraph
+	gui.contributionGraph.updateSelectedCommits()
+	gui.fileTree.Rebuild()
+	gui.commitGraph.Rebuild()
+	gui.detailPanel.SelectedCommitIndex = 0
+
+	switch {
+	case request.Reload || !request.History.IsZero():
+		if selectedAuthor != nil {
+			gui.state.SelectedContributor = FindContributor(data.Contributors, *selectedAuthor)
+		}
+		for i, commit := range gui.state.SelectedCommits {
+			if commit.Hash == selectedHash {
+				gui.detailPanel.SelectedCommitIndex = i
+				break
+			}
+		}
+		gui.state.StatusMessage = fmt.Sprintf("Refreshed at %s", time.Now().Format("15:04:05"))
+		if !request.History.IsZero() {
+			gui.state.StatusMessage = fmt.Sprintf("Loaded history since %s", data.TimeRange.Start.Format("Jan 2006"))
+		}
+	case request.Filter.IsEmpty():
+		gui.state.StatusMessage = "Filters cleared"
+	case data.Summary != nil:
+		gui.state.StatusMessage = fmt.Sprintf("%d matching commits", data.Summary.TotalCommits)
+	}
+	gui.updateDisplay()
+}
+
+// readFilterForm collects the filter values from the filter form
+func readFilterForm(form *tview.Form) GUIFilter {
+	text := func(label string) string {
+		return strings.TrimSpace(form.GetFormItemByLabel(label).(*tview.InputField).GetText())
+	}
+	bound := func(label string) int {
+		value, err := strconv.Atoi(text(label))
+		if err != nil || value < 0 {
+			return 0
+		}
+		return value
+	}
+
+	return GUIFilter{
+		Author:        text("Author"),
+		Message:       text("Message"),
+		Since:         text("Since"),
+		Until:         text("Until"),
+		IncludePaths:  ParseFilterList(text("Include paths")),
+		ExcludePaths:  ParseFilterList(text("Exclude paths")),
+		MinInsertions: bound("Min insertions"),
+		MaxInsertions: bound("Max insertions"),
+		MinDeletions:  bound("Min deletions"),
+		MaxDeletions:  bound("Max deletions"),
+		MinFiles:      bound("Min files"),
+		MaxFiles:      bound("Max files"),
+	}
+}
+
+// formatBound renders an optional size bound, leaving unset bounds empty
+func formatBound(value int) string {
+	if value <= 0 {
+		return ""
+	}
+	return strconv.Itoa(value)
+}
+
+// centerPrimitive centers p in a box of the given size
+func centerPrimitive(p tview.Primitive, width, height int) tview.Primitive {
+	return tview.NewFlex().
+		AddItem(nil, 0, 1, false).
+		AddItem(tview.NewFlex().SetDirection(tview.FlexRow).
+			AddItem(nil, 0, 1, false).
+			AddItem(p, height, 1, true).
+			AddItem(nil, 0, 1, false), width, 1, true).
+		AddItem(nil, 0, 1, false)
+}
+
 // cycleView cycles through the available views
 func (gui *GUIInterface) cycleView(direction int) {
-	views := []ViewType{ContributionView, StatisticsView, ContributorsView, HealthView}
+	views := guiViews
 	currentIndex := 0
 
 	// Find current view index
@@ -1026,12 +2214,13 @@ func (gui *GUIInterface) handleTextViewInput(event *tcell.EventKey) *tcell.Event
 	case tcell.KeyHome:
 		if gui.detailPanel != nil {
 			gui.detailPanel.ScrollPos = 0
+			gui.detailPanel.ScrollToBeginning()
 			gui.updateDisplay()
 		}
 		return nil
 	case tcell.KeyEnd:
 		if gui.detailPanel != nil {
-			gui.detailPanel.ScrollPos = len(gui.detailPanel.Content)
+			gui.detailPanel.ScrollToEnd()
 			gui.updateDisplay()
 		}
 		return nil
@@ -1045,33 +2234,22 @@ func (gui *GUIInterface) scrollDetailPanel(delta int) {
 		return
 	}
 
-	newPos := gui.detailPanel.ScrollPos + delta
-	maxPos := len(gui.detailPanel.Content) - gui.detailPanel.MaxLines
-	if maxPos < 0 {
-		maxPos = 0
-	}
-
-	if newPos < 0 {
-		newPos = 0
-	} else if newPos > maxPos {
-		newPos = maxPos
-	}
-
-	gui.detailPanel.ScrollPos = newPos
+	gui.detailPanel.Scroll(delta)
 	gui.updateDisplay()
 }
 
-// updateDisplay updates all display components
+// updateDisplay updates all display components; tview redraws after each input event,
+// and calling Draw from an input handler would deadlock the application
 func (gui *GUIInterface) updateDisplay() {
+	if gui.panes != nil {
+		gui.syncPanes()
+	}
 	if gui.detailPanel != nil {
 		gui.detailPanel.UpdateContent()
 	}
 	if gui.statusBar != nil {
 		gui.statusBar.UpdateStatus()
 	}
-	if gui.app != nil {
-		gui.app.Draw()
-	}
 }
 
 // updateDisplayContent updates display content without calling Draw (safe for initialization)
@@ -1096,6 +2274,9 @@ func (gui *GUIInterface) HandleInput() error {
 // Render renders the GUI (part of GUIVisualizer interface)
 func (gui *GUIInterface) Render() error {
 	gui.updateDisplay()
+	if gui.app != nil {
+		gui.app.Draw()
+	}
 	return nil
 }
 
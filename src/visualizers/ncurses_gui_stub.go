@@ -20,6 +20,8 @@ const (
 	StatisticsView
 	ContributorsView
 	HealthView
+	FilesView
+	GraphView
 )
 
 // String returns the string representation of ViewType
@@ -33,6 +35,10 @@ func (vt ViewType) String() string {
 		return "Contributors"
 	case HealthView:
 		return "Health"
+	case FilesView:
+		return "Files"
+	case GraphView:
+		return "Graph"
 	default:
 		return "Unknown"
 	}
@@ -48,6 +54,10 @@ type GUIState struct {
 	ShowHelp        bool
 	StatusMessage   string
 	Data            *models.AnalysisResult
+	Source          CommitSource // supplies real commits for drill-down; nil when unavailable
+	FilterSummary   string       // active GUI filters, empty when unfiltered
+	// SelectedContributor is the index into Data.Contributors shown as a profile, or -1
+	SelectedContributor int
 }
 
 // NewGUIState creates a new GUI state with default values
@@ -63,6 +73,8 @@ func NewGUIState(data *models.AnalysisResult) *GUIState {
 		ShowHelp:      false,
 		StatusMessage: "Ready",
 		Data:          data,
+
+		SelectedContributor: -1,
 	}
 }
 
@@ -80,10 +92,11 @@ func (gs *GUIState) SelectDate(date time.Time) {
 	// Update selected commits for the date if contribution graph data is available
 	if gs.Data != nil && gs.Data.ContribGraph != nil {
 		dateStr := date.Format("2006-01-02")
-		if commits, exists := gs.Data.ContribGraph.DailyCommits[dateStr]; exists {
-			gs.StatusMessage = fmt.Sprintf("Selected: %s (%d commits)", dateStr, commits)
+		label := models.MetricLabel(gs.Data.ContribGraph.Metric)
+		if commits, exists := gs.Data.ContribGraph.DailyValues[dateStr]; exists {
+			gs.StatusMessage = fmt.Sprintf("Selected: %s (%d %s)", dateStr, commits, label)
 		} else {
-			gs.StatusMessage = fmt.Sprintf("Selected: %s (0 commits)", dateStr)
+			gs.StatusMessage = fmt.Sprintf("Selected: %s (0 %s)", dateStr, label)
 		}
 	}
 }
@@ -123,6 +136,23 @@ func (gs *GUIState) UpdateSelectedCommits(commits []models.Commit) {
 	gs.SelectedCommits = commits
 }
 
+// SelectContributor selects the contributor matching author and reports whether one was found
+func (gs *GUIState) SelectContributor(author models.Author) bool {
+	if gs.Data == nil {
+		return false
+	}
+
+	index := FindContributor(gs.Data.Contributors, author)
+	if index < 0 {
+		gs.StatusMessage = fmt.Sprintf("No contributor profile for %s", author.Name)
+		return false
+	}
+
+	gs.SelectedContributor = index
+	gs.StatusMessage = fmt.Sprintf("Showing profile: %s", gs.Data.Contributors[index].Name)
+	return true
+}
+
 // ToggleHelp toggles the help display
 func (gs *GUIState) ToggleHelp() {
 	gs.ShowHelp = !gs.ShowHelp
@@ -151,7 +181,36 @@ func (gui *GUIInterface) Initialize() error {
 // Run starts the GUI with the provided data (stub implementation)
 func (gui *GUIInterface) Run(data *models.AnalysisResult) error {
 	gui.state = NewGUIState(data)
-	return fmt.Errorf("GUI mode requires building with -tags gui\n\nTo enable GUI mode:\n1. Run: go build -tags gui -o git-stats .\n2. Then use: ./git-stats -gui /path/to/repo\n\nAlternatively, use terminal mode:\n- git-stats -contrib /path/to/repo\n- git-stats -summary /path/to/repo")
+	return fmt.Errorf("%w\n\nTo enable GUI mode:\n1. Run: go build -tags gui -o git-stats .\n2. Then use: ./git-stats -gui /path/to/repo\n\nAlternatively, use terminal mode:\n- git-stats -contrib /path/to/repo\n- git-stats -summary /path/to/repo", ErrGUIUnavailable)
+}
+
+// SetCommitSource sets the source used for commit drill-down (stub implementation)
+func (gui *GUIInterface) SetCommitSource(source CommitSource) {}
+
+// SetFilterHandler sets the function used to re-analyze commits with GUI filters (stub implementation)
+func (gui *GUIInterface) SetFilterHandler(handler GUIFilterFunc) {}
+
+// SetRefreshHandler sets the function used to re-read the repository (stub implementation)
+func (gui *GUIInterface) SetRefreshHandler(handler GUIFilterFunc) {}
+
+// SetHistoryHandler sets the function used to load older commits (stub implementation)
+func (gui *GUIInterface) SetHistoryHandler(handler GUIHistoryFunc) {}
+
+// SetCompareHandler sets the function used to compare the marked contributors (stub implementation)
+func (gui *GUIInterface) SetCompareHandler(handler GUICompareFunc) {}
+
+// SetExportHandler sets the function used to write exports of the current view (stub implementation)
+func (gui *GUIInterface) SetExportHandler(handler GUIExportFunc) {}
+
+// SetPaneSizeHandler sets the function used to persist the pane width (stub implementation)
+func (gui *GUIInterface) SetPaneSizeHandler(handler GUIPaneSizeFunc) {}
+
+// SetOptions sets the key bindings, default view and refresh behavior (stub implementation)
+func (gui *GUIInterface) SetOptions(options GUIOptions) {}
+
+// RunWithLoader fails before loading since the GUI is not available (stub implementation)
+func (gui *GUIInterface) RunWithLoader(load GUILoadFunc) error {
+	return gui.Run(nil)
 }
 
 // HandleInput processes input events (stub implementation)
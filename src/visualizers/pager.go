@@ -0,0 +1,522 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Keyboard driven terminal pager for interactive tables
+
+package visualizers
+
+import (
+	"bufio"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// PagerKeyCode identifies a key read by the table pager
+type PagerKeyCode int
+
+const (
+	PagerKeyRune PagerKeyCode = iota
+	PagerKeyUp
+	PagerKeyDown
+	PagerKeyLeft
+	PagerKeyRight
+	PagerKeyPageUp
+	PagerKeyPageDown
+	PagerKeyHome
+	PagerKeyEnd
+	PagerKeyEnter
+	PagerKeyEscape
+	PagerKeyBackspace
+	PagerKeyTab
+	PagerKeyInterrupt
+	PagerKeyUnknown
+)
+
+// PagerKey is a key press; Rune holds the character of PagerKeyRune keys
+type PagerKey struct {
+	Code PagerKeyCode
+	Rune rune
+}
+
+// is reports whether the key is the character r
+func (k PagerKey) is(r rune) bool {
+	return k.Code == PagerKeyRune && k.Rune == r
+}
+
+// pagerChromeLines are the lines of the pager screen besides the rows: the title, the header,
+// the separator, the status line and the key help
+const pagerChromeLines = 5
+
+// maxPagerColumnWidth is the widest a column starts out in the pager
+const maxPagerColumnWidth = 40
+
+// pagerHelp lists the pager keys on the last line of the screen
+const pagerHelp = "↑↓ Row  PgUp/PgDn Page  ←→ Column  s Sort  / Filter  +/- Width  y Copy  q Quit"
+
+// pagerViewsHelp is the key help of a pager over several tables
+const pagerViewsHelp = "↑↓ Row  PgUp/PgDn Page  ←→ Column  s Sort  / Filter  +/- Width  y Copy  Tab Table  q Quit"
+
+// Terminal attributes of the pager's selected row and column
+const (
+	reverseVideo    = "\033[7m"
+	reverseVideoOff = "\033[27m"
+)
+
+// PagerView is a table the pager pages through and its title
+type PagerView struct {
+	Table *InteractiveTable
+	Title string
+}
+
+// pagerPosition is where the pager was in a table, restored when Tab returns to it
+type pagerPosition struct {
+	column, firstColumn, offset int
+}
+
+// TablePager pages through an InteractiveTable in the terminal: it sorts by the selected column,
+// filters as you type, resizes the selected column and copies the selected row. Tab switches
+// between the tables of a pager over several
+type TablePager struct {
+	Table  *InteractiveTable
+	Title  string
+	Width  int
+	Height int
+
+	views     []PagerView     // tables the pager switches between, the shown one included
+	positions []pagerPosition // position in each of the views
+	view      int             // index of the shown view
+
+	column      int                        // selected column
+	firstColumn int                        // leftmost column on screen
+	offset      int                        // first row on screen
+	filtering   bool                       // keys go to the filter
+	status      string                     // message of the last key, shown on the status line
+	clipboard   string                     // OSC 52 sequence of a copied row, written with the next screen
+	size        func() (width, height int) // terminal size, read before every screen
+}
+
+// NewTablePager creates a pager over the table with its columns sized to their content
+func NewTablePager(table *InteractiveTable, title string) *TablePager {
+	table.FitColumns(maxPagerColumnWidth)
+	table.CurrentRow = 0
+
+	return &TablePager{
+		Table:     table,
+		Title:     title,
+		Width:     DefaultTerminalWidth,
+		Height:    24,
+		views:     []PagerView{{Table: table, Title: title}},
+		positions: []pagerPosition{{column: table.SortColumn}},
+		column:    table.SortColumn,
+	}
+}
+
+// AddView adds a table that Tab switches to, after the tables already added
+func (tp *TablePager) AddView(table *InteractiveTable, title string) {
+	table.FitColumns(maxPagerColumnWidth)
+	table.CurrentRow = 0
+
+	tp.views = append(tp.views, PagerView{Table: table, Title: title})
+	tp.positions = append(tp.positions, pagerPosition{column: table.SortColumn})
+}
+
+// nextView shows the next table, keeping the position in the one left
+func (tp *TablePager) nextView() {
+	if len(tp.views) < 2 {
+		return
+	}
+
+	tp.positions[tp.view] = pagerPosition{column: tp.column, firstColumn: tp.firstColumn, offset: tp.offset}
+	tp.view = (tp.view + 1) % len(tp.views)
+
+	view, position := tp.views[tp.view], tp.positions[tp.view]
+	tp.Table, tp.Title = view.Table, view.Title
+	tp.column, tp.firstColumn, tp.offset = position.column, position.firstColumn, position.offset
+}
+
+// PagerAvailable reports whether the pager can run: it needs keys from a terminal on stdin and
+// a terminal on stdout to draw on
+func PagerAvailable() bool {
+	return IsTerminal(os.Stdin) && IsTerminal(os.Stdout)
+}
+
+// RunTablePager pages through the tables on the terminal's alternate screen until the user quits,
+// starting with the first; Tab switches to the next
+func RunTablePager(views ...PagerView) error {
+	if len(views) == 0 {
+		return nil
+	}
+
+	fd := int(os.Stdin.Fd())
+	state, err := term.MakeRaw(fd)
+	if err != nil {
+		return fmt.Errorf("failed to put the terminal in raw mode: %w", err)
+	}
+	defer term.Restore(fd, state)
+
+	// Switch to the alternate screen and hide the cursor until the pager quits
+	fmt.Print("\033[?1049h\033[?25l")
+	defer fmt.Print("\033[?25h\033[?1049l")
+
+	pager := NewTablePager(views[0].Table, views[0].Title)
+	for _, view := range views[1:] {
+		pager.AddView(view.Table, view.Title)
+	}
+	pager.size = func() (int, int) {
+		width, height, err := term.GetSize(int(os.Stdout.Fd()))
+		if err != nil {
+			return DefaultTerminalWidth, 24
+		}
+		return width, height
+	}
+
+	return pager.Run(os.Stdin, os.Stdout)
+}
+
+// Run draws the pager on out and applies the keys read from in until the user quits or in ends
+func (tp *TablePager) Run(in io.Reader, out io.Writer) error {
+	reader := bufio.NewReader(in)
+
+	for {
+		if tp.size != nil {
+			tp.Width, tp.Height = tp.size()
+		}
+
+		// Redraw over the previous screen, clearing what is left of each line and below the last
+		screen := "\033[H" + strings.ReplaceAll(tp.Render(), "\n", "\033[K\r\n") + "\033[K\033[J" + tp.clipboard
+		tp.clipboard = ""
+		if _, err := io.WriteString(out, screen); err != nil {
+			return err
+		}
+
+		key, err := readPagerKey(reader)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read key: %w", err)
+		}
+		if tp.HandleKey(key) {
+			return nil
+		}
+	}
+}
+
+// HandleKey applies a key press and reports whether the pager quits
+func (tp *TablePager) HandleKey(key PagerKey) bool {
+	tp.status = ""
+	if key.Code == PagerKeyInterrupt {
+		return true
+	}
+	if tp.filtering {
+		tp.handleFilterKey(key)
+		return false
+	}
+
+	page := tp.pageSize()
+	switch {
+	case key.Code == PagerKeyEscape, key.is('q'):
+		return true
+	case key.Code == PagerKeyUp, key.is('k'):
+		tp.Table.CurrentRow--
+	case key.Code == PagerKeyDown, key.is('j'):
+		tp.Table.CurrentRow++
+	case key.Code == PagerKeyPageUp, key.is('b'):
+		tp.Table.CurrentRow -= page
+		tp.offset -= page
+	case key.Code == PagerKeyPageDown, key.is(' '):
+		tp.Table.CurrentRow += page
+		tp.offset += page
+	case key.Code == PagerKeyHome, key.is('g'):
+		tp.Table.CurrentRow = 0
+	case key.Code == PagerKeyEnd, key.is('G'):
+		tp.Table.CurrentRow = len(tp.Table.VisibleRows()) - 1
+	case key.Code == PagerKeyLeft, key.is('h'):
+		tp.column = max(0, tp.column-1)
+	case key.Code == PagerKeyRight, key.is('l'):
+		tp.column = min(len(tp.Table.Headers)-1, tp.column+1)
+	case key.is('s'):
+		tp.Table.SortBy(tp.column)
+		tp.Table.CurrentRow, tp.offset = 0, 0
+	case key.is('/'), key.is('f'):
+		tp.filtering = true
+	case key.is('+'), key.is('>'):
+		tp.Table.ResizeColumn(tp.column, 2)
+	case key.is('-'), key.is('<'):
+		tp.Table.ResizeColumn(tp.column, -2)
+	case key.is('y'), key.is('c'):
+		tp.copySelectedRow()
+	case key.Code == PagerKeyTab:
+		tp.nextView()
+	}
+
+	tp.scroll()
+	return false
+}
+
+// handleFilterKey edits the filter: typing narrows the rows as you go, Enter keeps the filter and
+// Escape clears it
+func (tp *TablePager) handleFilterKey(key PagerKey) {
+	switch key.Code {
+	case PagerKeyRune:
+		tp.Table.Filter += string(key.Rune)
+	case PagerKeyBackspace:
+		if runes := []rune(tp.Table.Filter); len(runes) > 0 {
+			tp.Table.Filter = string(runes[:len(runes)-1])
+		}
+	case PagerKeyEnter:
+		tp.filtering = false
+		return
+	case PagerKeyEscape:
+		tp.Table.Filter = ""
+		tp.filtering = false
+	default:
+		return
+	}
+
+	tp.Table.CurrentRow, tp.offset = 0, 0
+	tp.scroll()
+}
+
+// SelectedRow returns the cells of the selected row, nil when no row passes the filter
+func (tp *TablePager) SelectedRow() []string {
+	rows := tp.Table.VisibleRows()
+	if tp.Table.CurrentRow < 0 || tp.Table.CurrentRow >= len(rows) {
+		return nil
+	}
+	return rows[tp.Table.CurrentRow]
+}
+
+// copySelectedRow puts the selected row, its cells separated by tabs, on the clipboard with an
+// OSC 52 sequence, which terminals handle without a clipboard tool, even over SSH
+func (tp *TablePager) copySelectedRow() {
+	row := tp.SelectedRow()
+	if row == nil {
+		tp.status = "No row to copy"
+		return
+	}
+
+	tp.clipboard = "\033]52;c;" + base64.StdEncoding.EncodeToString([]byte(strings.Join(row, "\t"))) + "\a"
+	tp.status = fmt.Sprintf("Copied row %d to the clipboard", tp.Table.CurrentRow+1)
+}
+
+// pageSize returns the number of rows on a screen
+func (tp *TablePager) pageSize() int {
+	return max(1, tp.Height-pagerChromeLines)
+}
+
+// scroll keeps the selected row and column in range and on screen
+func (tp *TablePager) scroll() {
+	rows, page := len(tp.Table.VisibleRows()), tp.pageSize()
+
+	tp.Table.CurrentRow = max(0, min(tp.Table.CurrentRow, rows-1))
+	tp.offset = max(0, min(tp.offset, rows-page))
+	if tp.Table.CurrentRow < tp.offset {
+		tp.offset = tp.Table.CurrentRow
+	}
+	if tp.Table.CurrentRow >= tp.offset+page {
+		tp.offset = tp.Table.CurrentRow - page + 1
+	}
+	tp.Table.CurrentPage = tp.offset / page
+
+	// Scroll right until the selected column fits, or is the leftmost column
+	if tp.column < tp.firstColumn {
+		tp.firstColumn = tp.column
+	}
+	for tp.firstColumn < tp.column && tp.columnsWidth(tp.firstColumn, tp.column) > tp.Width {
+		tp.firstColumn++
+	}
+}
+
+// columnsWidth returns the width of the columns from first to last with their separators
+func (tp *TablePager) columnsWidth(first, last int) int {
+	width := (last - first) * 3
+	for i := first; i <= last; i++ {
+		width += tp.Table.widthOf(i)
+	}
+	return width
+}
+
+// Render returns the pager screen: the title, the header with the selected column highlighted,
+// a page of rows with the selected row highlighted, the status line and the key help
+func (tp *TablePager) Render() string {
+	tp.scroll()
+	rows := tp.Table.VisibleRows()
+	page := tp.pageSize()
+
+	var screen strings.Builder
+	title, help := tp.Title, pagerHelp
+	if len(tp.views) > 1 {
+		title += fmt.Sprintf(" (%d of %d)", tp.view+1, len(tp.views))
+		help = pagerViewsHelp
+	}
+	screen.WriteString(ColorBold + truncateRunes(title, tp.Width) + ColorReset + "\n")
+
+	headers := make([]string, len(tp.Table.Headers))
+	for i, header := range tp.Table.Headers {
+		headers[i] = header
+		if tp.Table.Sortable && i == tp.Table.SortColumn && tp.Table.SortAsc {
+			headers[i] += " ↑"
+		} else if tp.Table.Sortable && i == tp.Table.SortColumn {
+			headers[i] += " ↓"
+		}
+	}
+	screen.WriteString(ColorBold + tp.renderCells(headers, tp.column) + ColorReset + "\n")
+	screen.WriteString(ColorDim + strings.Repeat("─", min(tp.Width, tp.columnsWidth(tp.firstColumn, len(tp.Table.Headers)-1))) + ColorReset + "\n")
+
+	for i := tp.offset; i < tp.offset+page; i++ {
+		switch {
+		case i < len(rows) && i == tp.Table.CurrentRow:
+			screen.WriteString(reverseVideo + tp.renderCells(rows[i], -1) + ColorReset)
+		case i < len(rows):
+			screen.WriteString(tp.renderCells(rows[i], -1))
+		case i == 0:
+			screen.WriteString(ColorDim + "No matching rows" + ColorReset)
+		}
+		screen.WriteString("\n")
+	}
+
+	screen.WriteString(tp.statusLine(len(rows)) + "\n")
+	screen.WriteString(ColorDim + truncateRunes(help, tp.Width) + ColorReset)
+
+	return screen.String()
+}
+
+// renderCells lays out the cells from the leftmost column on screen, cutting the line at the
+// pager width; the highlight column is shown in reverse video
+func (tp *TablePager) renderCells(cells []string, highlight int) string {
+	var line strings.Builder
+	used := 0
+
+	for i := tp.firstColumn; i < len(tp.Table.Headers); i++ {
+		if i > tp.firstColumn {
+			if used+3 >= tp.Width {
+				break
+			}
+			line.WriteString(" │ ")
+			used += 3
+		}
+
+		width := min(tp.Table.widthOf(i), tp.Width-used)
+		cell := ""
+		if i < len(cells) {
+			cell = cells[i]
+		}
+		text := fmt.Sprintf("%-*s", width, truncateRunes(cell, width))
+		if i == highlight {
+			text = reverseVideo + text + reverseVideoOff
+		}
+		line.WriteString(text)
+		used += width
+	}
+
+	return line.String()
+}
+
+// statusLine describes the position in the table, its sort order and filter, or the message of
+// the last key
+func (tp *TablePager) statusLine(rows int) string {
+	if tp.filtering {
+		return ColorYellow + "Filter: " + tp.Table.Filter + ColorReset + "█"
+	}
+	if tp.status != "" {
+		return ColorGreen + tp.status + ColorReset
+	}
+
+	page := tp.pageSize()
+	parts := []string{fmt.Sprintf("Row %d of %d", min(tp.Table.CurrentRow+1, rows), rows)}
+	parts = append(parts, fmt.Sprintf("Page %d of %d", tp.offset/page+1, max(1, (rows+page-1)/page)))
+	if tp.Table.Sortable && tp.Table.SortColumn < len(tp.Table.Headers) {
+		order := "ascending"
+		if !tp.Table.SortAsc {
+			order = "descending"
+		}
+		parts = append(parts, fmt.Sprintf("Sorted by %s, %s", tp.Table.Headers[tp.Table.SortColumn], order))
+	}
+	if tp.Table.Filter != "" {
+		parts = append(parts, fmt.Sprintf("Filter: %s", tp.Table.Filter))
+	}
+
+	return ColorDim + truncateRunes(strings.Join(parts, " · "), tp.Width) + ColorReset
+}
+
+// readPagerKey reads a key press, decoding the escape sequences of cursor and paging keys
+func readPagerKey(reader *bufio.Reader) (PagerKey, error) {
+	r, _, err := reader.ReadRune()
+	if err != nil {
+		return PagerKey{}, err
+	}
+
+	switch r {
+	case 3: // Ctrl-C; raw mode delivers it as a key rather than a signal
+		return PagerKey{Code: PagerKeyInterrupt}, nil
+	case '\r', '\n':
+		return PagerKey{Code: PagerKeyEnter}, nil
+	case '\t':
+		return PagerKey{Code: PagerKeyTab}, nil
+	case 8, 127:
+		return PagerKey{Code: PagerKeyBackspace}, nil
+	case 27:
+		// A lone escape is the Escape key; a sequence arrives in one read
+		if reader.Buffered() == 0 {
+			return PagerKey{Code: PagerKeyEscape}, nil
+		}
+		return readEscapeSequence(reader), nil
+	}
+
+	return PagerKey{Code: PagerKeyRune, Rune: r}, nil
+}
+
+// readEscapeSequence decodes the CSI or SS3 sequence that follows an escape
+func readEscapeSequence(reader *bufio.Reader) PagerKey {
+	intro, err := reader.ReadByte()
+	if err != nil {
+		return PagerKey{Code: PagerKeyEscape}
+	}
+	if intro != '[' && intro != 'O' {
+		reader.UnreadByte()
+		return PagerKey{Code: PagerKeyEscape}
+	}
+
+	var params strings.Builder
+	for {
+		b, err := reader.ReadByte()
+		if err != nil {
+			return PagerKey{Code: PagerKeyUnknown}
+		}
+		if b < 0x40 || b > 0x7e {
+			params.WriteByte(b)
+			continue
+		}
+
+		switch b {
+		case 'A':
+			return PagerKey{Code: PagerKeyUp}
+		case 'B':
+			return PagerKey{Code: PagerKeyDown}
+		case 'C':
+			return PagerKey{Code: PagerKeyRight}
+		case 'D':
+			return PagerKey{Code: PagerKeyLeft}
+		case 'H':
+			return PagerKey{Code: PagerKeyHome}
+		case 'F':
+			return PagerKey{Code: PagerKeyEnd}
+		case '~':
+			switch params.String() {
+			case "1", "7":
+				return PagerKey{Code: PagerKeyHome}
+			case "4", "8":
+				return PagerKey{Code: PagerKeyEnd}
+			case "5":
+				return PagerKey{Code: PagerKeyPageUp}
+			case "6":
+				return PagerKey{Code: PagerKeyPageDown}
+			}
+		}
+		return PagerKey{Code: PagerKeyUnknown}
+	}
+}
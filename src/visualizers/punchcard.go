@@ -0,0 +1,210 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Hour by weekday punch card renderer
+
+package visualizers
+
+import (
+	"fmt"
+	"git-stats/models"
+	"html"
+	"math"
+	"strings"
+	"time"
+)
+
+// punchCardGlyphs are the punch card cells from no commits to the busiest hour
+var punchCardGlyphs = []string{"·", "∘", "○", "◎", "●"}
+
+// punchCardLabelWidth is the width of the weekday column
+const punchCardLabelWidth = 4
+
+// PunchCardRenderer draws the hour by weekday punch card as text or SVG
+type PunchCardRenderer struct {
+	config     models.RenderConfig
+	useColors  bool
+	theme      *models.Theme
+	colorDepth ColorDepth
+}
+
+// NewPunchCardRenderer creates a punch card renderer in the default theme
+func NewPunchCardRenderer(config models.RenderConfig) *PunchCardRenderer {
+	theme, _ := models.ResolveTheme(models.DefaultThemeName, nil)
+	return &PunchCardRenderer{
+		config:     config,
+		useColors:  true,
+		theme:      theme,
+		colorDepth: DetectColorDepth(),
+	}
+}
+
+// SetColorOptions enables or disables ANSI colors
+func (pr *PunchCardRenderer) SetColorOptions(useColors bool) {
+	pr.useColors = useColors
+}
+
+// SetTheme sets the palette of the cells; nil keeps the current theme
+func (pr *PunchCardRenderer) SetTheme(theme *models.Theme) {
+	if theme != nil {
+		pr.theme = theme
+	}
+}
+
+// RenderPunchCard renders the card as a grid of weekdays by hours with the commits of each
+// weekday on the right. Cells are 3, 2 or 1 characters wide, whichever fits the render width
+func (pr *PunchCardRenderer) RenderPunchCard(card *models.PunchCard) (string, error) {
+	if card == nil {
+		return "", fmt.Errorf("punch card cannot be nil")
+	}
+
+	maxCommits := card.Max()
+	totalWidth := len(fmt.Sprintf("%d", card.Total()))
+	cellWidth, labelStep := pr.cellLayout(totalWidth)
+
+	var result strings.Builder
+	result.WriteString(strings.Repeat(" ", punchCardLabelWidth) + pr.hourLabels(cellWidth, labelStep) + "\n")
+
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		result.WriteString(fmt.Sprintf("%-*s", punchCardLabelWidth, weekday.String()[:3]))
+		for _, commits := range card[weekday] {
+			result.WriteString(strings.Repeat(" ", cellWidth-1) + pr.cell(PunchCardLevel(commits, maxCommits)))
+		}
+		result.WriteString(fmt.Sprintf("  %*d\n", totalWidth, card.WeekdayTotal(weekday)))
+	}
+
+	return result.String(), nil
+}
+
+// RenderLegend renders the glyph scale and the busiest weekday and hour
+func (pr *PunchCardRenderer) RenderLegend(card *models.PunchCard) string {
+	glyphs := make([]string, len(punchCardGlyphs))
+	for level := range punchCardGlyphs {
+		glyphs[level] = pr.cell(level)
+	}
+	legend := "Less " + strings.Join(glyphs, " ") + " More"
+
+	if card == nil || card.Total() == 0 {
+		return legend + "   No commits\n"
+	}
+
+	weekday, hour := card.Peak()
+	commits := card[weekday][hour]
+	unit := "commits"
+	if commits == 1 {
+		unit = "commit"
+	}
+	return fmt.Sprintf("%s   Busiest: %s %02d:00 (%d %s)\n", legend, weekday.String()[:3], hour, commits, unit)
+}
+
+// cellLayout picks the widest cells that fit the render width and how often hours are labeled
+func (pr *PunchCardRenderer) cellLayout(totalWidth int) (cellWidth, labelStep int) {
+	width := pr.config.Width
+	if width <= 0 {
+		width = DefaultTerminalWidth
+	}
+
+	for _, layout := range [][2]int{{3, 1}, {2, 3}} {
+		if punchCardLabelWidth+24*layout[0]+2+totalWidth <= width {
+			return layout[0], layout[1]
+		}
+	}
+	return 1, 6
+}
+
+// hourLabels returns the hour numbers, each ending above the glyph of its hour
+func (pr *PunchCardRenderer) hourLabels(cellWidth, labelStep int) string {
+	line := []byte(strings.Repeat(" ", 24*cellWidth))
+	for hour := 0; hour < 24; hour += labelStep {
+		label := fmt.Sprintf("%d", hour)
+		end := hour*cellWidth + cellWidth
+		copy(line[end-len(label):end], label)
+	}
+	return strings.TrimRight(string(line), " ")
+}
+
+// cell returns the glyph of a level, colored from the theme when colors are enabled
+func (pr *PunchCardRenderer) cell(level int) string {
+	glyph := punchCardGlyphs[level]
+	if !pr.useColors {
+		return glyph
+	}
+	return LevelEscape(pr.theme, level, pr.colorDepth) + glyph + ColorReset
+}
+
+// PunchCardLevel returns the glyph level, 0 to 4, of an hour's commits on the card's scale
+func PunchCardLevel(commits, maxCommits int) int {
+	if commits <= 0 || maxCommits <= 0 {
+		return 0
+	}
+	level := (commits*4 + maxCommits - 1) / maxCommits
+	if level > 4 {
+		level = 4
+	}
+	return level
+}
+
+// SVG punch card geometry, in pixels
+const (
+	svgCellSize   = 28
+	svgLeftMargin = 44
+	svgTopMargin  = 36
+	svgRightSpace = 48
+	svgBottomRow  = 24
+)
+
+// RenderPunchCardSVG renders the card as a standalone SVG image: one circle per hour whose
+// area grows with its commits, in the theme's colors
+func (pr *PunchCardRenderer) RenderPunchCardSVG(card *models.PunchCard, title string) (string, error) {
+	if card == nil {
+		return "", fmt.Errorf("punch card cannot be nil")
+	}
+
+	width := svgLeftMargin + 24*svgCellSize + svgRightSpace
+	height := svgTopMargin + 7*svgCellSize + svgBottomRow
+	maxCommits := card.Max()
+	maxRadius := float64(svgCellSize)/2 - 2
+
+	var svg strings.Builder
+	svg.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	fmt.Fprintf(&svg, `<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d" font-family="sans-serif" font-size="11">`+"\n",
+		width, height, width, height)
+	fmt.Fprintf(&svg, "  <title>%s</title>\n", html.EscapeString(title))
+	if title != "" {
+		fmt.Fprintf(&svg, `  <text x="%d" y="16" font-size="13" font-weight="bold" fill="#57606a">%s</text>`+"\n",
+			svgLeftMargin, html.EscapeString(title))
+	}
+
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		cy := svgTopMargin + int(weekday)*svgCellSize + svgCellSize/2
+		fmt.Fprintf(&svg, `  <text x="%d" y="%d" text-anchor="end" dominant-baseline="middle" fill="#57606a">%s</text>`+"\n",
+			svgLeftMargin-8, cy, weekday.String()[:3])
+
+		for hour, commits := range card[weekday] {
+			cx := svgLeftMargin + hour*svgCellSize + svgCellSize/2
+			if commits == 0 {
+				fmt.Fprintf(&svg, `  <circle cx="%d" cy="%d" r="1.5" fill="%s"/>`+"\n", cx, cy, pr.theme.LevelColor(0).Hex())
+				continue
+			}
+
+			radius := math.Max(2, maxRadius*math.Sqrt(float64(commits)/float64(maxCommits)))
+			unit := "commits"
+			if commits == 1 {
+				unit = "commit"
+			}
+			fmt.Fprintf(&svg, `  <circle cx="%d" cy="%d" r="%.1f" fill="%s"><title>%s %02d:00: %d %s</title></circle>`+"\n",
+				cx, cy, radius, pr.theme.LevelColor(PunchCardLevel(commits, maxCommits)).Hex(),
+				weekday.String()[:3], hour, commits, unit)
+		}
+
+		fmt.Fprintf(&svg, `  <text x="%d" y="%d" dominant-baseline="middle" fill="#57606a">%d</text>`+"\n",
+			svgLeftMargin+24*svgCellSize+8, cy, card.WeekdayTotal(weekday))
+	}
+
+	labelY := svgTopMargin + 7*svgCellSize + 16
+	for hour := 0; hour < 24; hour += 3 {
+		fmt.Fprintf(&svg, `  <text x="%d" y="%d" text-anchor="middle" fill="#57606a">%02d:00</text>`+"\n",
+			svgLeftMargin+hour*svgCellSize+svgCellSize/2, labelY, hour)
+	}
+
+	svg.WriteString("</svg>\n")
+	return svg.String(), nil
+}
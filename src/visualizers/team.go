@@ -0,0 +1,58 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Team statistics tables
+
+package visualizers
+
+import (
+	"fmt"
+	"git-stats/models"
+	"strings"
+)
+
+// RenderTeamStatistics renders the rollup of each team as a table
+func (cr *ChartsRenderer) RenderTeamStatistics(teams []models.TeamStats, config models.RenderConfig) (string, error) {
+	var result strings.Builder
+
+	result.WriteString("Team Statistics\n")
+	result.WriteString("===============\n\n")
+
+	if len(teams) == 0 {
+		result.WriteString("No team activity found.\n")
+		return result.String(), nil
+	}
+
+	headers, rows := TeamTable(teams)
+	table, err := cr.RenderTable(headers, rows, config)
+	if err != nil {
+		return "", err
+	}
+	result.WriteString(table)
+	result.WriteString("\nA team owns the files it made the most commits to\n")
+
+	return result.String(), nil
+}
+
+// TeamTable returns the headers and rows of the team statistics table, one row per team
+func TeamTable(teams []models.TeamStats) ([]string, [][]string) {
+	headers := []string{"Team", "Members", "Commits", "Share", "Churn", "Active Days", "Files", "Owned Files",
+		"First Commit", "Last Commit"}
+
+	var rows [][]string
+	for i := range teams {
+		team := &teams[i]
+		rows = append(rows, []string{
+			team.Name,
+			fmt.Sprintf("%d", team.Members),
+			fmt.Sprintf("%d", team.Commits),
+			fmt.Sprintf("%.1f%%", team.CommitShare),
+			fmt.Sprintf("+%d -%d", team.Insertions, team.Deletions),
+			fmt.Sprintf("%d", team.ActiveDays),
+			fmt.Sprintf("%d", team.Files),
+			fmt.Sprintf("%d (%.1f%%)", team.OwnedFiles, team.Ownership),
+			team.FirstCommit.Format("2006-01-02"),
+			team.LastCommit.Format("2006-01-02"),
+		})
+	}
+
+	return headers, rows
+}
@@ -7,10 +7,14 @@ import (
 	"fmt"
 	"git-stats/models"
 	"git-stats/utils"
+	"os"
 	"sort"
 	"strconv"
 	"strings"
 	"time"
+	"unicode/utf8"
+
+	"golang.org/x/term"
 )
 
 // Color constants for terminal output
@@ -143,16 +147,18 @@ func (pi *ProgressIndicator) renderPercentage(current, total int, percentage flo
 
 // InteractiveTable provides an interactive table for data exploration
 type InteractiveTable struct {
-	Headers     []string
-	Rows        [][]string
-	CurrentRow  int
-	PageSize    int
-	CurrentPage int
-	Sortable    bool
-	SortColumn  int
-	SortAsc     bool
-	Filterable  bool
-	Filter      string
+	Headers      []string
+	Rows         [][]string
+	CurrentRow   int
+	PageSize     int
+	CurrentPage  int
+	Sortable     bool
+	SortColumn   int
+	SortAsc      bool
+	Filterable   bool
+	Filter       string
+	Width        int   // columns to fit the table to; 0 gives each column 15 characters
+	ColumnWidths []int // per column widths, as resized in the pager; they override Width
 }
 
 // NewInteractiveTable creates a new interactive table
@@ -235,7 +241,7 @@ func (it *InteractiveTable) renderTableHeader() string {
 			}
 		}
 
-		header.WriteString(fmt.Sprintf("%-15s%s", h, sortIndicator))
+		header.WriteString(fmt.Sprintf("%-*s%s", it.widthOf(i), truncateRunes(h, it.widthOf(i)), sortIndicator))
 	}
 
 	header.WriteString(ColorReset)
@@ -244,10 +250,92 @@ func (it *InteractiveTable) renderTableHeader() string {
 
 // renderTableSeparator renders the table separator line
 func (it *InteractiveTable) renderTableSeparator() string {
-	width := len(it.Headers)*17 + (len(it.Headers)-1)*3 // Approximate width
+	width := (len(it.Headers) - 1) * 3 // Approximate width
+	for i := range it.Headers {
+		width += it.widthOf(i) + 2
+	}
 	return ColorDim + strings.Repeat("─", width) + ColorReset
 }
 
+// columnWidth returns the width of each column: 15 characters, or an equal share of Width
+// less the separators and sort indicator
+func (it *InteractiveTable) columnWidth() int {
+	if it.Width <= 0 || len(it.Headers) == 0 {
+		return 15
+	}
+
+	width := (it.Width-(len(it.Headers)-1)*3)/len(it.Headers) - 2
+	if width < minColumnWidth {
+		return minColumnWidth
+	}
+	return width
+}
+
+// widthOf returns the width of a column: its entry in ColumnWidths, else columnWidth
+func (it *InteractiveTable) widthOf(column int) int {
+	if column < len(it.ColumnWidths) && it.ColumnWidths[column] > 0 {
+		return it.ColumnWidths[column]
+	}
+	return it.columnWidth()
+}
+
+// FitColumns sizes every column to its longest cell, at most maxWidth characters
+func (it *InteractiveTable) FitColumns(maxWidth int) {
+	it.ColumnWidths = make([]int, len(it.Headers))
+	for i, header := range it.Headers {
+		it.ColumnWidths[i] = utf8.RuneCountInString(header) + 2 // room for the sort indicator
+	}
+	for _, row := range it.Rows {
+		for i, cell := range row {
+			if i < len(it.ColumnWidths) && utf8.RuneCountInString(cell) > it.ColumnWidths[i] {
+				it.ColumnWidths[i] = utf8.RuneCountInString(cell)
+			}
+		}
+	}
+	for i, width := range it.ColumnWidths {
+		it.ColumnWidths[i] = max(minColumnWidth, min(width, maxWidth))
+	}
+}
+
+// ResizeColumn widens a column by delta characters, or narrows it for a negative delta, keeping
+// it at least minColumnWidth wide
+func (it *InteractiveTable) ResizeColumn(column, delta int) {
+	if column < 0 || column >= len(it.Headers) {
+		return
+	}
+	if len(it.ColumnWidths) < len(it.Headers) {
+		widths := make([]int, len(it.Headers))
+		for i := range widths {
+			widths[i] = it.widthOf(i)
+		}
+		it.ColumnWidths = widths
+	}
+	it.ColumnWidths[column] = max(minColumnWidth, it.ColumnWidths[column]+delta)
+}
+
+// SortBy sorts by a column, ascending first and reversing the order when it already sorts by it
+func (it *InteractiveTable) SortBy(column int) {
+	if column < 0 || column >= len(it.Headers) {
+		return
+	}
+	if it.SortColumn == column {
+		it.SortAsc = !it.SortAsc
+	} else {
+		it.SortColumn = column
+		it.SortAsc = true
+	}
+	it.Sortable = true
+}
+
+// VisibleRows returns the rows that pass the filter in sort order, leaving Rows as they are
+func (it *InteractiveTable) VisibleRows() [][]string {
+	rows := append([][]string(nil), it.getFilteredRows()...)
+	if it.Sortable {
+		it.sortRows(rows)
+	}
+	return rows
+}
+
 // renderTableRow renders a single table row
 func (it *InteractiveTable) renderTableRow(row []string, style string) string {
 	var result strings.Builder
@@ -257,7 +345,7 @@ func (it *InteractiveTable) renderTableRow(row []string, style string) string {
 		if i > 0 {
 			result.WriteString(" │ ")
 		}
-		result.WriteString(fmt.Sprintf("%-15s", TruncateString(cell, 15)))
+		result.WriteString(fmt.Sprintf("%-*s", it.widthOf(i), truncateRunes(cell, it.widthOf(i))))
 	}
 
 	result.WriteString(ColorReset)
@@ -322,8 +410,8 @@ func (it *InteractiveTable) sortRows(rows [][]string) {
 		b := rows[j][it.SortColumn]
 
 		// Try to parse as numbers first
-		if numA, errA := strconv.ParseFloat(a, 64); errA == nil {
-			if numB, errB := strconv.ParseFloat(b, 64); errB == nil {
+		if numA, okA := sortNumber(a); okA {
+			if numB, okB := sortNumber(b); okB {
 				if it.SortAsc {
 					return numA < numB
 				}
@@ -339,6 +427,14 @@ func (it *InteractiveTable) sortRows(rows [][]string) {
 	})
 }
 
+// sortNumber parses a cell as a number for sorting, ignoring ranks' "#", percent signs and
+// thousands separators
+func sortNumber(cell string) (float64, bool) {
+	cell = strings.TrimSuffix(strings.TrimPrefix(strings.TrimSpace(cell), "#"), "%")
+	number, err := strconv.ParseFloat(strings.ReplaceAll(cell, ",", ""), 64)
+	return number, err == nil
+}
+
 // ColoredBarChart renders a colored bar chart
 type ColoredBarChart struct {
 	Title     string
@@ -559,10 +655,42 @@ func formatDuration(d time.Duration) string {
 	}
 }
 
-// GetTerminalWidth attempts to get the terminal width
+// DefaultTerminalWidth is the width assumed when stdout is not a terminal and COLUMNS is unset
+const DefaultTerminalWidth = 80
+
+// GetTerminalWidth returns the width of the terminal on stdout, else COLUMNS, else DefaultTerminalWidth
 func GetTerminalWidth() int {
-	// Default width if unable to determine
-	return 80
+	if width, _, err := term.GetSize(int(os.Stdout.Fd())); err == nil && width > 0 {
+		return width
+	}
+	if columns, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && columns > 0 {
+		return columns
+	}
+	return DefaultTerminalWidth
+}
+
+// IsTerminal reports whether the file is a terminal rather than a pipe or regular file
+func IsTerminal(f *os.File) bool {
+	return term.IsTerminal(int(f.Fd()))
+}
+
+// ColorEnabled decides whether output is colored under a -color mode. always and never are
+// absolute; auto colors a terminal unless NO_COLOR is set, and CLICOLOR_FORCE colors pipes too
+func ColorEnabled(mode string, toTerminal bool) bool {
+	switch mode {
+	case models.ColorAlways:
+		return true
+	case models.ColorNever:
+		return false
+	}
+
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	if force := os.Getenv("CLICOLOR_FORCE"); force != "" && force != "0" {
+		return true
+	}
+	return toTerminal && os.Getenv("TERM") != "dumb"
 }
 
 // ClearScreen clears the terminal screen
@@ -74,6 +74,7 @@ func demoInteractiveTable() {
 	table := NewInteractiveTable(headers, rows)
 	table.SortColumn = 1 // Sort by commits
 	table.SortAsc = false // Descending order
+	table.Width = GetTerminalWidth()
 
 	fmt.Println(table.RenderTable())
 }
@@ -218,15 +219,15 @@ func CreateSampleAnalysisResult() *models.AnalysisResult {
 		ContribGraph: &models.ContributionGraph{
 			StartDate:    now.AddDate(-1, 0, 0),
 			EndDate:      now,
-			DailyCommits: map[string]int{
+			DailyValues: map[string]int{
 				"2024-01-15": 3,
 				"2024-01-16": 5,
 				"2024-01-17": 2,
 				"2024-01-18": 8,
 				"2024-01-19": 1,
 			},
-			MaxCommits:   8,
-			TotalCommits: 1234,
+			MaxValue: 8,
+			Total:    1234,
 		},
 		HealthMetrics: &models.HealthMetrics{
 			RepositoryAge:      730 * 24 * time.Hour, // 2 years
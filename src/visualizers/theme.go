@@ -0,0 +1,191 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Theme colors for terminals of different color depths
+
+package visualizers
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"git-stats/models"
+)
+
+// ColorDepth is how many colors a terminal can show
+type ColorDepth int
+
+const (
+	Color16 ColorDepth = iota
+	Color256
+	TrueColor
+)
+
+// DetectColorDepth reads the terminal's color depth from COLORTERM and TERM
+func DetectColorDepth() ColorDepth {
+	colorTerm := strings.ToLower(os.Getenv("COLORTERM"))
+	if colorTerm == "truecolor" || colorTerm == "24bit" {
+		return TrueColor
+	}
+
+	term := strings.ToLower(os.Getenv("TERM"))
+	switch {
+	case strings.HasSuffix(term, "-direct"):
+		return TrueColor
+	case strings.Contains(term, "256color"):
+		return Color256
+	default:
+		return Color16
+	}
+}
+
+// ColorEscape returns the ANSI escape that sets the foreground to color, degraded to the
+// nearest color the depth can show
+func ColorEscape(color models.RGB, depth ColorDepth) string {
+	switch depth {
+	case TrueColor:
+		return fmt.Sprintf("\033[38;2;%d;%d;%dm", color.R, color.G, color.B)
+	case Color256:
+		return fmt.Sprintf("\033[38;5;%dm", nearest256(color))
+	default:
+		return fmt.Sprintf("\033[%dm", nearest16(color))
+	}
+}
+
+// LevelEscape returns the ANSI escape that sets the foreground to a theme's color for an activity
+// level. 16-color terminals get the theme's 16-color palette, which keeps the levels apart
+func LevelEscape(theme *models.Theme, level int, depth ColorDepth) string {
+	if depth != Color16 {
+		return ColorEscape(theme.LevelColor(level), depth)
+	}
+	return "\033[" + Palette16(theme)[theme.LevelIndex(level)] + "m"
+}
+
+// Palette16 returns the SGR parameters of a theme's colors on 16-color terminals. Themes without
+// their own take the nearest colors, and a color already taken by a lower level moves to its bold
+// or bright variant, or else to the nearest color still free
+func Palette16(theme *models.Theme) []string {
+	if len(theme.ANSI16) == len(theme.Colors) {
+		return theme.ANSI16
+	}
+
+	taken := make(map[string]bool)
+	palette := make([]string, len(theme.Colors))
+	for i, color := range theme.Colors {
+		code := nearest16(color)
+		candidates := []string{strconv.Itoa(code), strconv.Itoa(code) + ";1"}
+		if variant := brightVariant(code); variant != 0 {
+			candidates = append(candidates, strconv.Itoa(variant), strconv.Itoa(variant)+";1")
+		}
+
+		byDistance := make([]int, len(ansi16))
+		for j := range ansi16 {
+			byDistance[j] = j
+		}
+		sort.SliceStable(byDistance, func(a, b int) bool {
+			return colorDistance(color, ansi16[byDistance[a]].color) < colorDistance(color, ansi16[byDistance[b]].color)
+		})
+		for _, j := range byDistance {
+			candidates = append(candidates, strconv.Itoa(ansi16[j].code), strconv.Itoa(ansi16[j].code)+";1")
+		}
+
+		palette[i] = candidates[0]
+		for _, candidate := range candidates {
+			if !taken[candidate] {
+				palette[i] = candidate
+				break
+			}
+		}
+		taken[palette[i]] = true
+	}
+	return palette
+}
+
+// brightVariant returns the bright color of a normal SGR color code or the normal one of a
+// bright code; gray pairs with white since black is left out
+func brightVariant(code int) int {
+	switch {
+	case code == 90:
+		return 37
+	case code >= 31 && code <= 37:
+		return code + 60
+	case code >= 91 && code <= 97:
+		return code - 60
+	}
+	return 0
+}
+
+// cubeLevels are the channel values of the 6x6x6 color cube of 256-color terminals
+var cubeLevels = []int{0, 95, 135, 175, 215, 255}
+
+// nearest256 returns the closest color of the 256-color cube or grayscale ramp
+func nearest256(color models.RGB) int {
+	cubeIndex := func(value uint8) int {
+		best := 0
+		for i, level := range cubeLevels {
+			if abs(int(value)-level) < abs(int(value)-cubeLevels[best]) {
+				best = i
+			}
+		}
+		return best
+	}
+
+	r, g, b := cubeIndex(color.R), cubeIndex(color.G), cubeIndex(color.B)
+	cube := models.RGB{R: uint8(cubeLevels[r]), G: uint8(cubeLevels[g]), B: uint8(cubeLevels[b])}
+
+	// The grayscale ramp runs from 8 to 238 in steps of 10
+	average := (int(color.R) + int(color.G) + int(color.B)) / 3
+	step := (average - 3) / 10
+	if step < 0 {
+		step = 0
+	} else if step > 23 {
+		step = 23
+	}
+	grayValue := uint8(8 + step*10)
+	gray := models.RGB{R: grayValue, G: grayValue, B: grayValue}
+
+	if colorDistance(color, gray) < colorDistance(color, cube) {
+		return 232 + step
+	}
+	return 16 + 36*r + 6*g + b
+}
+
+// ansi16 are the xterm colors of the 16-color SGR codes. Black is left out: it is the usual
+// background, and dark palette colors should stay visible on it
+var ansi16 = []struct {
+	code  int
+	color models.RGB
+}{
+	{31, models.RGB{R: 205}}, {32, models.RGB{G: 205}}, {33, models.RGB{R: 205, G: 205}},
+	{34, models.RGB{B: 238}}, {35, models.RGB{R: 205, B: 205}}, {36, models.RGB{G: 205, B: 205}},
+	{37, models.RGB{R: 229, G: 229, B: 229}}, {90, models.RGB{R: 127, G: 127, B: 127}},
+	{91, models.RGB{R: 255}}, {92, models.RGB{G: 255}}, {93, models.RGB{R: 255, G: 255}},
+	{94, models.RGB{R: 92, G: 92, B: 255}}, {95, models.RGB{R: 255, B: 255}}, {96, models.RGB{G: 255, B: 255}},
+	{97, models.RGB{R: 255, G: 255, B: 255}},
+}
+
+// nearest16 returns the SGR foreground code of the closest 16-color terminal color
+func nearest16(color models.RGB) int {
+	best := ansi16[0]
+	for _, candidate := range ansi16[1:] {
+		if colorDistance(color, candidate.color) < colorDistance(color, best.color) {
+			best = candidate
+		}
+	}
+	return best.code
+}
+
+// colorDistance returns the squared distance between two colors
+func colorDistance(a, b models.RGB) int {
+	dr, dg, db := int(a.R)-int(b.R), int(a.G)-int(b.G), int(a.B)-int(b.B)
+	return dr*dr + dg*dg + db*db
+}
+
+// abs returns the absolute value of n
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,142 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Contributor comparison analyzer tests
+
+package analyzers
+
+import (
+	"git-stats/analyzers"
+	"git-stats/models"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+// comparisonFixture returns commits by Alice under two emails, Bob, and Carol, who shares
+// a file with Alice
+func comparisonFixture() []models.Commit {
+	alice := models.Author{Name: "Alice", Email: "alice@example.com"}
+	aliceWork := models.Author{Name: "Alice Smith", Email: "alice@work.example"}
+	bob := models.Author{Name: "Bob", Email: "bob@example.com"}
+	carol := models.Author{Name: "Carol", Email: "carol@example.com"}
+
+	commit := func(hash string, author models.Author, at time.Time, files ...string) models.Commit {
+		changes := make([]models.FileChange, len(files))
+		for i, file := range files {
+			changes[i] = models.FileChange{Path: file, Insertions: 5, Deletions: 1}
+		}
+		return models.Commit{
+			Hash: hash, Author: author, AuthorDate: at,
+			Stats: models.CommitStats{Insertions: 5 * len(files), Deletions: len(files), Files: changes},
+		}
+	}
+
+	day := time.Date(2024, 1, 20, 9, 0, 0, 0, time.UTC)
+	return []models.Commit{
+		commit("c6", carol, day.AddDate(0, 2, 0), "web/app.js"),
+		commit("c5", bob, day.AddDate(0, 1, 1).Add(5*time.Hour), "api/server.go", "docs/api.md"),
+		commit("c4", aliceWork, day.AddDate(0, 1, 0), "web/app.js"),
+		commit("c3", alice, day.AddDate(0, 0, 1), "api/server.go"),
+		commit("c2", alice, day.Add(time.Hour), "api/server.go", "api/db.go"),
+		commit("c1", alice, day, "api/db.go"),
+	}
+}
+
+func TestCompareContributors(t *testing.T) {
+	comparison, err := analyzers.NewComparisonAnalyzer().CompareContributors(comparisonFixture(), []string{"alice", "bob@example.com"}, models.AnalysisConfig{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(comparison.Contributors) != 2 {
+		t.Fatalf("Expected 2 contributors, got %d", len(comparison.Contributors))
+	}
+
+	alice, bob := comparison.Contributors[0], comparison.Contributors[1]
+
+	// "alice" matches both of Alice's identities, named after the one with the most commits
+	if alice.Name != "Alice" || alice.Email != "alice@example.com" || len(alice.Identities) != 2 || alice.Identities[1].Email != "alice@work.example" {
+		t.Errorf("Expected Alice's two identities, most commits first, got %+v", alice.Identities)
+	}
+	if alice.TotalCommits != 4 || alice.TotalInsertions != 25 || alice.TotalDeletions != 5 || alice.ActiveDays != 3 {
+		t.Errorf("Unexpected totals for Alice: %+v", alice)
+	}
+	if alice.CommitsByDay["2024-01-20"] != 2 || alice.CommitsByHour[9] != 3 || alice.CommitsByHour[10] != 1 || alice.PeakHour() != 9 {
+		t.Errorf("Unexpected daily or hourly activity for Alice: %v %v", alice.CommitsByDay, alice.CommitsByHour)
+	}
+	if alice.FileTypes["go"] != 4 || alice.FileTypes["js"] != 1 || alice.FilesTouched != 3 {
+		t.Errorf("Unexpected file mix for Alice: %v, %d files", alice.FileTypes, alice.FilesTouched)
+	}
+	if bob.Selector != "bob@example.com" || bob.TotalCommits != 1 || bob.PeakHour() != 14 {
+		t.Errorf("Unexpected totals for Bob: %+v", bob)
+	}
+
+	// The open-ended range spans the compared commits only, so Carol's later commit is left out
+	wantStart := time.Date(2024, 1, 20, 9, 0, 0, 0, time.UTC)
+	wantEnd := time.Date(2024, 2, 21, 14, 0, 0, 0, time.UTC)
+	if !comparison.TimeRange.Start.Equal(wantStart) || !comparison.TimeRange.End.Equal(wantEnd) {
+		t.Errorf("Expected %v to %v, got %v to %v", wantStart, wantEnd, comparison.TimeRange.Start, comparison.TimeRange.End)
+	}
+	if len(comparison.Months) != 2 || comparison.Months[0].Month() != time.January || comparison.Months[1].Month() != time.February {
+		t.Errorf("Expected January and February, got %v", comparison.Months)
+	}
+	if !reflect.DeepEqual(alice.CommitsByMonth, []int{3, 1}) || !reflect.DeepEqual(bob.CommitsByMonth, []int{0, 1}) {
+		t.Errorf("Unexpected monthly commits: %v, %v", alice.CommitsByMonth, bob.CommitsByMonth)
+	}
+
+	// api/server.go is shared; the rest belong to one side, most changed first
+	if len(comparison.SharedFiles) != 1 {
+		t.Fatalf("Expected one shared file, got %+v", comparison.SharedFiles)
+	}
+	shared := comparison.SharedFiles[0]
+	if shared.Path != "api/server.go" || !reflect.DeepEqual(shared.Commits, []int{2, 1}) || shared.TotalCommits != 3 || shared.Contributors != 2 {
+		t.Errorf("Unexpected shared file: %+v", shared)
+	}
+	if !reflect.DeepEqual(alice.ExclusiveFiles, []string{"api/db.go", "web/app.js"}) || !reflect.DeepEqual(bob.ExclusiveFiles, []string{"docs/api.md"}) {
+		t.Errorf("Unexpected exclusive files: %v, %v", alice.ExclusiveFiles, bob.ExclusiveFiles)
+	}
+}
+
+func TestCompareContributors_TimeRange(t *testing.T) {
+	config := models.AnalysisConfig{TimeRange: models.TimeRange{
+		Start: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC),
+	}}
+
+	comparison, err := analyzers.NewComparisonAnalyzer().CompareContributors(comparisonFixture(), []string{"alice", "carol"}, config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// A configured range is kept as it is and only its commits count
+	if !comparison.TimeRange.Start.Equal(config.TimeRange.Start) || !comparison.TimeRange.End.Equal(config.TimeRange.End) {
+		t.Errorf("Expected the configured range, got %+v", comparison.TimeRange)
+	}
+	if alice := comparison.Contributors[0]; alice.TotalCommits != 1 || alice.Name != "Alice Smith" {
+		t.Errorf("Expected only Alice's February commit, got %+v", alice)
+	}
+	if len(comparison.Months) != 3 || len(comparison.SharedFiles) != 1 || comparison.SharedFiles[0].Path != "web/app.js" {
+		t.Errorf("Expected three months and web/app.js shared, got %v and %+v", comparison.Months, comparison.SharedFiles)
+	}
+}
+
+func TestCompareContributors_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		selectors []string
+		want      string
+	}{
+		{"one contributor", []string{"alice"}, "at least two contributors"},
+		{"author matched twice", []string{"alice", "example.com"}, `matches both "alice" and "example.com"`},
+		{"no commits", []string{"alice", "dave@example.com"}, `no commits found for contributor "dave@example.com"`},
+		{"email is exact", []string{"alice", "bob@example"}, `no commits found for contributor "bob@example"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := analyzers.NewComparisonAnalyzer().CompareContributors(comparisonFixture(), tt.selectors, models.AnalysisConfig{})
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got %v", tt.want, err)
+			}
+		})
+	}
+}
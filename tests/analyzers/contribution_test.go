@@ -33,16 +33,16 @@ func TestAnalyzeContributions_EmptyCommits(t *testing.T) {
 		t.Fatalf("AnalyzeContributions failed: %v", err)
 	}
 
-	if result.TotalCommits != 0 {
-		t.Errorf("Expected 0 total commits, got %d", result.TotalCommits)
+	if result.Total != 0 {
+		t.Errorf("Expected 0 total commits, got %d", result.Total)
 	}
 
-	if result.MaxCommits != 0 {
-		t.Errorf("Expected 0 max commits, got %d", result.MaxCommits)
+	if result.MaxValue != 0 {
+		t.Errorf("Expected 0 max commits, got %d", result.MaxValue)
 	}
 
-	if len(result.DailyCommits) != 0 {
-		t.Errorf("Expected empty daily commits map, got %d entries", len(result.DailyCommits))
+	if len(result.DailyValues) != 0 {
+		t.Errorf("Expected empty daily commits map, got %d entries", len(result.DailyValues))
 	}
 }
 
@@ -86,24 +86,24 @@ func TestAnalyzeContributions_WithCommits(t *testing.T) {
 		t.Fatalf("AnalyzeContributions failed: %v", err)
 	}
 
-	if result.TotalCommits != 3 {
-		t.Errorf("Expected 3 total commits, got %d", result.TotalCommits)
+	if result.Total != 3 {
+		t.Errorf("Expected 3 total commits, got %d", result.Total)
 	}
 
-	if result.MaxCommits != 2 {
-		t.Errorf("Expected 2 max commits per day, got %d", result.MaxCommits)
+	if result.MaxValue != 2 {
+		t.Errorf("Expected 2 max commits per day, got %d", result.MaxValue)
 	}
 
 	// Check specific dates
 	jan15 := "2024-01-15"
 	jan16 := "2024-01-16"
 
-	if result.DailyCommits[jan15] != 2 {
-		t.Errorf("Expected 2 commits on %s, got %d", jan15, result.DailyCommits[jan15])
+	if result.DailyValues[jan15] != 2 {
+		t.Errorf("Expected 2 commits on %s, got %d", jan15, result.DailyValues[jan15])
 	}
 
-	if result.DailyCommits[jan16] != 1 {
-		t.Errorf("Expected 1 commit on %s, got %d", jan16, result.DailyCommits[jan16])
+	if result.DailyValues[jan16] != 1 {
+		t.Errorf("Expected 1 commit on %s, got %d", jan16, result.DailyValues[jan16])
 	}
 }
 
@@ -138,13 +138,13 @@ func TestAnalyzeContributions_AuthorFilter(t *testing.T) {
 		t.Fatalf("AnalyzeContributions failed: %v", err)
 	}
 
-	if result.TotalCommits != 1 {
-		t.Errorf("Expected 1 total commit after filtering, got %d", result.TotalCommits)
+	if result.Total != 1 {
+		t.Errorf("Expected 1 total commit after filtering, got %d", result.Total)
 	}
 
 	jan15 := "2024-01-15"
-	if result.DailyCommits[jan15] != 1 {
-		t.Errorf("Expected 1 commit on %s after filtering, got %d", jan15, result.DailyCommits[jan15])
+	if result.DailyValues[jan15] != 1 {
+		t.Errorf("Expected 1 commit on %s after filtering, got %d", jan15, result.DailyValues[jan15])
 	}
 }
 
@@ -181,24 +181,25 @@ func TestAnalyzeContributions_ExcludeMerges(t *testing.T) {
 		t.Fatalf("AnalyzeContributions failed: %v", err)
 	}
 
-	if result.TotalCommits != 1 {
-		t.Errorf("Expected 1 total commit after excluding merges, got %d", result.TotalCommits)
+	if result.Total != 1 {
+		t.Errorf("Expected 1 total commit after excluding merges, got %d", result.Total)
 	}
 }
 
 func TestCalculateActivityLevels(t *testing.T) {
 	analyzer := analyzers.NewContributionAnalyzer()
 
+	// The busiest day is 40 commits, so the levels split at 10, 20 and 30
 	dailyCommits := map[string]int{
 		"2024-01-01": 0,  // Level 0
 		"2024-01-02": 1,  // Level 1
-		"2024-01-03": 3,  // Level 1
-		"2024-01-04": 4,  // Level 2
-		"2024-01-05": 9,  // Level 2
-		"2024-01-06": 10, // Level 3
-		"2024-01-07": 19, // Level 3
-		"2024-01-08": 20, // Level 4
-		"2024-01-09": 50, // Level 4
+		"2024-01-03": 10, // Level 1
+		"2024-01-04": 11, // Level 2
+		"2024-01-05": 20, // Level 2
+		"2024-01-06": 21, // Level 3
+		"2024-01-07": 30, // Level 3
+		"2024-01-08": 31, // Level 4
+		"2024-01-09": 40, // Level 4
 	}
 
 	levels := analyzer.CalculateActivityLevels(dailyCommits)
@@ -306,15 +307,15 @@ func TestGetContributionSummary(t *testing.T) {
 	graph := &models.ContributionGraph{
 		StartDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 		EndDate:   time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC),
-		DailyCommits: map[string]int{
+		DailyValues: map[string]int{
 			"2024-01-01": 0,
 			"2024-01-02": 2,
 			"2024-01-03": 1,
 			"2024-01-04": 0,
 			"2024-01-05": 3,
 		},
-		MaxCommits:   3,
-		TotalCommits: 6,
+		MaxValue: 3,
+		Total:    6,
 	}
 
 	summary := analyzer.GetContributionSummary(graph)
@@ -410,3 +411,236 @@ func BenchmarkCalculateActivityLevels(b *testing.B) {
 		analyzer.CalculateActivityLevels(dailyCommits)
 	}
 }
+
+func TestSummarizeYears(t *testing.T) {
+	analyzer := analyzers.NewContributionAnalyzer()
+
+	// The analyzer fills every day of the range, so the streaks rely on a dense map
+	dailyCommits := make(map[string]int)
+	for day := time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC); day.Year() < 2025; day = day.AddDate(0, 0, 1) {
+		dailyCommits[day.Format("2006-01-02")] = 0
+	}
+	dailyCommits["2023-12-30"] = 1
+	dailyCommits["2023-12-31"] = 2
+	dailyCommits["2024-01-01"] = 3
+	dailyCommits["2024-01-02"] = 1
+	dailyCommits["2024-03-10"] = 5
+
+	graph := &models.ContributionGraph{DailyValues: dailyCommits}
+
+	years := analyzer.SummarizeYears(graph, 2022, 2024)
+	if len(years) != 3 {
+		t.Fatalf("Expected 3 years, got %d", len(years))
+	}
+
+	expected := []models.ContributionYear{
+		{Year: 2024, Total: 9, ActiveDays: 3, MaxValue: 5, LongestStreak: 2},
+		{Year: 2023, Total: 3, ActiveDays: 2, MaxValue: 2, LongestStreak: 2},
+		{Year: 2022},
+	}
+
+	for i, want := range expected {
+		if years[i] != want {
+			t.Errorf("Year %d: expected %+v, got %+v", i, want, years[i])
+		}
+	}
+
+	if years := analyzer.SummarizeYears(nil, 2022, 2024); years != nil {
+		t.Errorf("Expected nil for nil graph, got %v", years)
+	}
+}
+
+func TestAnalyzeContributions_Metrics(t *testing.T) {
+	analyzer := analyzers.NewContributionAnalyzer()
+
+	day := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	alice := models.Author{Name: "Alice", Email: "alice@example.com"}
+	bob := models.Author{Name: "Bob", Email: "bob@example.com"}
+
+	commits := []models.Commit{
+		{
+			Hash: "abc1234", Author: alice, AuthorDate: day.Add(9 * time.Hour),
+			Stats: models.CommitStats{FilesChanged: 2, Insertions: 100, Deletions: 20, Files: []models.FileChange{
+				{Path: "main.go"}, {Path: "README.md"},
+			}},
+		},
+		{
+			Hash: "def5678", Author: bob, AuthorDate: day.Add(15 * time.Hour),
+			Stats: models.CommitStats{FilesChanged: 1, Insertions: 1, Deletions: 1, Files: []models.FileChange{
+				{Path: "main.go"},
+			}},
+		},
+		{
+			Hash: "0123abc", Author: alice, AuthorDate: day.AddDate(0, 0, 1).Add(10 * time.Hour),
+			Stats: models.CommitStats{FilesChanged: 3, Insertions: 5},
+		},
+	}
+
+	tests := []struct {
+		metric   string
+		first    int
+		second   int
+		maxValue int
+	}{
+		{"", 2, 1, 2},
+		{models.MetricCommits, 2, 1, 2},
+		{models.MetricLines, 122, 5, 122},
+		{models.MetricInsertions, 101, 5, 101},
+		{models.MetricDeletions, 21, 0, 21},
+		{models.MetricFiles, 2, 3, 3},
+		{models.MetricAuthors, 2, 1, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.metric, func(t *testing.T) {
+			config := models.AnalysisConfig{
+				TimeRange: models.TimeRange{
+					Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					End:   time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+				},
+				IncludeMerges: true,
+				Metric:        tt.metric,
+			}
+
+			graph, err := analyzer.AnalyzeContributions(commits, config)
+			if err != nil {
+				t.Fatalf("AnalyzeContributions failed: %v", err)
+			}
+
+			wantMetric := tt.metric
+			if wantMetric == "" {
+				wantMetric = models.MetricCommits
+			}
+			if graph.Metric != wantMetric {
+				t.Errorf("Expected metric %q, got %q", wantMetric, graph.Metric)
+			}
+
+			if got := graph.DailyValues["2024-01-15"]; got != tt.first {
+				t.Errorf("Expected %d on 2024-01-15, got %d", tt.first, got)
+			}
+			if got := graph.DailyValues["2024-01-16"]; got != tt.second {
+				t.Errorf("Expected %d on 2024-01-16, got %d", tt.second, got)
+			}
+			if graph.MaxValue != tt.maxValue {
+				t.Errorf("Expected max %d, got %d", tt.maxValue, graph.MaxValue)
+			}
+			if graph.Total != tt.first+tt.second {
+				t.Errorf("Expected total %d, got %d", tt.first+tt.second, graph.Total)
+			}
+		})
+	}
+}
+
+func TestAnalyzeAuthors(t *testing.T) {
+	analyzer := analyzers.NewContributionAnalyzer()
+
+	day := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	alice := models.Author{Name: "Alice", Email: "alice@example.com"}
+	bob := models.Author{Name: "Bob", Email: "bob@example.com"}
+
+	commits := []models.Commit{
+		{Hash: "a1", Author: models.Author{Name: "Alice Smith", Email: "Alice@Example.com"}, AuthorDate: day.AddDate(0, 0, 1).Add(9 * time.Hour),
+			Stats: models.CommitStats{Insertions: 10}},
+		{Hash: "b1", Author: bob, AuthorDate: day.Add(15 * time.Hour), Stats: models.CommitStats{Insertions: 3}},
+		{Hash: "b2", Author: bob, AuthorDate: day.Add(11 * time.Hour), Stats: models.CommitStats{Insertions: 4}},
+		{Hash: "a2", Author: alice, AuthorDate: day.Add(9 * time.Hour), Stats: models.CommitStats{Insertions: 1}},
+		{Hash: "old", Author: alice, AuthorDate: day.AddDate(-1, 0, 0), Stats: models.CommitStats{Insertions: 50}},
+	}
+	config := models.AnalysisConfig{
+		TimeRange: models.TimeRange{
+			Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			End:   time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+		},
+		IncludeMerges: true,
+	}
+
+	graph, err := analyzer.AnalyzeContributions(commits, config)
+	if err != nil {
+		t.Fatalf("AnalyzeContributions failed: %v", err)
+	}
+	analyzer.AnalyzeAuthors(graph, commits, config)
+
+	if len(graph.Authors) != 2 {
+		t.Fatalf("Expected 2 authors, emails matched case insensitively, got %+v", graph.Authors)
+	}
+	first, second := graph.Authors[0], graph.Authors[1]
+	if first.Name != "Alice Smith" || first.Total != 2 {
+		t.Errorf("Expected Alice first by name, with her latest name and 2 commits, got %+v", first)
+	}
+	if second.Name != "Bob" || second.Daily["2024-01-15"] != 2 {
+		t.Errorf("Expected Bob with 2 commits on 2024-01-15, got %+v", second)
+	}
+	if graph.DominantAuthor("2024-01-15") != 1 || graph.DominantAuthor("2024-01-16") != 0 {
+		t.Errorf("Expected Bob to dominate 2024-01-15 and Alice 2024-01-16")
+	}
+
+	config.Metric = models.MetricInsertions
+	config.AuthorFilter = "bob"
+	graph, err = analyzer.AnalyzeContributions(commits, config)
+	if err != nil {
+		t.Fatalf("AnalyzeContributions failed: %v", err)
+	}
+	analyzer.AnalyzeAuthors(graph, commits, config)
+	if len(graph.Authors) != 1 || graph.Authors[0].Total != 7 {
+		t.Errorf("Expected only Bob with 7 insertions, got %+v", graph.Authors)
+	}
+}
+
+func TestAnalyzeContributions_Levels(t *testing.T) {
+	analyzer := analyzers.NewContributionAnalyzer()
+
+	// Seven active days with one outlier
+	base := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	var commits []models.Commit
+	for i, count := range []int{1, 1, 2, 3, 5, 8, 200} {
+		for j := 0; j < count; j++ {
+			commits = append(commits, models.Commit{
+				Hash:       fmt.Sprintf("commit%d-%d", i, j),
+				Author:     models.Author{Name: "Test User", Email: "test@example.com"},
+				AuthorDate: base.AddDate(0, 0, i*2),
+			})
+		}
+	}
+
+	tests := []struct {
+		levels     string
+		fixed      []int
+		thresholds []int
+	}{
+		{"", nil, []int{50, 100, 150}},
+		{models.LevelsLinear, nil, []int{50, 100, 150}},
+		{models.LevelsQuantile, nil, []int{1, 3, 8}},
+		{models.LevelsLog, nil, []int{3, 14, 53}},
+		{models.LevelsFixed, []int{3, 9, 19}, []int{3, 9, 19}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.levels, func(t *testing.T) {
+			config := models.AnalysisConfig{
+				TimeRange: models.TimeRange{
+					Start: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+					End:   time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+				},
+				Levels:     tt.levels,
+				Thresholds: tt.fixed,
+			}
+
+			graph, err := analyzer.AnalyzeContributions(commits, config)
+			if err != nil {
+				t.Fatalf("AnalyzeContributions failed: %v", err)
+			}
+
+			if fmt.Sprint(graph.LevelThresholds()) != fmt.Sprint(tt.thresholds) {
+				t.Errorf("Expected thresholds %v, got %v", tt.thresholds, graph.LevelThresholds())
+			}
+
+			// The summary levels follow the graph's thresholds
+			summary := analyzer.GetContributionSummary(graph)
+			for date, value := range graph.DailyValues {
+				if want := models.ActivityLevel(value, tt.thresholds); summary.ActivityLevels[date] != want {
+					t.Errorf("Expected level %d on %s, got %d", want, date, summary.ActivityLevels[date])
+				}
+			}
+		})
+	}
+}
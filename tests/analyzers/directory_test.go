@@ -0,0 +1,113 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Directory and module analyzer tests
+
+package analyzers
+
+import (
+	"git-stats/analyzers"
+	"git-stats/models"
+	"testing"
+	"time"
+)
+
+// directoryCommits returns commits by two authors in a repository with a Go module and a web app
+func directoryCommits() []models.Commit {
+	day := time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)
+	alice := models.Author{Name: "Alice", Email: "alice@example.com"}
+	bob := models.Author{Name: "Bob", Email: "bob@example.com"}
+	change := func(path string, insertions, deletions int) models.FileChange {
+		return models.FileChange{Path: path, Insertions: insertions, Deletions: deletions}
+	}
+	commit := func(hash string, author models.Author, daysAgo int, files ...models.FileChange) models.Commit {
+		return models.Commit{Hash: hash, Author: author, AuthorDate: day.AddDate(0, 0, -daysAgo),
+			Stats: models.CommitStats{Files: files}}
+	}
+
+	return []models.Commit{
+		commit("c4", alice, 0, change("services/api/handler.go", 10, 2), change("services/api/internal/db.go", 5, 5)),
+		commit("c3", bob, 8, change("web/src/app.js", 40, 0)),
+		commit("c2", alice, 15, change("services/api/handler.go", 20, 0), change("README.md", 3, 0)),
+		commit("c1", bob, 30, change("services/api/go.mod", 6, 0), change("{web => web/src}/index.js", 12, 1)),
+	}
+}
+
+func TestAnalyzeDirectories(t *testing.T) {
+	directories := analyzers.NewDirectoryAnalyzer().AnalyzeDirectories(directoryCommits(), models.DirectoryOptions{Depth: 1})
+
+	if len(directories) != 3 {
+		t.Fatalf("Expected services, web and the root, got %+v", directories)
+	}
+	services := directories[0]
+	if services.Path != "services" || services.Commits != 3 || services.Files != 3 {
+		t.Errorf("Expected services first with 3 commits to 3 files, got %+v", services)
+	}
+	if services.Insertions != 41 || services.Deletions != 7 || services.Churn() != 48 {
+		t.Errorf("Expected 41 insertions and 7 deletions in services, got %+v", services)
+	}
+	if services.Authors != 2 || len(services.TopAuthors) != 2 || services.TopAuthors[0].Name != "Alice" ||
+		services.TopAuthors[0].Commits != 2 {
+		t.Errorf("Expected Alice as the top author of services, got %+v", services.TopAuthors)
+	}
+	if !services.FirstChange.Equal(time.Date(2024, 2, 3, 10, 0, 0, 0, time.UTC)) ||
+		!services.LastChange.Equal(time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected services changed from 2024-02-03 to 2024-03-04, got %s to %s", services.FirstChange, services.LastChange)
+	}
+	if services.Trend == "" || services.IsModule() {
+		t.Errorf("Expected a trend and no module without module roots, got %+v", services)
+	}
+	if len(services.WeeklyCommits) != 12 || services.WeeklyCommits[11] != 1 || services.WeeklyCommits[9] != 1 ||
+		services.WeeklyCommits[7] != 1 {
+		t.Errorf("Expected one commit in weeks 8, 10 and 12, got %v", services.WeeklyCommits)
+	}
+
+	// The renamed file counts under its new path, so web has two files
+	if directories[1].Path != "web" || directories[1].Files != 2 || directories[2].Path != models.RootDirectory {
+		t.Errorf("Expected web then the root, got %+v", directories[1:])
+	}
+}
+
+func TestAnalyzeDirectoriesDepthAndModules(t *testing.T) {
+	analyzer := analyzers.NewDirectoryAnalyzer()
+
+	directories := analyzer.AnalyzeDirectories(directoryCommits(), models.DirectoryOptions{Depth: 2})
+	paths := make(map[string]int)
+	for _, directory := range directories {
+		paths[directory.Path] = directory.Commits
+	}
+	if len(paths) != 3 || paths["services/api"] != 3 || paths["web/src"] != 2 || paths[models.RootDirectory] != 1 {
+		t.Errorf("Expected directories two segments deep, got %v", paths)
+	}
+
+	roots := analyzers.ModuleRoots([]string{"services/api/go.mod", "web/package.json", "vendor/x/go.mod",
+		"node_modules/y/package.json", "README.md"})
+	if len(roots) != 2 || roots["services/api"] != "go.mod" || roots["web"] != "package.json" {
+		t.Errorf("Expected two module roots without vendored manifests, got %v", roots)
+	}
+
+	directories = analyzer.AnalyzeDirectories(directoryCommits(), models.DirectoryOptions{Depth: 3, ModuleRoots: roots})
+	for _, directory := range directories {
+		switch directory.Path {
+		case "services/api":
+			if directory.Manifest != "go.mod" || directory.Files != 3 {
+				t.Errorf("Expected the api module to hold its nested files, got %+v", directory)
+			}
+		case "web":
+			if directory.Manifest != "package.json" || directory.Commits != 2 {
+				t.Errorf("Expected the web module with 2 commits, got %+v", directory)
+			}
+		case models.RootDirectory:
+			if directory.IsModule() {
+				t.Errorf("Expected the root outside modules, got %+v", directory)
+			}
+		default:
+			t.Errorf("Unexpected directory %q", directory.Path)
+		}
+	}
+}
+
+func TestAnalyzeDirectoriesEmpty(t *testing.T) {
+	directories := analyzers.NewDirectoryAnalyzer().AnalyzeDirectories(nil, models.DirectoryOptions{})
+	if len(directories) != 0 {
+		t.Errorf("Expected no directories without commits, got %+v", directories)
+	}
+}
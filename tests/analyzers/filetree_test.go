@@ -0,0 +1,115 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - File tree analyzer tests
+
+package analyzers
+
+import (
+	"git-stats/analyzers"
+	"git-stats/models"
+	"testing"
+	"time"
+)
+
+// fileTreeFixture returns commits by Alice and Bob to files in nested directories, including
+// a rename in each of the forms git reports
+func fileTreeFixture() []models.Commit {
+	alice := models.Author{Name: "Alice", Email: "alice@example.com"}
+	bob := models.Author{Name: "Bob", Email: "Bob@Example.com"}
+	day := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+
+	commit := func(author models.Author, at time.Time, files ...models.FileChange) models.Commit {
+		return models.Commit{Author: author, AuthorDate: at, Stats: models.CommitStats{Files: files}}
+	}
+	change := func(path string, insertions, deletions int) models.FileChange {
+		return models.FileChange{Path: path, Insertions: insertions, Deletions: deletions}
+	}
+
+	return []models.Commit{
+		commit(alice, day, change("src/api/server.go", 10, 2), change("src/api/db.go", 5, 0), change("README.md", 1, 1)),
+		commit(bob, day.AddDate(0, 0, 1), change("src/api/server.go", 3, 3)),
+		commit(bob, day.AddDate(0, 0, 2), change("src/{web => ui}/app.js", 4, 0)),
+		commit(alice, day.AddDate(0, 0, -1), change("Makefile => build.mk", 0, 0), change("", 9, 9)),
+	}
+}
+
+func TestBuildFileTree(t *testing.T) {
+	root := analyzers.NewFileTreeAnalyzer().BuildFileTree(fileTreeFixture())
+
+	// The root counts every commit once, however many files it changed
+	if !root.IsDir || root.Path != "" || root.Commits != 4 || root.Insertions != 23 || root.Deletions != 6 || root.Churn() != 29 {
+		t.Errorf("Unexpected root totals: %+v", root)
+	}
+	if root.Authors != 2 || !root.LastModified.Equal(time.Date(2024, 5, 3, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("Unexpected root authors or last change: %+v", root)
+	}
+
+	src := root.Find("src")
+	if src == nil || !src.IsDir || src.Commits != 3 || src.Insertions != 22 || src.Deletions != 5 {
+		t.Fatalf("Unexpected src totals: %+v", src)
+	}
+
+	api := root.Find("src/api")
+	if api == nil || api.Commits != 2 || api.Authors != 2 || api.Path != "src/api" {
+		t.Fatalf("Unexpected src/api totals: %+v", api)
+	}
+
+	server := root.Find("src/api/server.go")
+	if server == nil || server.IsDir || server.Commits != 2 || server.Churn() != 18 || server.Name != "server.go" {
+		t.Errorf("Unexpected server.go totals: %+v", server)
+	}
+
+	// Renamed files are counted under their new path
+	if root.Find("src/ui/app.js") == nil || root.Find("src/web") != nil || root.Find("build.mk") == nil || root.Find("Makefile") != nil {
+		t.Error("Expected renames to be counted under their new paths")
+	}
+	if root.Find("src/nope.go") != nil {
+		t.Error("Find should return nil for paths outside the tree")
+	}
+}
+
+func TestBuildFileTree_TopAuthor(t *testing.T) {
+	root := analyzers.NewFileTreeAnalyzer().BuildFileTree(fileTreeFixture())
+
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"", "Alice"},                  // Alice has 2 commits, Bob 2; ties go to the smaller email
+		{"src", "Bob"},                 // Bob has 2 commits below src, Alice 1
+		{"src/api/db.go", "Alice"},     // only Alice
+		{"src/api/server.go", "Alice"}, // one commit each; ties go to the smaller email
+	}
+	for _, tt := range tests {
+		if node := root.Find(tt.path); node == nil || node.TopAuthor != tt.want {
+			t.Errorf("Top author of %q: got %+v, want %s", tt.path, node, tt.want)
+		}
+	}
+}
+
+func TestBuildFileTree_Order(t *testing.T) {
+	root := analyzers.NewFileTreeAnalyzer().BuildFileTree(fileTreeFixture())
+
+	// Directories come first, then files, each by name
+	want := []string{"src", "README.md", "build.mk"}
+	if len(root.Children) != len(want) {
+		t.Fatalf("Expected %v below the root, got %d children", want, len(root.Children))
+	}
+	for i, name := range want {
+		if root.Children[i].Name != name {
+			t.Errorf("Child %d: got %s, want %s", i, root.Children[i].Name, name)
+		}
+	}
+
+	src := root.Find("src")
+	if len(src.Children) != 2 || src.Children[0].Name != "api" || src.Children[1].Name != "ui" {
+		t.Errorf("Expected api and ui below src, got %+v", src.Children)
+	}
+	api := root.Find("src/api")
+	if len(api.Children) != 2 || api.Children[0].Name != "db.go" || api.Children[1].Name != "server.go" {
+		t.Errorf("Expected db.go and server.go below src/api, got %+v", api.Children)
+	}
+
+	if empty := analyzers.NewFileTreeAnalyzer().BuildFileTree(nil); empty.Commits != 0 || len(empty.Children) != 0 {
+		t.Errorf("Expected an empty root without commits, got %+v", empty)
+	}
+}
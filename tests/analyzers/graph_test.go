@@ -0,0 +1,140 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Commit graph layout tests
+
+package analyzers
+
+import (
+	"git-stats/analyzers"
+	"git-stats/models"
+	"testing"
+	"time"
+)
+
+// graphCommit returns a commit made minute minutes into 2024 with the given parents
+func graphCommit(hash string, minute int, parents ...string) models.Commit {
+	at := time.Date(2024, 1, 1, 0, minute, 0, 0, time.UTC)
+	return models.Commit{Hash: hash, Message: "commit " + hash, AuthorDate: at, CommitterDate: at, ParentHashes: parents}
+}
+
+// graphRow is the expected layout of a row
+type graphRow struct {
+	hash   string
+	lane   int
+	graph  string
+	linear bool
+}
+
+func TestBuildGraph(t *testing.T) {
+	tests := []struct {
+		name     string
+		commits  []models.Commit
+		refs     map[string][]string
+		maxLanes int
+		rows     []graphRow
+	}{
+		{
+			name:     "linear history",
+			commits:  []models.Commit{graphCommit("a", 1), graphCommit("b", 2, "a"), graphCommit("c", 3, "b")},
+			maxLanes: 1,
+			rows:     []graphRow{{"c", 0, "●", false}, {"b", 0, "●", true}, {"a", 0, "●", false}}, // lanes start at tips
+		},
+		{
+			name:     "refs end a linear run",
+			commits:  []models.Commit{graphCommit("a", 1), graphCommit("b", 2, "a"), graphCommit("c", 3, "b")},
+			refs:     map[string][]string{"b": {"v1.0"}},
+			maxLanes: 1,
+			rows:     []graphRow{{"c", 0, "●", false}, {"b", 0, "●", false}, {"a", 0, "●", false}},
+		},
+		{
+			name: "merge",
+			commits: []models.Commit{graphCommit("a", 1), graphCommit("b", 2, "a"), graphCommit("c", 3, "a"),
+				graphCommit("d", 4, "b", "c")},
+			maxLanes: 2,
+			rows: []graphRow{
+				{"d", 0, "●─╮", false}, // the second parent forks a lane
+				{"c", 1, "│ ●", true},
+				{"b", 0, "● │", true},
+				{"a", 0, "●─┘", false}, // both lanes end at the common parent
+			},
+		},
+		{
+			name: "octopus merge",
+			commits: []models.Commit{graphCommit("a", 1), graphCommit("b", 2, "a"), graphCommit("c", 3, "a"),
+				graphCommit("d", 4, "a"), graphCommit("e", 5, "b", "c", "d")},
+			maxLanes: 3,
+			rows: []graphRow{
+				{"e", 0, "●─┬─╮", false},
+				{"d", 2, "│ │ ●", true},
+				{"c", 1, "│ ● │", true},
+				{"b", 0, "● │ │", true},
+				{"a", 0, "●─┴─┘", false},
+			},
+		},
+		{
+			name: "branches merged later",
+			commits: []models.Commit{graphCommit("a", 1), graphCommit("b", 2, "a"), graphCommit("c", 3, "a"),
+				graphCommit("m", 5, "b"), graphCommit("n", 6, "c")},
+			maxLanes: 2,
+			rows: []graphRow{
+				{"n", 0, "●", false},
+				{"m", 1, "│ ●", false},
+				{"c", 0, "● │", true},
+				{"b", 1, "│ ●", true},
+				{"a", 0, "●─┘", false},
+			},
+		},
+		{
+			name: "parents cut off by the range",
+			commits: []models.Commit{graphCommit("b", 2, "x"), graphCommit("c", 3, "b"),
+				graphCommit("m", 4, "c", "y")},
+			maxLanes: 1,
+			rows: []graphRow{
+				{"m", 0, "●", false}, // a merge whose second parent is outside the range draws no fork
+				{"c", 0, "●", true},
+				{"b", 0, "●", false}, // its parent x is outside the range, so the lane ends
+			},
+		},
+		{
+			name: "input order and duplicates do not matter",
+			commits: []models.Commit{graphCommit("a", 1), graphCommit("c", 3, "b"), graphCommit("b", 2, "a"),
+				graphCommit("c", 3, "b")},
+			maxLanes: 1,
+			rows:     []graphRow{{"c", 0, "●", false}, {"b", 0, "●", true}, {"a", 0, "●", false}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			graph := analyzers.NewCommitGraphAnalyzer().BuildGraph(tt.commits, tt.refs)
+			if graph.MaxLanes != tt.maxLanes {
+				t.Errorf("MaxLanes = %d, want %d", graph.MaxLanes, tt.maxLanes)
+			}
+			if len(graph.Rows) != len(tt.rows) {
+				t.Fatalf("Got %d rows, want %d", len(graph.Rows), len(tt.rows))
+			}
+			for i, want := range tt.rows {
+				row := graph.Rows[i]
+				if row.Commit.Hash != want.hash || row.Lane != want.lane || row.Graph != want.graph || row.Linear != want.linear {
+					t.Errorf("Row %d = %s lane %d %q linear %v, want %s lane %d %q linear %v", i,
+						row.Commit.Hash, row.Lane, row.Graph, row.Linear, want.hash, want.lane, want.graph, want.linear)
+				}
+			}
+		})
+	}
+}
+
+func TestBuildGraph_RefsAndMerges(t *testing.T) {
+	commits := []models.Commit{graphCommit("a", 1), graphCommit("b", 2, "a"), graphCommit("c", 3, "a"), graphCommit("d", 4, "b", "c")}
+	graph := analyzers.NewCommitGraphAnalyzer().BuildGraph(commits, map[string][]string{"d": {"HEAD -> main", "origin/main"}})
+
+	if refs := graph.Rows[0].Refs; len(refs) != 2 || refs[0] != "HEAD -> main" {
+		t.Errorf("Expected the refs of d, got %v", refs)
+	}
+	if !graph.Rows[0].Merge() || graph.Rows[1].Merge() {
+		t.Error("Only d is a merge")
+	}
+
+	if empty := analyzers.NewCommitGraphAnalyzer().BuildGraph(nil, nil); len(empty.Rows) != 0 || empty.MaxLanes != 0 {
+		t.Errorf("Expected an empty graph, got %+v", empty)
+	}
+}
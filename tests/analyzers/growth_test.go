@@ -0,0 +1,124 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Code growth analyzer tests
+
+package analyzers
+
+import (
+	"git-stats/analyzers"
+	"git-stats/models"
+	"testing"
+	"time"
+)
+
+// growthCommits returns commits in three consecutive weeks, the middle one without changes
+func growthCommits() []models.Commit {
+	monday := time.Date(2024, 1, 8, 10, 0, 0, 0, time.UTC)
+	change := func(path string, insertions, deletions int) models.FileChange {
+		return models.FileChange{Path: path, Insertions: insertions, Deletions: deletions}
+	}
+
+	return []models.Commit{
+		{Hash: "c3", AuthorDate: monday.AddDate(0, 0, 16), Stats: models.CommitStats{Files: []models.FileChange{
+			change("src/main.go", 5, 20),
+			change("{src => lib}/util.go", 2, 0),
+		}}},
+		{Hash: "c1", AuthorDate: monday, Stats: models.CommitStats{Files: []models.FileChange{
+			change("src/main.go", 100, 0),
+			change("README.md", 10, 0),
+			change("go.sum", 40, 0),
+			change("vendor/github.com/x/y.go", 500, 0),
+			change("api/service.pb.go", 300, 0),
+		}}},
+		{Hash: "c2", AuthorDate: monday.AddDate(0, 0, 2), Stats: models.CommitStats{Files: []models.FileChange{
+			change("src/util.go", 30, 0),
+			change("docs/guide.md", 8, 0),
+		}}},
+	}
+}
+
+func TestAnalyzeGrowth(t *testing.T) {
+	growth := analyzers.NewGrowthAnalyzer().AnalyzeGrowth(growthCommits(), models.GrowthOptions{})
+
+	if growth.Interval != models.GrowthWeek {
+		t.Errorf("Expected weekly growth by default, got %q", growth.Interval)
+	}
+	if len(growth.Groups) != 0 {
+		t.Errorf("Expected no groups without a breakdown, got %d", len(growth.Groups))
+	}
+
+	points := growth.Total.Points
+	if len(points) != 3 {
+		t.Fatalf("Expected a point for each of 3 weeks, got %d", len(points))
+	}
+	if !points[0].Period.Equal(time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the first week to start on 2024-01-08, got %s", points[0].Period)
+	}
+	if points[0].Total != 988 || points[1].Net != 0 || points[1].Total != 988 {
+		t.Errorf("Expected 988 lines after the first week and none added in the second, got %+v", points[:2])
+	}
+	if points[2].Insertions != 7 || points[2].Deletions != 20 || points[2].Net != -13 || points[2].Total != 975 {
+		t.Errorf("Expected 7 added and 20 deleted in the last week, got %+v", points[2])
+	}
+}
+
+func TestAnalyzeGrowthExclusions(t *testing.T) {
+	analyzer := analyzers.NewGrowthAnalyzer()
+
+	growth := analyzer.AnalyzeGrowth(growthCommits(), models.GrowthOptions{
+		Exclude: []string{models.ExcludeGenerated, models.ExcludeVendored, models.ExcludeLockfiles},
+	})
+	if growth.Total.Final() != 135 {
+		t.Errorf("Expected 135 net lines without generated, vendored and lockfile paths, got %d", growth.Total.Final())
+	}
+	if growth.ExcludedFiles != 3 || growth.ExcludedLines != 840 {
+		t.Errorf("Expected 3 excluded files with 840 lines, got %d and %d", growth.ExcludedFiles, growth.ExcludedLines)
+	}
+
+	growth = analyzer.AnalyzeGrowth(growthCommits(), models.GrowthOptions{Exclude: []string{"*.md", "vendor/", "api/*.go"}})
+	if growth.Total.Final() != 157 {
+		t.Errorf("Expected 157 net lines without markdown, vendor/ and api/*.go, got %d", growth.Total.Final())
+	}
+}
+
+func TestAnalyzeGrowthBreakdown(t *testing.T) {
+	analyzer := analyzers.NewGrowthAnalyzer()
+	options := models.GrowthOptions{
+		Interval:  models.GrowthMonth,
+		Breakdown: models.GrowthByDirectory,
+		Exclude:   []string{models.ExcludeVendored},
+	}
+
+	growth := analyzer.AnalyzeGrowth(growthCommits(), options)
+	if len(growth.Total.Points) != 1 {
+		t.Fatalf("Expected one month, got %d points", len(growth.Total.Points))
+	}
+
+	want := []struct {
+		name  string
+		final int
+	}{{"api", 300}, {"src", 115}, {"(root)", 50}, {"docs", 8}, {"lib", 2}}
+	if len(growth.Groups) != len(want) {
+		t.Fatalf("Expected %d directories, got %+v", len(want), growth.Groups)
+	}
+	for i, w := range want {
+		if growth.Groups[i].Name != w.name || growth.Groups[i].Final() != w.final {
+			t.Errorf("Group %d: expected %s with %d lines, got %s with %d", i, w.name, w.final, growth.Groups[i].Name, growth.Groups[i].Final())
+		}
+	}
+
+	options.Breakdown = models.GrowthByExtension
+	growth = analyzer.AnalyzeGrowth(growthCommits(), options)
+	if len(growth.Groups) != 3 || growth.Groups[0].Name != "go" || growth.Groups[0].Final() != 417 {
+		t.Fatalf("Expected go files first with 417 lines, got %+v", growth.Groups)
+	}
+	if growth.Groups[1].Name != "sum" || growth.Groups[2].Name != "md" || growth.Groups[2].Final() != 18 {
+		t.Errorf("Expected go.sum's extension before the 18 lines of markdown, got %s and %s", growth.Groups[1].Name, growth.Groups[2].Name)
+	}
+}
+
+func TestAnalyzeGrowthEmpty(t *testing.T) {
+	growth := analyzers.NewGrowthAnalyzer().AnalyzeGrowth(nil, models.GrowthOptions{Interval: models.GrowthDay})
+	if growth == nil || len(growth.Total.Points) != 0 || growth.Interval != models.GrowthDay {
+		t.Errorf("Expected an empty daily growth, got %+v", growth)
+	}
+}
@@ -396,6 +396,134 @@ func TestAnalyzeStatistics_ExcludeMerges(t *testing.T) {
 }
 
 // Benchmark tests
+func TestAnalyzeContributors(t *testing.T) {
+	analyzer := analyzers.NewStatisticsAnalyzer()
+	base := time.Date(2024, 3, 4, 10, 0, 0, 0, time.UTC)
+
+	commits := []models.Commit{
+		{
+			Hash:       "a1",
+			Author:     models.Author{Name: "Alice", Email: "alice@example.com"},
+			AuthorDate: base,
+			Stats: models.CommitStats{Insertions: 10, Deletions: 2, Files: []models.FileChange{
+				{Path: "main.go"}, {Path: "README.md"},
+			}},
+		},
+		{
+			Hash:       "a2",
+			Author:     models.Author{Name: "Alice A.", Email: "ALICE@example.com"},
+			AuthorDate: base.AddDate(0, 0, 2),
+			Stats:      models.CommitStats{Insertions: 5, Deletions: 1, Files: []models.FileChange{{Path: "main.go"}}},
+		},
+		{
+			Hash:       "b1",
+			Author:     models.Author{Name: "Bob", Email: "bob@example.com"},
+			AuthorDate: base.AddDate(0, 0, 1),
+			Stats:      models.CommitStats{Insertions: 3},
+		},
+	}
+
+	contributors := analyzer.AnalyzeContributors(commits)
+	if len(contributors) != 2 {
+		t.Fatalf("Expected 2 contributors, got %d", len(contributors))
+	}
+
+	alice := contributors[0]
+	if alice.Email != "alice@example.com" || alice.TotalCommits != 2 {
+		t.Errorf("Expected Alice first with 2 commits, got %+v", alice)
+	}
+	if alice.TotalInsertions != 15 || alice.TotalDeletions != 3 {
+		t.Errorf("Unexpected line totals: +%d/-%d", alice.TotalInsertions, alice.TotalDeletions)
+	}
+	if !alice.FirstCommit.Equal(base) || !alice.LastCommit.Equal(base.AddDate(0, 0, 2)) {
+		t.Errorf("Unexpected commit range: %v - %v", alice.FirstCommit, alice.LastCommit)
+	}
+	if alice.ActiveDays != 2 {
+		t.Errorf("Expected 2 active days, got %d", alice.ActiveDays)
+	}
+	if len(alice.TopFiles) == 0 || alice.TopFiles[0] != "main.go" {
+		t.Errorf("Expected main.go as top file, got %v", alice.TopFiles)
+	}
+
+	if contributors[1].Name != "Bob" || contributors[1].TotalCommits != 1 {
+		t.Errorf("Expected Bob second with 1 commit, got %+v", contributors[1])
+	}
+
+	if empty := analyzer.AnalyzeContributors(nil); len(empty) != 0 {
+		t.Errorf("Expected no contributors for no commits, got %d", len(empty))
+	}
+}
+
+func TestAnalyzePunchCard(t *testing.T) {
+	analyzer := analyzers.NewStatisticsAnalyzer()
+	monday := time.Date(2024, 3, 4, 10, 15, 0, 0, time.UTC)
+
+	commits := []models.Commit{
+		{Hash: "a1", Author: models.Author{Name: "Alice", Email: "alice@example.com"}, AuthorDate: monday},
+		{Hash: "a2", Author: models.Author{Name: "Alice", Email: "alice@example.com"}, AuthorDate: monday.Add(20 * time.Minute)},
+		{Hash: "b1", Author: models.Author{Name: "Bob", Email: "bob@example.com"}, AuthorDate: monday.AddDate(0, 0, 2).Add(12 * time.Hour)},
+	}
+
+	summary, err := analyzer.AnalyzeStatistics(commits, models.AnalysisConfig{IncludeMerges: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if summary.PunchCard.Total() != 3 || summary.PunchCard[time.Monday][10] != 2 || summary.PunchCard[time.Wednesday][22] != 1 {
+		t.Errorf("Unexpected summary punch card: %v", summary.PunchCard)
+	}
+
+	contributors := analyzer.AnalyzeContributors(commits)
+	if len(contributors) != 2 {
+		t.Fatalf("Expected 2 contributors, got %d", len(contributors))
+	}
+	if card := contributors[0].PunchCard; card.Total() != 2 || card[time.Monday][10] != 2 {
+		t.Errorf("Unexpected punch card for Alice: %v", card)
+	}
+	if card := contributors[1].PunchCard; card.Total() != 1 || card[time.Wednesday][22] != 1 {
+		t.Errorf("Unexpected punch card for Bob: %v", card)
+	}
+}
+
+func TestAnalyzeWeeklyActivity(t *testing.T) {
+	analyzer := analyzers.NewStatisticsAnalyzer()
+	wednesday := time.Date(2024, 3, 6, 10, 0, 0, 0, time.UTC)
+
+	commits := []models.Commit{
+		{Hash: "c3", AuthorDate: wednesday.AddDate(0, 0, 14), Stats: models.CommitStats{Insertions: 1, Deletions: 9}},
+		{Hash: "c2", AuthorDate: wednesday.AddDate(0, 0, 1), Stats: models.CommitStats{Insertions: 5}},
+		{Hash: "c1", AuthorDate: wednesday, Stats: models.CommitStats{Insertions: 10, Deletions: 2}},
+	}
+
+	weeks := analyzer.AnalyzeWeeklyActivity(commits)
+	if len(weeks) != 3 {
+		t.Fatalf("Expected 3 weeks including the quiet one, got %d", len(weeks))
+	}
+	if !weeks[0].Week.Equal(time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected the first week to start on Monday 2024-03-04, got %s", weeks[0].Week)
+	}
+	if weeks[0].Commits != 2 || weeks[0].NetLines() != 13 {
+		t.Errorf("Unexpected first week: %+v", weeks[0])
+	}
+	if weeks[1].Commits != 0 {
+		t.Errorf("Expected a quiet second week, got %+v", weeks[1])
+	}
+	if weeks[2].Commits != 1 || weeks[2].NetLines() != -8 {
+		t.Errorf("Unexpected last week: %+v", weeks[2])
+	}
+
+	summary, err := analyzer.AnalyzeStatistics(commits, models.AnalysisConfig{IncludeMerges: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(summary.CommitsByWeek) != 3 {
+		t.Errorf("Expected the summary to hold 3 weeks, got %d", len(summary.CommitsByWeek))
+	}
+
+	if empty := analyzer.AnalyzeWeeklyActivity(nil); len(empty) != 0 {
+		t.Errorf("Expected no weeks for no commits, got %d", len(empty))
+	}
+}
+
 func BenchmarkAnalyzeStatistics(b *testing.B) {
 	analyzer := analyzers.NewStatisticsAnalyzer()
 
@@ -0,0 +1,104 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Team analyzer tests
+
+package analyzers
+
+import (
+	"git-stats/analyzers"
+	"git-stats/models"
+	"testing"
+	"time"
+)
+
+// teamFixture returns two teams, Alice moving from platform to web on 2024-03-04, and commits
+// by Alice, Bob and an author outside both teams
+func teamFixture() (models.Teams, []models.Commit) {
+	switched := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+	teams := models.Teams{
+		{Name: "platform", Members: []models.TeamMember{{Pattern: "alice@example.com", Until: switched.AddDate(0, 0, -1)}}},
+		{Name: "web", Members: []models.TeamMember{{Pattern: "alice@example.com", Since: switched}, {Pattern: "bob@*"}}},
+	}
+
+	alice := models.Author{Name: "Alice", Email: "alice@example.com"}
+	bob := models.Author{Name: "Bob", Email: "bob@example.com"}
+	eve := models.Author{Name: "Eve", Email: "eve@example.com"}
+	commit := func(hash string, author models.Author, at time.Time, files ...string) models.Commit {
+		changes := make([]models.FileChange, len(files))
+		for i, file := range files {
+			changes[i] = models.FileChange{Path: file, Insertions: 10, Deletions: 2}
+		}
+		return models.Commit{Hash: hash, Author: author, AuthorDate: at, Stats: models.CommitStats{Files: changes}}
+	}
+
+	return teams, []models.Commit{
+		commit("c5", eve, switched.AddDate(0, 0, 3), "docs/guide.md"),
+		commit("c4", bob, switched.AddDate(0, 0, 2), "web/app.js", "api/server.go"),
+		commit("c3", alice, switched.Add(10*time.Hour), "web/app.js"),
+		commit("c2", alice, switched.AddDate(0, 0, -1).Add(10*time.Hour), "api/server.go"),
+		commit("c1", alice, switched.AddDate(0, 0, -1).Add(9*time.Hour), "api/server.go", "api/db.go"),
+	}
+}
+
+func TestAnalyzeTeams(t *testing.T) {
+	teams, commits := teamFixture()
+	stats := analyzers.NewTeamAnalyzer().AnalyzeTeams(commits, teams)
+
+	if len(stats) != 3 || stats[0].Name != "platform" || stats[1].Name != "web" || stats[2].Name != models.NoTeam {
+		t.Fatalf("Expected platform, web and the authors outside teams, got %+v", stats)
+	}
+
+	platform, web := stats[0], stats[1]
+	if platform.Commits != 2 || platform.Members != 1 || platform.ActiveDays != 1 || platform.Files != 2 {
+		t.Errorf("Expected Alice's 2 commits on one day to 2 files for platform, got %+v", platform)
+	}
+	if platform.Insertions != 30 || platform.Deletions != 6 || platform.Churn() != 36 {
+		t.Errorf("Expected 30 insertions and 6 deletions for platform, got %+v", platform)
+	}
+	if platform.OwnedFiles != 2 || platform.Ownership != 50 || platform.CommitShare != 40 {
+		t.Errorf("Expected platform to own api/server.go and api/db.go, got %+v", platform)
+	}
+	if web.Commits != 2 || web.Members != 2 || web.ActiveDays != 2 || web.OwnedFiles != 1 {
+		t.Errorf("Expected Alice and Bob in web owning web/app.js, got %+v", web)
+	}
+	if !web.FirstCommit.Equal(commits[2].AuthorDate) || !web.LastCommit.Equal(commits[1].AuthorDate) {
+		t.Errorf("Expected web active from %s to %s, got %+v", commits[2].AuthorDate, commits[1].AuthorDate, web)
+	}
+
+	if len(analyzers.NewTeamAnalyzer().AnalyzeTeams(nil, teams)) != 0 {
+		t.Errorf("Expected no teams without commits")
+	}
+}
+
+func TestTeamCommits(t *testing.T) {
+	teams, commits := teamFixture()
+
+	web := analyzers.TeamCommits(commits, teams, "Web")
+	if len(web) != 2 || web[0].Hash != "c4" || web[1].Hash != "c3" {
+		t.Errorf("Expected Bob's and Alice's commits after the switch, got %+v", web)
+	}
+	if outside := analyzers.TeamCommits(commits, teams, models.NoTeam); len(outside) != 1 || outside[0].Hash != "c5" {
+		t.Errorf("Expected Eve's commit outside teams, got %+v", outside)
+	}
+}
+
+func TestAnalyzeTeamContributions(t *testing.T) {
+	teams, commits := teamFixture()
+	analyzer := analyzers.NewContributionAnalyzer()
+	config := models.AnalysisConfig{TimeRange: models.TimeRange{
+		Start: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		End:   time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC),
+	}}
+
+	graph, err := analyzer.AnalyzeContributions(commits, config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	analyzer.AnalyzeTeams(graph, commits, config, teams)
+
+	if !graph.ByTeam || graph.AuthorNoun() != "team" || len(graph.Authors) != 3 {
+		t.Fatalf("Expected three teams in the graph, got %+v", graph.Authors)
+	}
+	if graph.Authors[0].Name != "platform" || graph.Authors[0].Total != 2 || graph.Authors[0].Daily["2024-03-03"] != 2 {
+		t.Errorf("Expected platform's 2 commits on 2024-03-03 first, got %+v", graph.Authors[0])
+	}
+}
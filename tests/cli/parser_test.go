@@ -8,6 +8,7 @@ import (
 	"git-stats/cli"
 	"os"
 	"path/filepath"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -415,9 +416,16 @@ func TestCLIValidator_ValidateFormat(t *testing.T) {
 		{"terminal format", "terminal", false},
 		{"json format", "json", false},
 		{"csv format", "csv", false},
+		{"xlsx format", "xlsx", false},
+		{"template format", "template", false},
 		{"uppercase format", "JSON", false},
 		{"empty format", "", true},
 		{"invalid format", "xml", true},
+		{"multiple formats", "json,terminal,csv", false},
+		{"multiple formats with spaces", "json, csv", false},
+		{"duplicate format", "json,json", true},
+		{"invalid format in list", "json,xml", true},
+		{"empty entry in list", "json,", true},
 	}
 
 	for _, tt := range tests {
@@ -466,6 +474,121 @@ func TestCLIValidator_ValidateLimit(t *testing.T) {
 	}
 }
 
+func TestCLIValidator_ValidateTemplate(t *testing.T) {
+	validator := cli.NewCLIValidator()
+
+	tmplFile := filepath.Join(t.TempDir(), "report.tmpl")
+	if err := os.WriteFile(tmplFile, []byte("{{.Summary.TotalCommits}}"), 0644); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		format    string
+		template  string
+		expectErr bool
+	}{
+		{"no template for json", "json", "", false},
+		{"built-in template name", "template", "slack", false},
+		{"template file", "template", tmplFile, false},
+		{"missing template", "template", "", true},
+		{"missing template file", "template", "/nonexistent/report.tmpl", true},
+		{"template without template format", "json", "slack", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.ValidateTemplate(tt.format, tt.template)
+
+			if tt.expectErr && err == nil {
+				t.Error("Expected validation error")
+			}
+
+			if !tt.expectErr && err != nil {
+				t.Errorf("Unexpected validation error: %v", err)
+			}
+		})
+	}
+}
+
+func TestConfig_Formats(t *testing.T) {
+	tests := []struct {
+		format   string
+		expected []string
+	}{
+		{"terminal", []string{"terminal"}},
+		{"json,terminal,csv", []string{"json", "terminal", "csv"}},
+		{"json, csv", []string{"json", "csv"}},
+	}
+
+	for _, tt := range tests {
+		config := &cli.Config{Format: tt.format}
+		if got := config.Formats(); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("Formats() for %q = %v, want %v", tt.format, got, tt.expected)
+		}
+	}
+}
+
+func TestMultiFormatOutputPath(t *testing.T) {
+	tests := []struct {
+		base     string
+		format   string
+		expected string
+	}{
+		{"report", "json", "report.json"},
+		{"report", "terminal", "report.txt"},
+		{"out/report.txt", "csv", "out/report.csv"},
+		{"report", "xlsx", "report.xlsx"},
+		{"report", "template", "report.template.txt"},
+	}
+
+	for _, tt := range tests {
+		if got := cli.MultiFormatOutputPath(tt.base, tt.format); got != tt.expected {
+			t.Errorf("MultiFormatOutputPath(%q, %q) = %q, want %q", tt.base, tt.format, got, tt.expected)
+		}
+	}
+}
+
+func TestCLIValidator_ValidateCSVOptions(t *testing.T) {
+	validator := cli.NewCLIValidator()
+	tempDir := t.TempDir()
+
+	tests := []struct {
+		name      string
+		config    *cli.Config
+		expectErr bool
+	}{
+		{"default dialect", &cli.Config{Format: "csv"}, false},
+		{"excel dialect to stdout", &cli.Config{Format: "csv", CSVDialect: "excel"}, false},
+		{"unknown dialect", &cli.Config{Format: "csv", CSVDialect: "tsv"}, true},
+		{"excel dialect with json format", &cli.Config{Format: "json", CSVDialect: "excel"}, true},
+		{"default dialect with terminal format", &cli.Config{Format: "terminal", CSVDialect: "default"}, false},
+		{"excel dialect among several formats", &cli.Config{Format: "json,csv", CSVDialect: "excel", OutputFile: filepath.Join(tempDir, "report")}, false},
+		{"split into existing directory", &cli.Config{Format: "csv", OutputFile: tempDir}, false},
+		{"split into new directory", &cli.Config{Format: "csv", OutputFile: filepath.Join(tempDir, "tables") + "/"}, false},
+		{"split into zip", &cli.Config{Format: "csv", OutputFile: filepath.Join(tempDir, "report.zip")}, false},
+		{"split without output", &cli.Config{Format: "csv", CSVSplit: true}, true},
+		{"split with json format", &cli.Config{Format: "json", CSVSplit: true, OutputFile: tempDir}, true},
+		{"rfc4180 single stream", &cli.Config{Format: "csv", CSVDialect: "rfc4180"}, true},
+		{"rfc4180 split", &cli.Config{Format: "csv", CSVDialect: "rfc4180", OutputFile: tempDir}, false},
+		{"split into missing parent", &cli.Config{Format: "csv", OutputFile: "/nonexistent/dir/tables/"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validator.ValidateCSVOptions(tt.config)
+
+			if tt.expectErr && err == nil {
+				t.Error("Expected validation error")
+			}
+
+			if !tt.expectErr && err != nil {
+				t.Errorf("Unexpected validation error: %v", err)
+			}
+		})
+	}
+}
+
 func TestCLIValidator_ValidateRepositoryPath(t *testing.T) {
 	validator := cli.NewCLIValidator()
 
@@ -796,3 +919,437 @@ func createTempGitRepo(t *testing.T) string {
 
 	return tempDir
 }
+
+func TestCLIParser_Parse_YearFlags(t *testing.T) {
+	validator := cli.NewCLIValidator()
+	parser := cli.NewCLIParser(validator)
+
+	tempDir := createTempGitRepo(t)
+	defer os.RemoveAll(tempDir)
+
+	config, err := parser.Parse([]string{"-years", "2021-2023", tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if !config.HasYears() || config.FromYear != 2021 || config.ToYear != 2023 {
+		t.Errorf("Expected years 2021-2023, got %d-%d", config.FromYear, config.ToYear)
+	}
+
+	expectedSince := time.Date(2021, time.January, 1, 0, 0, 0, 0, time.UTC)
+	if config.Since == nil || !config.Since.Equal(expectedSince) {
+		t.Errorf("Expected since %v, got %v", expectedSince, config.Since)
+	}
+
+	expectedUntil := time.Date(2023, time.December, 31, 23, 59, 59, 0, time.UTC)
+	if config.Until == nil || !config.Until.Equal(expectedUntil) {
+		t.Errorf("Expected until %v, got %v", expectedUntil, config.Until)
+	}
+
+	config, err = parser.Parse([]string{"-year", "2022", tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if config.FromYear != 2022 || config.ToYear != 2022 {
+		t.Errorf("Expected year 2022, got %d-%d", config.FromYear, config.ToYear)
+	}
+
+	invalid := [][]string{
+		{"-years", "2023-2021"},
+		{"-years", "twenty"},
+		{"-year", "2022", "-years", "2021-2022"},
+		{"-year", "2022", "-since", "2022-01-01"},
+		{"-year", "1900"},
+		{"-year", fmt.Sprint(time.Now().Year() + 1)},
+	}
+
+	for _, args := range invalid {
+		if _, err := parser.Parse(append(args, tempDir)); err == nil {
+			t.Errorf("Expected error for %v", args)
+		}
+	}
+}
+
+func TestCLIParser_Parse_MetricFlag(t *testing.T) {
+	validator := cli.NewCLIValidator()
+	parser := cli.NewCLIParser(validator)
+
+	tempDir := createTempGitRepo(t)
+	defer os.RemoveAll(tempDir)
+
+	config, err := parser.Parse([]string{tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.Metric != "commits" {
+		t.Errorf("Expected default metric 'commits', got '%s'", config.Metric)
+	}
+
+	config, err = parser.Parse([]string{"-metric", "Lines", tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.Metric != "lines" {
+		t.Errorf("Expected metric 'lines', got '%s'", config.Metric)
+	}
+
+	if _, err := parser.Parse([]string{"-metric", "stars", tempDir}); err == nil || !strings.Contains(err.Error(), "invalid metric") {
+		t.Errorf("Expected invalid metric error, got %v", err)
+	}
+}
+
+func TestCLIParser_Parse_LevelsFlag(t *testing.T) {
+	validator := cli.NewCLIValidator()
+	parser := cli.NewCLIParser(validator)
+
+	tempDir := createTempGitRepo(t)
+	defer os.RemoveAll(tempDir)
+
+	config, err := parser.Parse([]string{"-levels", "quantile", tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.Levels != "quantile" || config.Thresholds != nil {
+		t.Errorf("Expected quantile levels, got %s %v", config.Levels, config.Thresholds)
+	}
+
+	config, err = parser.Parse([]string{"-levels", "1, 5, 10", tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.Levels != "fixed" || !reflect.DeepEqual(config.Thresholds, []int{1, 5, 10}) {
+		t.Errorf("Expected fixed levels 1,5,10, got %s %v", config.Levels, config.Thresholds)
+	}
+
+	for _, levels := range []string{"fixed", "1,5", "5,1,10", "0,1,2", "steps", "1,x,3"} {
+		if _, err := parser.Parse([]string{"-levels", levels, tempDir}); err == nil {
+			t.Errorf("Expected error for -levels %s", levels)
+		}
+	}
+
+	// Fixed levels are only chosen by thresholds, so the valid names leave them out
+	if _, err := parser.Parse([]string{"-levels", "steps", tempDir}); err == nil || strings.Contains(err.Error(), "fixed") {
+		t.Errorf("Expected an error listing only named strategies, got %v", err)
+	}
+}
+
+func TestCLIParser_Parse_ColorFlag(t *testing.T) {
+	validator := cli.NewCLIValidator()
+	parser := cli.NewCLIParser(validator)
+
+	tempDir := createTempGitRepo(t)
+	defer os.RemoveAll(tempDir)
+
+	tests := []struct {
+		args     []string
+		expected string
+	}{
+		{[]string{tempDir}, "auto"},
+		{[]string{"-color", "always", tempDir}, "always"},
+		{[]string{"-color=NEVER", tempDir}, "never"},
+		{[]string{"-no-color", tempDir}, "never"},
+		{[]string{"-no-color", "-color", "always", tempDir}, "never"},
+	}
+
+	for _, tt := range tests {
+		config, err := parser.Parse(tt.args)
+		if err != nil {
+			t.Fatalf("Unexpected error for %v: %v", tt.args, err)
+		}
+		if config.Color != tt.expected {
+			t.Errorf("%v: expected color mode %s, got %s", tt.args, tt.expected, config.Color)
+		}
+	}
+
+	if _, err := parser.Parse([]string{"-color", "sometimes", tempDir}); err == nil {
+		t.Error("Expected error for -color sometimes")
+	}
+}
+
+func TestCLIParser_Parse_PunchCard(t *testing.T) {
+	validator := cli.NewCLIValidator()
+	parser := cli.NewCLIParser(validator)
+
+	tempDir := createTempGitRepo(t)
+	defer os.RemoveAll(tempDir)
+
+	config, err := parser.Parse([]string{"-punchcard", "-format", "svg", tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.Command != "punchcard" || config.Format != "svg" {
+		t.Errorf("Expected punchcard command with svg format, got %s/%s", config.Command, config.Format)
+	}
+
+	if _, err := parser.Parse([]string{"-punchcard", "-graph", tempDir}); err == nil {
+		t.Error("Expected error for -punchcard with another command")
+	}
+
+	_, err = parser.Parse([]string{"-summary", "-format", "svg", tempDir})
+	if err == nil || !strings.Contains(err.Error(), "-punchcard") {
+		t.Errorf("Expected svg to require -punchcard, got %v", err)
+	}
+
+	if _, err := parser.Parse([]string{"-contrib", "-format", "svg", tempDir}); err != nil {
+		t.Errorf("Expected svg to be available with -contrib, got %v", err)
+	}
+
+	if path := cli.MultiFormatOutputPath("report", "svg"); path != "report.svg" {
+		t.Errorf("Expected report.svg, got %s", path)
+	}
+}
+
+func TestCLIParser_Parse_Files(t *testing.T) {
+	validator := cli.NewCLIValidator()
+	parser := cli.NewCLIParser(validator)
+
+	tempDir := createTempGitRepo(t)
+	defer os.RemoveAll(tempDir)
+
+	config, err := parser.Parse([]string{"-files", "-no-pager", tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.Command != "files" || !config.NoPager {
+		t.Errorf("Expected files command without the pager, got %s (no pager: %v)", config.Command, config.NoPager)
+	}
+
+	config, err = parser.Parse([]string{"-contributors", tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.NoPager {
+		t.Error("Expected the pager to be enabled by default")
+	}
+
+	if _, err := parser.Parse([]string{"-files", "-contributors", tempDir}); err == nil {
+		t.Error("Expected error for -files with another command")
+	}
+}
+
+func TestCLIParser_Parse_ByAuthor(t *testing.T) {
+	validator := cli.NewCLIValidator()
+	parser := cli.NewCLIParser(validator)
+
+	tempDir := createTempGitRepo(t)
+	defer os.RemoveAll(tempDir)
+
+	config, err := parser.Parse([]string{"-contrib", "-by-author", "Dominant", tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.ByAuthor != "dominant" || config.TopAuthors != 5 {
+		t.Errorf("Expected dominant mode with the top 5 authors, got %q/%d", config.ByAuthor, config.TopAuthors)
+	}
+
+	config, err = parser.Parse([]string{"-by-author", "multiples", "-top", "3", tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.Command != "contrib" || config.ByAuthor != "multiples" || config.TopAuthors != 3 {
+		t.Errorf("Expected contrib multiples of 3 authors, got %s/%q/%d", config.Command, config.ByAuthor, config.TopAuthors)
+	}
+
+	invalid := [][]string{
+		{"-contrib", "-by-author", "stacked", tempDir},
+		{"-contrib", "-by-author", "dominant", "-top", "0", tempDir},
+		{"-contrib", "-by-author", "dominant", "-top", "10", tempDir},
+		{"-summary", "-by-author", "dominant", tempDir},
+		{"-contrib", "-by-author", "multiples", "-year", "2023", tempDir},
+	}
+	for _, args := range invalid {
+		if _, err := parser.Parse(args); err == nil {
+			t.Errorf("Expected error for %v", args)
+		}
+	}
+}
+
+func TestCLIParser_Parse_Growth(t *testing.T) {
+	validator := cli.NewCLIValidator()
+	parser := cli.NewCLIParser(validator)
+
+	tempDir := createTempGitRepo(t)
+	defer os.RemoveAll(tempDir)
+
+	config, err := parser.Parse([]string{"-growth", "-interval", "Month", "-breakdown", "directory",
+		"-exclude", "generated, vendored,,docs/", tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.Command != "growth" || config.Interval != "month" || config.Breakdown != "directory" {
+		t.Errorf("Expected monthly growth by directory, got %s/%s/%s", config.Command, config.Interval, config.Breakdown)
+	}
+	if len(config.Exclude) != 3 || config.Exclude[1] != "vendored" || config.Exclude[2] != "docs/" {
+		t.Errorf("Expected three exclusions without empty entries, got %q", config.Exclude)
+	}
+
+	config, err = parser.Parse([]string{"-growth", "-format", "csv", tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.Interval != "" || config.Breakdown != "" || len(config.Exclude) != 0 {
+		t.Errorf("Expected no growth options by default, got %+v", config)
+	}
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"-summary", "-interval", "day"}, "only available with -growth"},
+		{[]string{"-contrib", "-exclude", "vendored"}, "only available with -growth"},
+		{[]string{"-growth", "-interval", "year"}, "invalid -interval"},
+		{[]string{"-growth", "-breakdown", "author"}, "invalid -breakdown"},
+		{[]string{"-growth", "-exclude", "src/["}, "invalid -exclude pattern"},
+		{[]string{"-growth", "-format", "svg"}, "svg format"},
+	}
+	for _, tt := range tests {
+		_, err := parser.Parse(append(tt.args, tempDir))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%v: expected error containing %q, got %v", tt.args, tt.want, err)
+		}
+	}
+}
+
+func TestCLIParser_Parse_ByDirectory(t *testing.T) {
+	validator := cli.NewCLIValidator()
+	parser := cli.NewCLIParser(validator)
+
+	tempDir := createTempGitRepo(t)
+	defer os.RemoveAll(tempDir)
+
+	config, err := parser.Parse([]string{"-files", "-by-directory", "-depth", "3", "-modules", tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !config.ByDirectory || config.Depth != 3 || !config.Modules {
+		t.Errorf("Expected modules by directory 3 segments deep, got %+v", config)
+	}
+
+	config, err = parser.Parse([]string{"-files", tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.ByDirectory || config.Depth != 1 || config.Modules {
+		t.Errorf("Expected per-file statistics with a depth of 1 by default, got %+v", config)
+	}
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"-summary", "-by-directory"}, "only available with -files"},
+		{[]string{"-files", "-modules"}, "-modules requires -by-directory"},
+		{[]string{"-files", "-by-directory", "-depth", "0"}, "-depth must be 1 or greater"},
+	}
+	for _, tt := range tests {
+		_, err := parser.Parse(append(tt.args, tempDir))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%v: expected error containing %q, got %v", tt.args, tt.want, err)
+		}
+	}
+}
+
+func TestCLIParser_Parse_Team(t *testing.T) {
+	validator := cli.NewCLIValidator()
+	parser := cli.NewCLIParser(validator)
+
+	tempDir := createTempGitRepo(t)
+	defer os.RemoveAll(tempDir)
+
+	config, err := parser.Parse([]string{"-contributors", "-team", " platform ", "-by-team", tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.Team != "platform" || !config.ByTeam {
+		t.Errorf("Expected the platform team grouped by team, got %q/%v", config.Team, config.ByTeam)
+	}
+
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"-gui", "-by-team"}, "not available in the GUI"},
+		{[]string{"-contrib", "-by-team", "-year", "2023"}, "cannot be combined with -year"},
+	}
+	for _, tt := range tests {
+		_, err := parser.Parse(append(tt.args, tempDir))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%v: expected error containing %q, got %v", tt.args, tt.want, err)
+		}
+	}
+}
+
+func TestCLIParser_Parse_Compare(t *testing.T) {
+	validator := cli.NewCLIValidator()
+	parser := cli.NewCLIParser(validator)
+
+	tempDir := createTempGitRepo(t)
+	defer os.RemoveAll(tempDir)
+
+	config, err := parser.Parse([]string{"-compare", "alice,bob@example.com", tempDir})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.Command != "compare" || len(config.Compare) != 2 || config.Compare[1] != "bob@example.com" {
+		t.Errorf("Expected a comparison of alice and bob@example.com, got %q %v", config.Command, config.Compare)
+	}
+
+	// The selectors pick the authors, so -author is rejected rather than ignored
+	tests := []struct {
+		args []string
+		want string
+	}{
+		{[]string{"-compare", "alice"}, "at least two contributors"},
+		{[]string{"-compare", "alice,Alice"}, "more than once"},
+		{[]string{"-compare", "alice,bob", "-author", "carol"}, "cannot be combined with -author"},
+		{[]string{"-compare", "alice,bob", "-format", "csv"}, "only the terminal and json formats"},
+	}
+	for _, tt := range tests {
+		_, err := parser.Parse(append(tt.args, tempDir))
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%v: expected error containing %q, got %v", tt.args, tt.want, err)
+		}
+	}
+}
+
+func TestCLIParser_Parse_MultiFormatCSV(t *testing.T) {
+	validator := cli.NewCLIValidator()
+	parser := cli.NewCLIParser(validator)
+
+	tempDir := createTempGitRepo(t)
+	defer os.RemoveAll(tempDir)
+	outDir := t.TempDir()
+
+	tests := []struct {
+		name  string
+		args  []string
+		split bool
+		want  string // error text; empty when the arguments are valid
+	}{
+		{"split beside json", []string{"-format", "json,csv", "-csv-split", "-output", filepath.Join(outDir, "report")}, true, ""},
+		{"zip beside json", []string{"-format", "json,csv", "-output", filepath.Join(outDir, "report.zip")}, true, ""},
+		{"rfc4180 split beside json", []string{"-format", "json,csv", "-csv-dialect", "rfc4180", "-csv-split", "-output", filepath.Join(outDir, "tables")}, true, ""},
+		{"single csv file beside json", []string{"-format", "json,csv", "-output", filepath.Join(outDir, "report")}, false, ""},
+		{"rfc4180 to a single file", []string{"-format", "json,csv", "-csv-dialect", "rfc4180", "-output", filepath.Join(outDir, "report")}, false, "requires split output"},
+		{"split without csv", []string{"-format", "json,terminal", "-csv-split", "-output", filepath.Join(outDir, "report")}, false, "-csv-split requires -format csv"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config, err := parser.Parse(append(tt.args, tempDir))
+			if tt.want != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.want) {
+					t.Errorf("Expected error containing %q, got %v", tt.want, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if config.SplitsCSVOutput() != tt.split {
+				t.Errorf("SplitsCSVOutput() = %v, want %v", config.SplitsCSVOutput(), tt.split)
+			}
+		})
+	}
+}
@@ -4,8 +4,10 @@
 package config
 
 import (
+	"encoding/json"
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
@@ -217,6 +219,65 @@ func TestConfigManager_Validate(t *testing.T) {
 	}
 }
 
+func TestConfigManager_ValidateGraphPaneSize(t *testing.T) {
+	tests := []struct {
+		size      int
+		shouldErr bool
+	}{
+		{9, true},
+		{10, false},
+		{67, false},
+		{90, false},
+		{91, true},
+	}
+
+	for _, tt := range tests {
+		manager := config.NewConfigManager()
+		manager.GetConfig().GUI.GraphPaneSize = tt.size
+
+		err := manager.Validate()
+		if tt.shouldErr && err == nil {
+			t.Errorf("Expected validation error for pane size %d", tt.size)
+		}
+		if !tt.shouldErr && err != nil {
+			t.Errorf("Unexpected validation error for pane size %d: %v", tt.size, err)
+		}
+	}
+}
+
+func TestConfigManager_ValidateThemes(t *testing.T) {
+	tests := []struct {
+		name       string
+		themes     map[string][]string
+		colorTheme string
+		shouldErr  bool
+	}{
+		{"Custom theme selected", map[string][]string{"purple": {"#30363d", "#6e40c9", "#d2a8ff"}}, "purple", false},
+		{"Built-in colorblind-safe theme", nil, "viridis", false},
+		{"Invalid custom theme color", map[string][]string{"purple": {"#30363d", "purple"}}, "github", true},
+		{"Custom theme with one color", map[string][]string{"purple": {"#30363d"}}, "github", true},
+		{"Undefined theme selected", map[string][]string{"purple": {"#30363d", "#6e40c9"}}, "orange", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			manager := config.NewConfigManager()
+			cfg := manager.GetConfig()
+			cfg.Output.Themes = tt.themes
+			cfg.Output.ColorTheme = tt.colorTheme
+			manager.SetConfig(cfg)
+
+			err := manager.Validate()
+			if tt.shouldErr && err == nil {
+				t.Error("Expected validation error but got none")
+			}
+			if !tt.shouldErr && err != nil {
+				t.Errorf("Unexpected validation error: %v", err)
+			}
+		})
+	}
+}
+
 func TestConfigManager_UpdateMethods(t *testing.T) {
 	manager := config.NewConfigManager()
 
@@ -469,3 +530,57 @@ func containsHelper(s, substr string) bool {
 	}
 	return false
 }
+
+func TestConfigManager_SaveGUIPaneSize(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "config.json")
+	original := `{"defaults": {"command": "summary"}, "gui": {"default_view": "contributors"}, "teams": "teams.json"}`
+	if err := os.WriteFile(configPath, []byte(original), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+
+	manager := config.NewConfigManagerWithPath(configPath)
+	if err := manager.Load(); err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	if err := manager.SaveGUIPaneSize(40); err != nil {
+		t.Fatalf("Failed to save pane size: %v", err)
+	}
+
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		t.Fatalf("Failed to read config: %v", err)
+	}
+	var saved map[string]json.RawMessage
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatalf("Invalid config JSON: %v\n%s", err, data)
+	}
+	var gui map[string]interface{}
+	if err := json.Unmarshal(saved["gui"], &gui); err != nil {
+		t.Fatalf("Invalid gui settings: %v", err)
+	}
+	if gui["graph_pane_size"] != float64(40) || gui["default_view"] != "contributors" || len(gui) != 2 {
+		t.Errorf("Expected the pane size beside the existing gui entries, got %v", gui)
+	}
+	var defaults map[string]interface{}
+	if err := json.Unmarshal(saved["defaults"], &defaults); err != nil || len(defaults) != 1 {
+		t.Errorf("Expected the defaults as written, got %s", saved["defaults"])
+	}
+	if len(saved) != 3 || string(saved["teams"]) != `"teams.json"` {
+		t.Errorf("Expected the other entries as written and no merged defaults, got %s", data)
+	}
+
+	reloaded := config.NewConfigManagerWithPath(configPath)
+	if err := reloaded.Load(); err != nil || reloaded.GetConfig().GUI.GraphPaneSize != 40 {
+		t.Errorf("Expected pane size 40 after reloading, got %d (%v)", reloaded.GetConfig().GUI.GraphPaneSize, err)
+	}
+
+	// Without a configuration file, the new file holds only the pane size
+	configPath = filepath.Join(tempDir, "new", "config.json")
+	if err := config.NewConfigManagerWithPath(configPath).SaveGUIPaneSize(30); err != nil {
+		t.Fatalf("Failed to save pane size: %v", err)
+	}
+	if data, _ := os.ReadFile(configPath); !strings.Contains(string(data), `"graph_pane_size": 30`) || strings.Contains(string(data), "defaults") {
+		t.Errorf("Expected a file with only the pane size, got %s", data)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Unit tests for the team definition file
+
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"git-stats/config"
+)
+
+func TestParseTeams(t *testing.T) {
+	teams, err := config.ParseTeams([]byte(`{"teams": [
+		{"name": "platform", "members": [{"email": "*@infra.example.com"}, {"email": "alice@example.com", "until": "2024-03-31"}]},
+		{"name": " web ", "members": [{"email": "alice@example.com", "since": "2024-04-01"}]}
+	]}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	if len(teams) != 2 || teams[0].Name != "platform" || teams[1].Name != "web" {
+		t.Fatalf("Expected platform and web in file order, got %+v", teams)
+	}
+	alice := teams[0].Members[1]
+	if alice.Pattern != "alice@example.com" || !alice.Since.IsZero() || !alice.Until.Equal(time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected alice on platform until 2024-03-31, got %+v", alice)
+	}
+
+	tests := []struct {
+		data string
+		want string
+	}{
+		{`{"teams": []}`, "no teams defined"},
+		{`{"teams": [{"name": "", "members": [{"email": "a@b.c"}]}]}`, "needs a name"},
+		{`{"teams": [{"name": "(no team)", "members": [{"email": "a@b.c"}]}]}`, "reserved or used twice"},
+		{`{"teams": [{"name": "a", "members": [{"email": "a@b.c"}]}, {"name": "A", "members": [{"email": "x@y.z"}]}]}`, "reserved or used twice"},
+		{`{"teams": [{"name": "a", "members": []}]}`, "has no members"},
+		{`{"teams": [{"name": "a", "members": [{"email": "[a@b.c"}]}]}`, "invalid email pattern"},
+		{`{"teams": [{"name": "a", "members": [{"email": "a@b.c", "since": "April"}]}]}`, "invalid since date"},
+		{`{"teams": [{"name": "a", "members": [{"email": "a@b.c", "since": "2024-05-01", "until": "2024-04-01"}]}]}`, "ends before it starts"},
+		{`{"teams": `, "failed to parse"},
+	}
+	for _, tt := range tests {
+		if _, err := config.ParseTeams([]byte(tt.data)); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: expected error containing %q, got %v", tt.data, tt.want, err)
+		}
+	}
+}
+
+func TestConfigManager_LoadTeams(t *testing.T) {
+	tempDir := t.TempDir()
+	configPath := filepath.Join(tempDir, "config.json")
+	manager := config.NewConfigManagerWithPath(configPath)
+
+	if _, err := manager.LoadTeams(); err == nil || !strings.Contains(err.Error(), "no team definition file configured") {
+		t.Errorf("Expected an error without a team definition file, got %v", err)
+	}
+
+	teamsData := `{"teams": [{"name": "web", "members": [{"email": "bob@example.com"}]}]}`
+	if err := os.WriteFile(filepath.Join(tempDir, "teams.json"), []byte(teamsData), 0644); err != nil {
+		t.Fatalf("Failed to write teams: %v", err)
+	}
+	if err := os.WriteFile(configPath, []byte(`{"teams": "teams.json"}`), 0644); err != nil {
+		t.Fatalf("Failed to write config: %v", err)
+	}
+	if err := manager.Load(); err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+
+	// The relative path is resolved against the configuration file
+	teams, err := manager.LoadTeams()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(teams) != 1 || teams[0].Name != "web" {
+		t.Errorf("Expected the web team, got %+v", teams)
+	}
+}
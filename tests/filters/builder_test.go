@@ -199,6 +199,39 @@ func TestFilterBuilder_ParseDateRange(t *testing.T) {
 	}
 }
 
+func TestFilterBuilder_ParseDate(t *testing.T) {
+	// ParseDate does not need a config manager
+	builder := filters.NewFilterBuilder(nil)
+
+	tests := []struct {
+		value     string
+		shouldErr bool
+	}{
+		{"2024-01-31", false},
+		{"6 months ago", false},
+		{"yesterday", false},
+		{"not a date", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			date, err := builder.ParseDate(tt.value)
+			if tt.shouldErr {
+				if err == nil {
+					t.Errorf("Expected error for %q", tt.value)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if date == nil {
+				t.Error("Expected a parsed date")
+			}
+		})
+	}
+}
+
 func TestFilterBuilder_BuildAdvancedFilter(t *testing.T) {
 	configManager := config.NewConfigManager()
 	builder := filters.NewFilterBuilder(configManager)
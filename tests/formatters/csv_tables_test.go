@@ -0,0 +1,317 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - CSV table set unit tests
+
+package formatters
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"git-stats/formatters"
+	"git-stats/models"
+)
+
+func TestGetCSVDialect(t *testing.T) {
+	tests := []struct {
+		name      string
+		delimiter rune
+		crlf      bool
+		bom       bool
+		expectErr bool
+	}{
+		{"", ',', false, false, false},
+		{"default", ',', false, false, false},
+		{"rfc4180", ',', true, false, false},
+		{"excel", ';', true, true, false},
+		{"tsv", 0, false, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dialect, err := formatters.GetCSVDialect(tt.name)
+			if tt.expectErr {
+				if err == nil {
+					t.Error("Expected error for unknown dialect")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if dialect.Delimiter != tt.delimiter || dialect.UseCRLF != tt.crlf || dialect.BOM != tt.bom {
+				t.Errorf("Unexpected dialect: %+v", dialect)
+			}
+		})
+	}
+}
+
+func TestCSVFormatter_FormatCSVTables(t *testing.T) {
+	formatter := formatters.NewCSVFormatter()
+	data := createCSVTablesTestResult()
+
+	files, err := formatter.FormatCSVTables(data, models.FormatConfig{Format: "csv", Metadata: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	byName := make(map[string][]byte)
+	for _, file := range files {
+		byName[file.Name] = file.Data
+	}
+
+	expected := []string{"metadata.csv", "summary.csv", "contributors.csv", "files.csv",
+		"file_types.csv", "daily_contributions.csv", formatters.CSVManifestFile}
+	for _, name := range expected {
+		if _, ok := byName[name]; !ok {
+			t.Errorf("Expected file %s in table set", name)
+		}
+	}
+
+	// Every CSV must parse as a single rectangular table
+	for name, content := range byName {
+		if !strings.HasSuffix(name, ".csv") {
+			continue
+		}
+		records, err := csv.NewReader(bytes.NewReader(content)).ReadAll()
+		if err != nil {
+			t.Errorf("%s is not a well-formed CSV table: %v", name, err)
+		}
+		if len(records) == 0 {
+			t.Errorf("%s should contain at least a header row", name)
+		}
+	}
+
+	// Daily contributions are sorted by date
+	records, _ := csv.NewReader(bytes.NewReader(byName["daily_contributions.csv"])).ReadAll()
+	if len(records) != 3 || records[1][0] != "2024-01-01" || records[2][0] != "2024-01-02" {
+		t.Errorf("Unexpected daily contributions: %v", records)
+	}
+
+	var manifest struct {
+		Tables []struct {
+			File    string   `json:"file"`
+			Columns []string `json:"columns"`
+			Rows    int      `json:"rows"`
+		} `json:"tables"`
+	}
+	if err := json.Unmarshal(byName[formatters.CSVManifestFile], &manifest); err != nil {
+		t.Fatalf("Manifest is not valid JSON: %v", err)
+	}
+	if len(manifest.Tables) != len(expected)-1 {
+		t.Errorf("Expected %d tables in manifest, got %d", len(expected)-1, len(manifest.Tables))
+	}
+	for _, table := range manifest.Tables {
+		if table.File == "contributors.csv" && table.Rows != 2 {
+			t.Errorf("Expected 2 contributor rows in manifest, got %d", table.Rows)
+		}
+	}
+}
+
+func TestCSVFormatter_EmptySectionsKeepHeaders(t *testing.T) {
+	formatter := formatters.NewCSVFormatter()
+
+	files, err := formatter.FormatCSVTables(&models.AnalysisResult{}, models.FormatConfig{Format: "csv"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, file := range files {
+		if strings.HasSuffix(file.Name, ".csv") && len(file.Data) == 0 {
+			t.Errorf("%s should still contain a header row", file.Name)
+		}
+	}
+}
+
+func TestCSVFormatter_ExcelDialect(t *testing.T) {
+	formatter := formatters.NewCSVFormatter()
+	data := createCSVTablesTestResult()
+
+	files, err := formatter.FormatCSVTables(data, models.FormatConfig{Format: "csv", CSVDialect: "excel"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, file := range files {
+		if file.Name != "summary.csv" {
+			continue
+		}
+		content := string(file.Data)
+		if !strings.HasPrefix(content, "\ufeff") {
+			t.Error("Excel dialect should start with a UTF-8 BOM")
+		}
+		if !strings.Contains(content, "Metric;Value\r\n") {
+			t.Errorf("Excel dialect should use semicolons and CRLF, got %q", content)
+		}
+	}
+}
+
+func TestCSVFormatter_RFC4180RejectsSingleStream(t *testing.T) {
+	formatter := formatters.NewCSVFormatter()
+
+	_, err := formatter.Format(createCSVTablesTestResult(), models.FormatConfig{Format: "csv", CSVDialect: "rfc4180"})
+	if err == nil {
+		t.Error("Expected error when using rfc4180 dialect for a multi-table stream")
+	}
+}
+
+func TestCSVFormatter_FormatCSVZip(t *testing.T) {
+	formatter := formatters.NewCSVFormatter()
+
+	archive, err := formatter.FormatCSVZip(createCSVTablesTestResult(), models.FormatConfig{Format: "csv", CSVDialect: "rfc4180"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	reader, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+	if err != nil {
+		t.Fatalf("Invalid zip archive: %v", err)
+	}
+
+	names := make(map[string]bool)
+	for _, file := range reader.File {
+		names[file.Name] = true
+	}
+	if !names["summary.csv"] || !names[formatters.CSVManifestFile] {
+		t.Errorf("Unexpected archive contents: %v", names)
+	}
+}
+
+func TestFileOutputHandler_WriteFileSet(t *testing.T) {
+	handler := formatters.NewFileOutputHandler(false, formatters.OverwriteModeReplace)
+	dir := filepath.Join(t.TempDir(), "tables")
+
+	files := []formatters.NamedOutput{
+		{Name: "a.csv", Data: []byte("A\n1\n")},
+		{Name: "b.csv", Data: []byte("B\n2\n")},
+	}
+
+	err := handler.WriteFileSet(files, formatters.FileOutputConfig{OutputPath: dir, OverwriteMode: formatters.OverwriteModeReplace})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, file := range files {
+		content, err := os.ReadFile(filepath.Join(dir, file.Name))
+		if err != nil {
+			t.Errorf("Expected %s to be written: %v", file.Name, err)
+		} else if string(content) != string(file.Data) {
+			t.Errorf("Unexpected content for %s: %q", file.Name, content)
+		}
+	}
+}
+
+func TestCSVFormatter_Sections(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  *models.AnalysisResult
+		lines []string // in the single-stream output
+		table string   // of the split tables
+		check func(t *testing.T, records [][]string)
+	}{
+		{"punch card", createPunchCardTestResult(), []string{"# Punch Card"}, "punch_card.csv", func(t *testing.T, records [][]string) {
+			if len(records) != 8 || len(records[0]) != 26 || records[0][1] != "00" || records[0][25] != "Total" {
+				t.Fatalf("Expected a header and 7 weekday rows of 24 hours, got %v", records)
+			}
+			if records[3][0] != "Tuesday" || records[3][11] != "3" || records[3][25] != "3" {
+				t.Errorf("Unexpected Tuesday row: %v", records[3])
+			}
+		}},
+		{"contributor punch cards", createPunchCardTestResult(), []string{"# Contributor Punch Cards"}, "contributor_punch_cards.csv", func(t *testing.T, records [][]string) {
+			if len(records) != 8 || records[1][1] != "alice@example.com" {
+				t.Errorf("Expected 7 rows for Alice only, got %d rows", len(records)-1)
+			}
+		}},
+		{"code growth", createGrowthTestResult(), []string{"# Code Growth", "2024-01-22,docs,0,0,0,20"}, "code_growth.csv", func(t *testing.T, records [][]string) {
+			if len(records) != 10 || strings.Join(records[0], ",") != "Period,Group,Insertions,Deletions,Net,Total" {
+				t.Fatalf("Expected a header and 3 rows each for the total and 2 groups, got %v", records)
+			}
+			if records[1][1] != "Total" || records[3][3] != "20" || records[3][5] != "100" || records[4][1] != "src" {
+				t.Errorf("Unexpected code growth rows: %v", records[1:5])
+			}
+		}},
+		{"directories", createDirectoryTestResult(), []string{"# Directory Statistics", "Directory,Manifest,Files,Commits",
+			"services/api,go.mod,3,3,41,7,2,Alice <alice@example.com> (2); Bob <bob@example.com> (1)"}, "directories.csv", nil},
+		{"teams", createTeamTestResult(), []string{"# Team Statistics", "Team,Members,Commits,Commit Share",
+			"web,2,3,75.00,30,6,2,2,1,50.00,2024-03-04T12:00:00Z,2024-03-06T12:00:00Z"}, "teams.csv", nil},
+	}
+
+	formatter := formatters.NewCSVFormatter()
+	empty, err := formatter.FormatCSVTables(&models.AnalysisResult{}, models.FormatConfig{Format: "csv"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := formatter.Format(tt.data, models.FormatConfig{Format: "csv"})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			for _, want := range tt.lines {
+				if !strings.Contains(string(output), want) {
+					t.Errorf("Expected %q in the CSV:\n%s", want, output)
+				}
+			}
+
+			files, err := formatter.FormatCSVTables(tt.data, models.FormatConfig{Format: "csv"})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			var table []byte
+			for _, file := range files {
+				if file.Name == tt.table {
+					table = file.Data
+				}
+			}
+			if table == nil {
+				t.Fatalf("Expected %s among the split tables", tt.table)
+			}
+			records, err := csv.NewReader(bytes.NewReader(table)).ReadAll()
+			if err != nil {
+				t.Fatalf("%s is not a well-formed CSV table: %v", tt.table, err)
+			}
+			if tt.check != nil {
+				tt.check(t, records)
+			}
+
+			// Results without the section's data keep the existing table set
+			for _, file := range empty {
+				if file.Name == tt.table {
+					t.Errorf("Unexpected %s without its data", tt.table)
+				}
+			}
+		})
+	}
+}
+
+// createCSVTablesTestResult creates a small analysis result for table set tests
+func createCSVTablesTestResult() *models.AnalysisResult {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &models.AnalysisResult{
+		Repository: &models.RepositoryInfo{Name: "test-repo", Path: "/tmp/test-repo"},
+		Summary: &models.StatsSummary{
+			TotalCommits:    5,
+			TotalInsertions: 50,
+			TotalDeletions:  10,
+			TopFiles:        []models.FileStats{{Path: "main.go", Commits: 3, LastModified: start}},
+			TopFileTypes:    []models.FileTypeStats{{Extension: "go", Files: 1, Commits: 3, Lines: 40}},
+		},
+		Contributors: []models.ContributorStats{
+			{Name: "Alice, A.", Email: "alice@example.com", TotalCommits: 3},
+			{Name: "Bob \"B\"", Email: "bob@example.com", TotalCommits: 2},
+		},
+		ContribGraph: &models.ContributionGraph{
+			StartDate:   start,
+			EndDate:     start.AddDate(0, 0, 1),
+			DailyValues: map[string]int{"2024-01-02": 2, "2024-01-01": 3},
+		},
+		TimeRange: models.TimeRange{Start: start, End: start.AddDate(0, 0, 1)},
+	}
+}
@@ -450,11 +450,11 @@ func createTestContributors() []models.Contributor {
 
 func createTestContributionGraph() *models.ContributionGraph {
 	return &models.ContributionGraph{
-		StartDate:    time.Now().AddDate(-1, 0, 0),
-		EndDate:      time.Now(),
-		DailyCommits: map[string]int{"2023-12-01": 5, "2023-12-02": 3, "2023-12-03": 7},
-		MaxCommits:   7,
-		TotalCommits: 15,
+		StartDate:   time.Now().AddDate(-1, 0, 0),
+		EndDate:     time.Now(),
+		DailyValues: map[string]int{"2023-12-01": 5, "2023-12-02": 3, "2023-12-03": 7},
+		MaxValue:    7,
+		Total:       15,
 	}
 }
 
@@ -5,6 +5,7 @@ package formatters
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -258,6 +259,177 @@ func TestJSONFormatter_TimeFormatting(t *testing.T) {
 	}
 }
 
+func TestJSONFormatter_ContributionGraphMetric(t *testing.T) {
+	formatter := formatters.NewJSONFormatter()
+
+	tests := []struct {
+		metric      string
+		commitNames bool
+	}{
+		{"", true},
+		{models.MetricCommits, true},
+		{models.MetricLines, false},
+	}
+
+	for _, tt := range tests {
+		data := createTestAnalysisResult()
+		data.ContribGraph = createTestContributionGraph()
+		data.ContribGraph.Metric = tt.metric
+		data.ContribYears = []models.ContributionYear{{Year: 2023, Total: 15, ActiveDays: 3, MaxValue: 7}}
+
+		output, err := formatter.Format(data, models.FormatConfig{Format: "json"})
+		if err != nil {
+			t.Fatalf("%q: unexpected error: %v", tt.metric, err)
+		}
+
+		var result map[string]interface{}
+		if err := json.Unmarshal(output, &result); err != nil {
+			t.Fatalf("%q: invalid JSON: %v", tt.metric, err)
+		}
+
+		graph := result["contribution_graph"].(map[string]interface{})
+		if graph["total"] != float64(15) || graph["max_value"] != float64(7) || graph["daily_values"] == nil {
+			t.Errorf("%q: expected metric-neutral keys, got %v", tt.metric, graph)
+		}
+		if _, ok := graph["total_commits"]; ok != tt.commitNames {
+			t.Errorf("%q: total_commits present = %v, want %v", tt.metric, ok, tt.commitNames)
+		}
+
+		year := result["contribution_years"].([]interface{})[0].(map[string]interface{})
+		if year["total"] != float64(15) || year["max_value"] != float64(7) {
+			t.Errorf("%q: expected metric-neutral year keys, got %v", tt.metric, year)
+		}
+		if _, ok := year["total_commits"]; ok != tt.commitNames {
+			t.Errorf("%q: year total_commits present = %v, want %v", tt.metric, ok, tt.commitNames)
+		}
+	}
+}
+
+func TestJSONFormatter_Sections(t *testing.T) {
+	type punchCardEntry struct {
+		Weekday string `json:"weekday"`
+		Hours   []int  `json:"hours"`
+		Total   int    `json:"total"`
+	}
+	type growthPoint struct {
+		Period string `json:"period"`
+		Net    int    `json:"net"`
+		Total  int    `json:"total"`
+	}
+	type sectionsJSON struct {
+		Summary struct {
+			PunchCard []punchCardEntry `json:"punch_card"`
+		} `json:"summary"`
+		Contributors []struct {
+			PunchCard []punchCardEntry `json:"punch_card"`
+		} `json:"contributors"`
+		Growth struct {
+			Interval      string        `json:"interval"`
+			Breakdown     string        `json:"breakdown"`
+			ExcludedLines int           `json:"excluded_lines"`
+			NetLines      int           `json:"net_lines"`
+			Points        []growthPoint `json:"points"`
+			Groups        []struct {
+				Name     string        `json:"name"`
+				NetLines int           `json:"net_lines"`
+				Points   []growthPoint `json:"points"`
+			} `json:"groups"`
+		} `json:"code_growth"`
+		Directories []struct {
+			Path       string `json:"path"`
+			Module     bool   `json:"module"`
+			Manifest   string `json:"manifest"`
+			Commits    int    `json:"commits"`
+			Trend      string `json:"activity_trend"`
+			TopAuthors []struct {
+				Name string `json:"name"`
+			} `json:"top_authors"`
+		} `json:"directories"`
+		Teams []struct {
+			Name       string  `json:"name"`
+			Commits    int     `json:"commits"`
+			Churn      int     `json:"churn"`
+			ActiveDays int     `json:"active_days"`
+			Ownership  float64 `json:"ownership"`
+		} `json:"teams"`
+	}
+	var result sectionsJSON
+
+	tests := []struct {
+		name  string
+		data  *models.AnalysisResult
+		key   string
+		check func(t *testing.T)
+	}{
+		{"punch card", createPunchCardTestResult(), "punch_card", func(t *testing.T) {
+			card := result.Summary.PunchCard
+			if len(card) != 7 || card[0].Weekday != "Sunday" || len(card[2].Hours) != 24 {
+				t.Fatalf("Expected seven weekdays of 24 hours starting on Sunday, got %+v", card)
+			}
+			if card[2].Weekday != "Tuesday" || card[2].Hours[10] != 3 || card[2].Total != 3 || card[6].Total != 1 {
+				t.Errorf("Unexpected punch card: %+v", card)
+			}
+			if len(result.Contributors) != 2 || len(result.Contributors[0].PunchCard) != 7 || result.Contributors[1].PunchCard != nil {
+				t.Errorf("Expected a punch card for Alice only, got %+v", result.Contributors)
+			}
+		}},
+		{"code growth", createGrowthTestResult(), "code_growth", func(t *testing.T) {
+			growth := result.Growth
+			if growth.Interval != "week" || growth.Breakdown != "directory" || growth.NetLines != 100 || growth.ExcludedLines != 40 {
+				t.Errorf("Unexpected code growth: %+v", growth)
+			}
+			if len(growth.Points) != 3 || growth.Points[0].Period != "2024-01-08" || growth.Points[2].Net != -20 {
+				t.Errorf("Expected three weekly points, got %+v", growth.Points)
+			}
+			if len(growth.Groups) != 2 || growth.Groups[0].Name != "src" || growth.Groups[0].NetLines != 80 || len(growth.Groups[1].Points) != 3 {
+				t.Errorf("Expected src and docs groups, got %+v", growth.Groups)
+			}
+		}},
+		{"directories", createDirectoryTestResult(), "directories", func(t *testing.T) {
+			if len(result.Directories) != 2 {
+				t.Fatalf("Expected two directories, got %+v", result.Directories)
+			}
+			api := result.Directories[0]
+			if api.Path != "services/api" || !api.Module || api.Manifest != "go.mod" || api.Commits != 3 ||
+				api.Trend != "increasing" || len(api.TopAuthors) != 2 || api.TopAuthors[0].Name != "Alice" {
+				t.Errorf("Unexpected module entry %+v", api)
+			}
+			if result.Directories[1].Module || result.Directories[1].Manifest != "" {
+				t.Errorf("Expected docs outside modules, got %+v", result.Directories[1])
+			}
+		}},
+		{"teams", createTeamTestResult(), "teams", func(t *testing.T) {
+			if len(result.Teams) != 2 {
+				t.Fatalf("Expected two teams, got %+v", result.Teams)
+			}
+			if web := result.Teams[0]; web.Name != "web" || web.Commits != 3 || web.Churn != 36 || web.ActiveDays != 2 || web.Ownership != 50 {
+				t.Errorf("Unexpected web entry %+v", web)
+			}
+		}},
+	}
+
+	formatter := formatters.NewJSONFormatter()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := formatter.Format(tt.data, models.FormatConfig{Format: "json"})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			result = sectionsJSON{}
+			if err := json.Unmarshal(output, &result); err != nil {
+				t.Fatalf("Invalid JSON: %v", err)
+			}
+			tt.check(t)
+
+			// Results without the section's data leave its key out
+			output, _ = formatter.Format(&models.AnalysisResult{}, models.FormatConfig{Format: "json"})
+			if strings.Contains(string(output), `"`+tt.key+`"`) {
+				t.Errorf("Expected no %s key without its data", tt.key)
+			}
+		})
+	}
+}
+
 // Helper functions for testing
 
 func createTestAnalysisResult() *models.AnalysisResult {
@@ -340,11 +512,11 @@ func createTestContributors() []models.Contributor {
 
 func createTestContributionGraph() *models.ContributionGraph {
 	return &models.ContributionGraph{
-		StartDate:    time.Now().AddDate(-1, 0, 0),
-		EndDate:      time.Now(),
-		DailyCommits: map[string]int{"2023-12-01": 5, "2023-12-02": 3, "2023-12-03": 7},
-		MaxCommits:   7,
-		TotalCommits: 15,
+		StartDate:   time.Now().AddDate(-1, 0, 0),
+		EndDate:     time.Now(),
+		DailyValues: map[string]int{"2023-12-01": 5, "2023-12-02": 3, "2023-12-03": 7},
+		MaxValue:    7,
+		Total:       15,
 	}
 }
 
@@ -377,3 +549,86 @@ func contains(s, substr string) bool {
 	}
 	return false
 }
+
+func createPunchCardTestResult() *models.AnalysisResult {
+	var repoCard, aliceCard models.PunchCard
+	repoCard[time.Tuesday][10] = 3
+	repoCard[time.Saturday][22] = 1
+	aliceCard[time.Tuesday][10] = 3
+
+	return &models.AnalysisResult{
+		Repository: &models.RepositoryInfo{Name: "test-repo", Path: "/tmp/test-repo"},
+		Summary:    &models.StatsSummary{TotalCommits: 4, PunchCard: repoCard},
+		Contributors: []models.Contributor{
+			{Name: "Alice", Email: "alice@example.com", TotalCommits: 3, PunchCard: aliceCard},
+			{Name: "Bob", Email: "bob@example.com", TotalCommits: 1},
+		},
+	}
+}
+
+func createGrowthTestResult() *models.AnalysisResult {
+	week := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)
+	series := func(name string, nets ...int) models.GrowthSeries {
+		s := models.GrowthSeries{Name: name}
+		total := 0
+		for i, net := range nets {
+			total += net
+			point := models.GrowthPoint{Period: week.AddDate(0, 0, 7*i), Net: net, Total: total}
+			if net > 0 {
+				point.Insertions = net
+			} else {
+				point.Deletions = -net
+			}
+			s.Points = append(s.Points, point)
+		}
+		return s
+	}
+
+	return &models.AnalysisResult{
+		Repository: &models.RepositoryInfo{Name: "test-repo", Path: "/tmp/test-repo"},
+		Growth: &models.CodeGrowth{
+			Interval:      models.GrowthWeek,
+			Breakdown:     models.GrowthByDirectory,
+			Exclude:       []string{models.ExcludeLockfiles},
+			ExcludedFiles: 1,
+			ExcludedLines: 40,
+			Total:         series("Total", 120, 0, -20),
+			Groups:        []models.GrowthSeries{series("src", 100, 0, -20), series("docs", 20, 0, 0)},
+		},
+	}
+}
+
+func createDirectoryTestResult() *models.AnalysisResult {
+	day := time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC)
+	return &models.AnalysisResult{
+		Repository: &models.RepositoryInfo{Name: "test-repo", Path: "/tmp/test-repo"},
+		Summary: &models.StatsSummary{TopFiles: []models.FileStats{
+			{Path: "services/api/handler.go", Commits: 2, Insertions: 30, Deletions: 2, LastModified: day},
+		}},
+		Directories: []models.DirectoryStats{
+			{Path: "services/api", Manifest: "go.mod", Files: 3, Commits: 3, Insertions: 41, Deletions: 7, Authors: 2,
+				TopAuthors: []models.ContributorSummary{
+					{Name: "Alice", Email: "alice@example.com", Commits: 2, Percentage: 66.7},
+					{Name: "Bob", Email: "bob@example.com", Commits: 1, Percentage: 33.3},
+				},
+				FirstChange: day.AddDate(0, 0, -30), LastChange: day, Trend: "increasing", WeeklyCommits: make([]int, 12)},
+			{Path: "docs", Files: 1, Commits: 1, Insertions: 5, Authors: 1,
+				TopAuthors:  []models.ContributorSummary{{Name: "Bob", Email: "bob@example.com", Commits: 1, Percentage: 100}},
+				FirstChange: day, LastChange: day, Trend: "stable", WeeklyCommits: make([]int, 12)},
+		},
+	}
+}
+
+func createTeamTestResult() *models.AnalysisResult {
+	day := time.Date(2024, 3, 4, 12, 0, 0, 0, time.UTC)
+	return &models.AnalysisResult{
+		Repository: &models.RepositoryInfo{Name: "test-repo", Path: "/tmp/test-repo"},
+		Summary:    &models.StatsSummary{TotalCommits: 4},
+		Teams: []models.TeamStats{
+			{Name: "web", Members: 2, Commits: 3, Insertions: 30, Deletions: 6, ActiveDays: 2, Files: 2, OwnedFiles: 1,
+				Ownership: 50, CommitShare: 75, FirstCommit: day, LastCommit: day.AddDate(0, 0, 2)},
+			{Name: models.NoTeam, Members: 1, Commits: 1, Insertions: 10, ActiveDays: 1, Files: 1, OwnedFiles: 1,
+				Ownership: 50, CommitShare: 25, FirstCommit: day, LastCommit: day},
+		},
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Tests for exports of a GUI view and selection
+
+package formatters
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"git-stats/formatters"
+	"git-stats/models"
+)
+
+// selectionExports returns results as the GUI exports them: the data behind the current view,
+// limited to its selection
+func selectionExports() map[string]*models.AnalysisResult {
+	day := time.Date(2024, 3, 5, 10, 0, 0, 0, time.UTC)
+	repository := &models.RepositoryInfo{Name: "test-repo", Path: "/tmp/test-repo"}
+	timeRange := models.TimeRange{Start: day.AddDate(0, -1, 0), End: day}
+
+	alice := models.Contributor{Name: "Alice", Email: "alice@example.com", TotalCommits: 3, FirstCommit: day, LastCommit: day}
+
+	server := &models.FileTreeNode{Name: "server.go", Path: "src/api/server.go", Commits: 2, Insertions: 12, Authors: 1, TopAuthor: "Alice", LastModified: day}
+	api := &models.FileTreeNode{Name: "api", Path: "src/api", IsDir: true, Commits: 2, Insertions: 12, Authors: 1, TopAuthor: "Alice", LastModified: day,
+		Children: []*models.FileTreeNode{server}}
+
+	commit := models.Commit{
+		Hash:       "abc1234def5678",
+		Message:    "Fix the login redirect\n\nLonger body",
+		Author:     models.Author{Name: "Alice", Email: "alice@example.com"},
+		AuthorDate: day,
+		Stats:      models.CommitStats{FilesChanged: 1, Insertions: 4, Deletions: 1},
+	}
+
+	return map[string]*models.AnalysisResult{
+		"contributor": {
+			Repository:   repository,
+			TimeRange:    timeRange,
+			Contributors: []models.ContributorStats{alice},
+			Selection:    &models.ViewSelection{View: "Contributors", Contributor: "Alice <alice@example.com>"},
+		},
+		"path": {
+			Repository: repository,
+			TimeRange:  timeRange,
+			FileTree:   api,
+			Selection:  &models.ViewSelection{View: "Files", Path: "src/api", Filters: "author=alice"},
+		},
+		"day": {
+			Repository: repository,
+			TimeRange:  timeRange,
+			ContribGraph: &models.ContributionGraph{
+				StartDate: timeRange.Start, EndDate: day,
+				DailyValues: map[string]int{"2024-03-05": 1}, MaxValue: 1, Total: 1,
+			},
+			Selection: &models.ViewSelection{View: "Contribution", Date: day, Commits: []models.Commit{commit}},
+		},
+	}
+}
+
+func TestFormatters_SelectionExport(t *testing.T) {
+	exports := selectionExports()
+
+	formats := []struct {
+		name      string
+		formatter formatters.Formatter
+		config    models.FormatConfig
+	}{
+		{"json", formatters.NewJSONFormatter(), models.FormatConfig{Format: "json", Pretty: true}},
+		{"csv", formatters.NewCSVFormatter(), models.FormatConfig{Format: "csv"}},
+		{"markdown", formatters.NewTemplateFormatter(), models.FormatConfig{Format: "template", Template: "markdown"}},
+	}
+
+	tests := []struct {
+		export   string
+		contains []string
+		excludes []string // parts of the repository outside the selection
+	}{
+		{"contributor", []string{"Alice", "alice@example.com"}, []string{"Bob", "bob@example.com", "src/api"}},
+		{"path", []string{"src/api", "src/api/server.go", "author=alice"}, []string{"docs/", "README.md", "bob@example.com"}},
+		{"day", []string{"2024-03-05", "abc1234", "Fix the login redirect"}, []string{"src/api", "bob@example.com"}},
+	}
+
+	for _, format := range formats {
+		for _, tt := range tests {
+			t.Run(format.name+"/"+tt.export, func(t *testing.T) {
+				output, err := format.formatter.Format(exports[tt.export], format.config)
+				if err != nil {
+					t.Fatalf("Unexpected error: %v", err)
+				}
+
+				text := string(output)
+				for _, want := range tt.contains {
+					if !strings.Contains(text, want) {
+						t.Errorf("Export should contain %q:\n%s", want, text)
+					}
+				}
+				for _, unwanted := range tt.excludes {
+					if strings.Contains(text, unwanted) {
+						t.Errorf("Export should not contain %q:\n%s", unwanted, text)
+					}
+				}
+			})
+		}
+	}
+}
+
+func TestFormatters_SelectionSections(t *testing.T) {
+	exports := selectionExports()
+
+	// JSON and CSV name the view the export was taken from
+	output, err := formatters.NewJSONFormatter().Format(exports["contributor"], models.FormatConfig{Format: "json"})
+	if err != nil {
+		t.Fatalf("JSON: %v", err)
+	}
+	if !strings.Contains(string(output), `"selection":{"contributor":"Alice \u003calice@example.com\u003e","view":"Contributors"}`) {
+		t.Errorf("Expected the selection section in JSON output:\n%s", output)
+	}
+
+	output, err = formatters.NewCSVFormatter().Format(exports["day"], models.FormatConfig{Format: "csv"})
+	if err != nil {
+		t.Fatalf("CSV: %v", err)
+	}
+	for _, want := range []string{"View,Contribution", "Date,2024-03-05", "abc1234def5678,Alice,alice@example.com"} {
+		if !strings.Contains(string(output), want) {
+			t.Errorf("CSV output should contain %q:\n%s", want, output)
+		}
+	}
+}
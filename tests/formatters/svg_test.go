@@ -0,0 +1,71 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - SVG formatter unit tests
+
+package formatters
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"git-stats/formatters"
+	"git-stats/models"
+)
+
+func TestSVGFormatter_Format(t *testing.T) {
+	formatter := formatters.NewSVGFormatter()
+
+	output, err := formatter.Format(createPunchCardTestResult(), models.FormatConfig{Format: "svg"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	svg := string(output)
+	if !strings.Contains(svg, "<svg") || !strings.Contains(svg, "test-repo punch card") {
+		t.Errorf("Expected an SVG punch card titled after the repository, got:\n%s", svg)
+	}
+	if !strings.Contains(svg, "Tue 10:00: 3 commits") {
+		t.Error("Expected a tooltip for the busiest hour")
+	}
+
+	if _, err := formatter.Format(nil, models.FormatConfig{}); err == nil {
+		t.Error("Expected error for nil data")
+	}
+	if _, err := formatter.Format(&models.AnalysisResult{}, models.FormatConfig{}); err == nil {
+		t.Error("Expected error without a summary")
+	}
+}
+
+func TestSVGFormatter_Contrib(t *testing.T) {
+	formatter := formatters.NewSVGFormatter()
+	data := createPunchCardTestResult()
+	data.ContribGraph = &models.ContributionGraph{
+		StartDate:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:     time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+		DailyValues: map[string]int{"2024-01-02": 3},
+		MaxValue:    3,
+		Total:       3,
+		Authors: []models.AuthorContributions{
+			{Name: "Alice", Email: "alice@example.com", Daily: map[string]int{"2024-01-02": 3}, Total: 3},
+		},
+	}
+
+	output, err := formatter.Format(data, models.FormatConfig{Format: "svg", Command: "contrib"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if svg := string(output); !strings.Contains(svg, "test-repo contributions") || !strings.Contains(svg, "2024-01-02: 3 commits") {
+		t.Errorf("Expected an SVG contribution graph titled after the repository, got:\n%s", svg)
+	}
+
+	output, err = formatter.Format(data, models.FormatConfig{Format: "svg", Command: "contrib", ByAuthor: models.ByAuthorDominant, TopAuthors: 3})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if svg := string(output); !strings.Contains(svg, "test-repo contributions by author") || !strings.Contains(svg, "mostly Alice") {
+		t.Errorf("Expected an SVG graph colored by author, got:\n%s", svg)
+	}
+
+	if _, err := formatter.Format(&models.AnalysisResult{}, models.FormatConfig{Command: "contrib"}); err == nil {
+		t.Error("Expected error without a contribution graph")
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Template formatter unit tests
+
+package formatters
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"git-stats/formatters"
+	"git-stats/models"
+)
+
+func TestTemplateFormatter_BuiltinTemplates(t *testing.T) {
+	formatter := formatters.NewTemplateFormatter()
+	testData := createTemplateTestResult()
+
+	for _, name := range formatters.BuiltinTemplateNames() {
+		t.Run(name, func(t *testing.T) {
+			output, err := formatter.Format(testData, models.FormatConfig{Format: "template", Template: name})
+			if err != nil {
+				t.Fatalf("Built-in template %s failed: %v", name, err)
+			}
+			if len(output) == 0 {
+				t.Errorf("Built-in template %s produced no output", name)
+			}
+		})
+	}
+}
+
+func TestTemplateFormatter_FormatTemplate(t *testing.T) {
+	formatter := formatters.NewTemplateFormatter()
+	testData := createTemplateTestResult()
+
+	tests := []struct {
+		name     string
+		text     string
+		expected string
+	}{
+		{"field access", "{{.Summary.TotalCommits}}", "10"},
+		{"percent", `{{percent 1 4}}`, "25.0%"},
+		{"percent of zero", `{{percent 1 0}}`, "0.0%"},
+		{"pad left", `[{{padLeft 5 "ab"}}]`, "[   ab]"},
+		{"pad right", `[{{padRight 5 "ab"}}]`, "[ab   ]"},
+		{"truncate", `{{truncate 6 "abcdefghij"}}`, "abc..."},
+		{"sort and top", `{{range topN 1 (sortContributors "commits" .Contributors)}}{{.Name}}{{end}}`, "Bob"},
+		{"sort by name", `{{range sortContributors "name" .Contributors}}{{.Name}} {{end}}`, "Alice Bob "},
+		{"format date", `{{formatDate "short" .TimeRange.Start}}`, "2024-01-01"},
+		{"humanize duration", `{{humanizeDuration .HealthMetrics.RepositoryAge}}`, "1 year, 1 month"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := formatter.FormatTemplate(testData, "test", tt.text)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if string(output) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(output))
+			}
+		})
+	}
+}
+
+func TestTemplateFormatter_RelativeDates(t *testing.T) {
+	formatter := formatters.NewTemplateFormatter()
+	testData := createTemplateTestResult()
+
+	// An hour past the whole days keeps the count stable while the test runs
+	tests := []struct {
+		name     string
+		date     time.Time
+		expected string
+	}{
+		{"past", time.Now().AddDate(0, 0, -3).Add(-time.Hour), "3 days ago"},
+		{"future", time.Now().AddDate(0, 0, 3).Add(time.Hour), "in 3 days"},
+		{"future within a day", time.Now().Add(2*time.Hour + 30*time.Minute), "in 2 hours"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			testData.TimeRange.End = tt.date
+			output, err := formatter.FormatTemplate(testData, "test", `{{formatDate "relative" .TimeRange.End}}`)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if string(output) != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, string(output))
+			}
+		})
+	}
+}
+
+func TestTemplateFormatter_Errors(t *testing.T) {
+	formatter := formatters.NewTemplateFormatter()
+	testData := createTemplateTestResult()
+
+	tests := []struct {
+		name     string
+		text     string
+		contains []string
+	}{
+		{"parse error", "line one\n{{range}}\n", []string{"parse failed", "test line 2", "{{range}}"}},
+		{"execute error", "ok\nok\n{{.Missing}}", []string{"execute failed", "test line 3", "{{.Missing}}"}},
+		{"bad sort key", `{{sortContributors "size" .Contributors}}`, []string{"unknown sort key"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := formatter.FormatTemplate(testData, "test", tt.text)
+			if err == nil {
+				t.Fatal("Expected error but got none")
+			}
+			if !formatters.IsFormatterError(err) {
+				t.Errorf("Expected FormatterError, got %T", err)
+			}
+			for _, want := range tt.contains {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Expected error to contain %q, got: %v", want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestTemplateFormatter_TemplateFile(t *testing.T) {
+	formatter := formatters.NewTemplateFormatter()
+	testData := createTemplateTestResult()
+
+	path := filepath.Join(t.TempDir(), "status.tmpl")
+	if err := os.WriteFile(path, []byte("{{.Repository.Name}}: {{.Summary.TotalCommits}}"), 0644); err != nil {
+		t.Fatalf("Failed to write template: %v", err)
+	}
+
+	output, err := formatter.Format(testData, models.FormatConfig{Format: "template", Template: path})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if string(output) != "test-repo: 10" {
+		t.Errorf("Unexpected output: %q", string(output))
+	}
+
+	if _, err := formatter.Format(testData, models.FormatConfig{Format: "template", Template: "nosuch"}); err == nil {
+		t.Error("Expected error for unknown built-in template")
+	}
+}
+
+// createTemplateTestResult creates a small analysis result for template tests
+func createTemplateTestResult() *models.AnalysisResult {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &models.AnalysisResult{
+		Repository: &models.RepositoryInfo{
+			Name:         "test-repo",
+			TotalCommits: 10,
+		},
+		Summary: &models.StatsSummary{
+			TotalCommits:    10,
+			TotalInsertions: 100,
+			TotalDeletions:  20,
+			ActiveDays:      4,
+		},
+		Contributors: []models.ContributorStats{
+			{Name: "Alice", TotalCommits: 3, TotalInsertions: 30},
+			{Name: "Bob", TotalCommits: 7, TotalInsertions: 70},
+		},
+		HealthMetrics: &models.HealthMetrics{
+			RepositoryAge: 400 * 24 * time.Hour,
+			ActivityTrend: "stable",
+		},
+		TimeRange: models.TimeRange{Start: start, End: start.AddDate(0, 6, 0)},
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Terminal formatter unit tests
+
+package formatters
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"git-stats/formatters"
+	"git-stats/models"
+)
+
+func TestTerminalFormatter_Format(t *testing.T) {
+	formatter := formatters.NewTerminalFormatter()
+	data := createTerminalTestResult()
+
+	tests := []struct {
+		name     string
+		command  string
+		contains []string
+	}{
+		{"contrib", "contrib", []string{"Git Contribution Graph", "Contribution Summary:", "Activity Level Distribution:"}},
+		{"default command", "", []string{"Git Contribution Graph"}},
+		{"summary", "summary", []string{"Git Repository Summary", "Repository: test-repo", "Total Commits: 5"}},
+		{"contributors", "contributors", []string{"Git Contributors Analysis", "Total Contributors: 2"}},
+		{"health", "health", []string{"Repository Health Analysis"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := formatter.Format(data, models.FormatConfig{Format: "terminal", Command: tt.command, NoColor: true})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			text := string(output)
+			for _, want := range tt.contains {
+				if !strings.Contains(text, want) {
+					t.Errorf("Output should contain %q", want)
+				}
+			}
+			if strings.Contains(text, "\x1b") {
+				t.Error("Plain output should not contain ANSI escape sequences")
+			}
+		})
+	}
+}
+
+func TestTerminalFormatter_ContribByAuthor(t *testing.T) {
+	formatter := formatters.NewTerminalFormatter()
+	data := createTerminalTestResult()
+	data.ContribGraph.Authors = []models.AuthorContributions{
+		{Name: "Alice", Email: "alice@example.com", Daily: map[string]int{"2024-01-01": 3}, Total: 3},
+		{Name: "Bob", Email: "bob@example.com", Daily: map[string]int{"2024-01-02": 2}, Total: 2},
+	}
+
+	tests := []struct {
+		mode     string
+		contains []string
+	}{
+		{models.ByAuthorDominant, []string{"1 Alice (3)   2 Bob (2)", "Contribution Summary:"}},
+		{models.ByAuthorMultiples, []string{"1 Alice (3)", "2 Bob (2)", "the same scale on every calendar"}},
+	}
+	for _, tt := range tests {
+		output, err := formatter.Format(data, models.FormatConfig{Format: "terminal", Command: "contrib", NoColor: true, ByAuthor: tt.mode, TopAuthors: 5})
+		if err != nil {
+			t.Fatalf("Unexpected error for %s: %v", tt.mode, err)
+		}
+		for _, want := range tt.contains {
+			if !strings.Contains(string(output), want) {
+				t.Errorf("%s output should contain %q", tt.mode, want)
+			}
+		}
+	}
+}
+
+func TestTerminalFormatter_ErrorHandling(t *testing.T) {
+	formatter := formatters.NewTerminalFormatter()
+
+	if _, err := formatter.Format(nil, models.FormatConfig{}); err == nil {
+		t.Error("Expected error for nil data")
+	}
+
+	if _, err := formatter.Format(createTerminalTestResult(), models.FormatConfig{Command: "unknown"}); err == nil {
+		t.Error("Expected error for unknown command")
+	}
+}
+
+func TestTerminalFormatter_EmptySections(t *testing.T) {
+	formatter := formatters.NewTerminalFormatter()
+	data := &models.AnalysisResult{Repository: &models.RepositoryInfo{Name: "empty"}}
+
+	tests := map[string]string{
+		"contrib":      "No contribution data available.",
+		"summary":      "No summary data available.",
+		"contributors": "No contributors found.",
+		"health":       "No health metrics available.",
+	}
+
+	for command, want := range tests {
+		output, err := formatter.Format(data, models.FormatConfig{Command: command, NoColor: true})
+		if err != nil {
+			t.Fatalf("%s: unexpected error: %v", command, err)
+		}
+		if !strings.Contains(string(output), want) {
+			t.Errorf("%s: output should contain %q", command, want)
+		}
+	}
+}
+
+func TestTerminalFormatter_FormatColorized(t *testing.T) {
+	formatter := formatters.NewTerminalFormatter()
+
+	colored := formatter.FormatColorized("text", "red")
+	if !strings.Contains(colored, "\x1b[") || !strings.Contains(colored, "text") {
+		t.Errorf("Expected ANSI-colored text, got %q", colored)
+	}
+
+	if got := formatter.FormatColorized("text", "no-such-color"); got != "text" {
+		t.Errorf("Unknown colors should leave text unchanged, got %q", got)
+	}
+
+	if got := formatters.StripANSI(colored); got != "text" {
+		t.Errorf("StripANSI() = %q, want %q", got, "text")
+	}
+}
+
+func TestTerminalFormatter_FormatTable(t *testing.T) {
+	formatter := formatters.NewTerminalFormatter()
+
+	table := formatter.FormatTable([]string{"Name", "Commits"}, [][]string{{"Alice", "3"}, {"Bob", "2"}})
+	for _, want := range []string{"Name", "Commits", "Alice", "Bob"} {
+		if !strings.Contains(table, want) {
+			t.Errorf("Table should contain %q", want)
+		}
+	}
+}
+
+func TestTerminalFormatter_Sections(t *testing.T) {
+	tests := []struct {
+		name     string
+		data     *models.AnalysisResult
+		command  string
+		contains []string
+		excludes []string
+	}{
+		{"growth", createGrowthTestResult(), "growth", []string{"Git Code Growth", "Net Lines of Code per Week", "net: +100",
+			"Excluded lockfiles: 1 file, 40 changed lines", "Net Lines by Directory", "src", "80.0%"}, nil},
+		{"growth without data", &models.AnalysisResult{}, "growth", []string{"No code growth data"}, nil},
+		{"directories", createDirectoryTestResult(), "files", []string{"Module Statistics", "services/api",
+			"Alice 67%, Bob 33%", "1 module, 1 directory outside modules"}, []string{"Most Frequently Modified Files"}},
+		{"teams in summary", createTeamTestResult(), "summary", []string{"Team Statistics", models.NoTeam}, nil},
+		{"teams in health", createTeamTestResult(), "health", []string{"Team Statistics", models.NoTeam}, nil},
+		{"teams in growth", createTeamTestResult(), "growth", []string{"Team Statistics", models.NoTeam}, nil},
+	}
+
+	formatter := formatters.NewTerminalFormatter()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := formatter.Format(tt.data, models.FormatConfig{Command: tt.command, NoColor: true})
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			text := string(output)
+			for _, want := range tt.contains {
+				if !strings.Contains(text, want) {
+					t.Errorf("Output should contain %q:\n%s", want, text)
+				}
+			}
+			for _, unwanted := range tt.excludes {
+				if strings.Contains(text, unwanted) {
+					t.Errorf("Output should not contain %q:\n%s", unwanted, text)
+				}
+			}
+		})
+	}
+}
+
+func createTerminalTestResult() *models.AnalysisResult {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 6)
+	return &models.AnalysisResult{
+		Repository: &models.RepositoryInfo{Name: "test-repo", Path: "/tmp/test-repo", TotalCommits: 5},
+		Summary: &models.StatsSummary{
+			TotalCommits:     5,
+			TotalInsertions:  50,
+			TotalDeletions:   10,
+			ActiveDays:       2,
+			CommitsByHour:    make(map[int]int),
+			CommitsByWeekday: make(map[time.Weekday]int),
+		},
+		Contributors: []models.ContributorStats{
+			{Name: "Alice", Email: "alice@example.com", TotalCommits: 3, FirstCommit: start, LastCommit: end},
+			{Name: "Bob", Email: "bob@example.com", TotalCommits: 2, FirstCommit: start, LastCommit: end},
+		},
+		ContribGraph: &models.ContributionGraph{
+			StartDate:   start,
+			EndDate:     end,
+			DailyValues: map[string]int{"2024-01-01": 3, "2024-01-02": 2},
+			MaxValue:    3,
+			Total:       5,
+		},
+		HealthMetrics: &models.HealthMetrics{RepositoryAge: 7 * 24 * time.Hour, ContributorCount: 2, ActiveContributors: 2},
+		TimeRange:     models.TimeRange{Start: start, End: end},
+	}
+}
+
+func TestTerminalFormatter_Width(t *testing.T) {
+	formatter := formatters.NewTerminalFormatter()
+	data := createTerminalTestResult()
+
+	// Without a width, as for pipes and files, tables keep their natural width
+	output, err := formatter.Format(data, models.FormatConfig{Command: "contributors", NoColor: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, want := range []string{"alice@example.com", "First Commit", "Last 12 Weeks"} {
+		if !strings.Contains(string(output), want) {
+			t.Errorf("Output without a width should contain %q:\n%s", want, output)
+		}
+	}
+
+	// A terminal's width cuts the table to fit
+	output, err = formatter.Format(data, models.FormatConfig{Command: "contributors", NoColor: true, Width: 60})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.Contains(string(output), "alice@example.com") {
+		t.Errorf("Output at width 60 should cut the table:\n%s", output)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - XLSX formatter unit tests
+
+package formatters
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"git-stats/formatters"
+	"git-stats/models"
+)
+
+func TestXLSXFormatter_Format(t *testing.T) {
+	formatter := formatters.NewXLSXFormatter()
+
+	output, err := formatter.Format(createXLSXTestResult(), models.FormatConfig{Format: "xlsx"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	parts := readXLSXParts(t, output)
+
+	required := []string{
+		"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml",
+		"xl/_rels/workbook.xml.rels", "xl/styles.xml",
+	}
+	for i := 1; i <= 6; i++ {
+		required = append(required, "xl/worksheets/sheet"+string(rune('0'+i))+".xml")
+	}
+	for _, name := range required {
+		content, ok := parts[name]
+		if !ok {
+			t.Errorf("Missing workbook part %s", name)
+			continue
+		}
+		if err := xml.Unmarshal([]byte(content), new(struct{})); err != nil {
+			t.Errorf("Part %s is not well-formed XML: %v", name, err)
+		}
+	}
+
+	workbook := parts["xl/workbook.xml"]
+	for _, sheet := range []string{"Summary", "Contributors", "Files", "File Types", "Monthly Growth", "Daily Activity"} {
+		if !strings.Contains(workbook, `name="`+sheet+`"`) {
+			t.Errorf("Workbook should contain sheet %s", sheet)
+		}
+	}
+}
+
+func TestXLSXFormatter_SheetFeatures(t *testing.T) {
+	formatter := formatters.NewXLSXFormatter()
+
+	output, err := formatter.FormatXLSX(createXLSXTestResult())
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	parts := readXLSXParts(t, output)
+	contributors := parts["xl/worksheets/sheet2.xml"]
+
+	checks := map[string]string{
+		"frozen header row": `state="frozen"`,
+		"auto-filter":       `<autoFilter ref="A1:I3">`,
+		"numeric cell":      `<c r="C2"><v>7</v></c>`,
+		"string cell":       `<c r="A2" t="inlineStr"><is><t>Bob</t></is></c>`,
+		"bold header":       `<c r="A1" s="1" t="inlineStr">`,
+	}
+	for name, want := range checks {
+		if !strings.Contains(contributors, want) {
+			t.Errorf("Contributors sheet missing %s (%s)", name, want)
+		}
+	}
+
+	// 2024-01-01 is serial 45292 in the 1900 date system
+	daily := parts["xl/worksheets/sheet6.xml"]
+	if !strings.Contains(daily, `<c r="A2" s="2"><v>45292</v></c>`) {
+		t.Errorf("Daily activity should store dates as typed serial numbers, got: %s", daily)
+	}
+}
+
+func TestXLSXFormatter_TimeZone(t *testing.T) {
+	data := createXLSXTestResult()
+	// 18:00 in Tokyo is 09:00 UTC; the cell keeps the commit's own clock, three quarters of a day
+	data.Contributors[0].FirstCommit = time.Date(2024, 1, 1, 18, 0, 0, 0, time.FixedZone("JST", 9*60*60))
+
+	output, err := formatters.NewXLSXFormatter().FormatXLSX(data)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	contributors := readXLSXParts(t, output)["xl/worksheets/sheet2.xml"]
+	if !strings.Contains(contributors, `<v>45292.75</v>`) {
+		t.Errorf("Expected the first commit at 18:00 on 2024-01-01, got: %s", contributors)
+	}
+}
+
+func TestXLSXFormatter_WriteFormattedOutput(t *testing.T) {
+	handler := formatters.NewFileOutputHandler(false, formatters.OverwriteModeReplace)
+	path := filepath.Join(t.TempDir(), "report.xlsx")
+
+	err := handler.WriteFormattedOutput(createXLSXTestResult(), formatters.NewXLSXFormatter(),
+		models.FormatConfig{Format: "xlsx"},
+		formatters.FileOutputConfig{OutputPath: path, OverwriteMode: formatters.OverwriteModeReplace})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Workbook was not written: %v", err)
+	}
+	if !bytes.HasPrefix(content, []byte("PK")) {
+		t.Error("Workbook should be a zip package")
+	}
+}
+
+func TestXLSXFormatter_NilData(t *testing.T) {
+	if _, err := formatters.NewXLSXFormatter().Format(nil, models.FormatConfig{}); err == nil {
+		t.Error("Expected error for nil analysis result")
+	}
+}
+
+// readXLSXParts unzips a workbook into a map of part name to content
+func readXLSXParts(t *testing.T, data []byte) map[string]string {
+	t.Helper()
+
+	reader, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		t.Fatalf("Workbook is not a valid zip: %v", err)
+	}
+
+	parts := make(map[string]string)
+	for _, file := range reader.File {
+		rc, err := file.Open()
+		if err != nil {
+			t.Fatalf("Failed to open %s: %v", file.Name, err)
+		}
+		content, _ := io.ReadAll(rc)
+		rc.Close()
+		parts[file.Name] = string(content)
+	}
+	return parts
+}
+
+// createXLSXTestResult creates a small analysis result for workbook tests
+func createXLSXTestResult() *models.AnalysisResult {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &models.AnalysisResult{
+		Repository: &models.RepositoryInfo{Name: "test-repo", Path: "/tmp/test-repo"},
+		Summary: &models.StatsSummary{
+			TotalCommits:     10,
+			AvgCommitsPerDay: 2.5,
+			TopFiles:         []models.FileStats{{Path: "main.go", Commits: 4, LastModified: start}},
+			TopFileTypes:     []models.FileTypeStats{{Extension: "go", Files: 1, Commits: 4, Lines: 80}},
+		},
+		Contributors: []models.ContributorStats{
+			{Name: "Bob", Email: "bob@example.com", TotalCommits: 7, FirstCommit: start, LastCommit: start.AddDate(0, 0, 3)},
+			{Name: "Alice & Co <dev>", Email: "alice@example.com", TotalCommits: 3},
+		},
+		ContribGraph: &models.ContributionGraph{
+			DailyValues: map[string]int{"2024-01-02": 4, "2024-01-01": 6},
+		},
+		HealthMetrics: &models.HealthMetrics{
+			MonthlyGrowth: []models.MonthlyStats{{Month: start, Commits: 10, Authors: 2}},
+		},
+		TimeRange: models.TimeRange{Start: start, End: start.AddDate(0, 0, 3)},
+	}
+}
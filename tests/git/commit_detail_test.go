@@ -0,0 +1,128 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Commit detail retrieval tests
+
+package git
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"git-stats/git"
+)
+
+func TestGitRepository_GetCommitDetail(t *testing.T) {
+	if !git.IsGitAvailable() {
+		t.Skip("git not available in PATH")
+	}
+
+	repoDir := createCommitDetailTestRepo(t)
+
+	repo, err := git.NewGitRepository(git.RepositoryConfig{Path: repoDir})
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+
+	hash := runCommitDetailGit(t, repoDir, "rev-parse", "HEAD")
+
+	detail, err := repo.GetCommitDetail(hash)
+	if err != nil {
+		t.Fatalf("GetCommitDetail() error = %v", err)
+	}
+
+	if detail.Commit.Hash != hash {
+		t.Errorf("Hash = %s, want %s", detail.Commit.Hash, hash)
+	}
+	if detail.Commit.Message != "Update readme" {
+		t.Errorf("Subject = %q, want %q", detail.Commit.Message, "Update readme")
+	}
+	if !strings.Contains(detail.FullMessage, "Explain the project in more detail.") {
+		t.Errorf("FullMessage should include the body, got %q", detail.FullMessage)
+	}
+
+	statuses := make(map[string]string)
+	for _, file := range detail.Commit.Stats.Files {
+		statuses[file.Path] = file.Status
+	}
+	if statuses["README.md"] != "M" {
+		t.Errorf("README.md status = %q, want M", statuses["README.md"])
+	}
+	if statuses["main.go"] != "A" {
+		t.Errorf("main.go status = %q, want A", statuses["main.go"])
+	}
+
+	for _, want := range []string{"diff --git a/README.md b/README.md", "+More detail", "+package main"} {
+		if !strings.Contains(detail.Diff, want) {
+			t.Errorf("Diff should contain %q", want)
+		}
+	}
+}
+
+func TestGitRepository_GetCommitDetail_InvalidHash(t *testing.T) {
+	if !git.IsGitAvailable() {
+		t.Skip("git not available in PATH")
+	}
+
+	repoDir := createCommitDetailTestRepo(t)
+
+	repo, err := git.NewGitRepository(git.RepositoryConfig{Path: repoDir})
+	if err != nil {
+		t.Fatalf("Failed to create repository: %v", err)
+	}
+
+	tests := []struct {
+		name string
+		hash string
+	}{
+		{"empty", ""},
+		{"option injection", "--all"},
+		{"revision expression", "HEAD~1"},
+		{"unknown hash", "deadbeefdeadbeef"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := repo.GetCommitDetail(tt.hash); err == nil {
+				t.Errorf("Expected error for hash %q", tt.hash)
+			}
+		})
+	}
+}
+
+// createCommitDetailTestRepo creates a repository with two commits, the second with a multi-line message
+func createCommitDetailTestRepo(t *testing.T) string {
+	dir := t.TempDir()
+
+	runCommitDetailGit(t, dir, "init", "-q")
+	runCommitDetailGit(t, dir, "config", "user.name", "Test User")
+	runCommitDetailGit(t, dir, "config", "user.email", "test@example.com")
+
+	writeCommitDetailFile(t, dir, "README.md", "# Test\n")
+	runCommitDetailGit(t, dir, "add", ".")
+	runCommitDetailGit(t, dir, "commit", "-q", "-m", "Initial commit")
+
+	writeCommitDetailFile(t, dir, "README.md", "# Test\nMore detail\n")
+	writeCommitDetailFile(t, dir, "main.go", "package main\n")
+	runCommitDetailGit(t, dir, "add", ".")
+	runCommitDetailGit(t, dir, "commit", "-q", "-m", "Update readme", "-m", "Explain the project in more detail.")
+
+	return dir
+}
+
+func writeCommitDetailFile(t *testing.T, dir, name, content string) {
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write %s: %v", name, err)
+	}
+}
+
+func runCommitDetailGit(t *testing.T, dir string, args ...string) string {
+	cmd := exec.Command("git", args...)
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("git %s failed: %v\n%s", strings.Join(args, " "), err, output)
+	}
+	return strings.TrimSpace(string(output))
+}
@@ -0,0 +1,55 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Integration tests for GUI routing in the command dispatcher
+
+//go:build !gui
+// +build !gui
+
+package integration
+
+import (
+	"testing"
+
+	"git-stats/actions"
+	"git-stats/cli"
+)
+
+// TestCommandDispatcherGUIRouting checks that GUI mode reaches the GUI, which builds without
+// the gui tag report as unavailable, and that output options are rejected before it
+func TestCommandDispatcherGUIRouting(t *testing.T) {
+	tempDir, cleanup := createTestRepository(t)
+	defer cleanup()
+	t.Setenv("XDG_CONFIG_HOME", t.TempDir())
+
+	dispatcher := actions.NewCommandDispatcher()
+
+	tests := []struct {
+		name      string
+		config    *cli.Config
+		errorType actions.CommandErrorType
+	}{
+		{"gui flag", &cli.Config{Command: "contrib", GUIMode: true, RepoPath: tempDir, Format: "terminal", Limit: 1000},
+			actions.ErrGUIUnavailable},
+		{"gui flag with another command", &cli.Config{Command: "health", GUIMode: true, RepoPath: tempDir, Format: "terminal", Limit: 1000},
+			actions.ErrGUIUnavailable},
+		{"gui command", &cli.Config{Command: "gui", RepoPath: tempDir, Format: "terminal", Limit: 1000},
+			actions.ErrGUIUnavailable},
+		{"gui with json format", &cli.Config{Command: "contrib", GUIMode: true, RepoPath: tempDir, Format: "json", Limit: 1000},
+			actions.ErrInvalidConfiguration},
+		{"gui with output file", &cli.Config{Command: "contrib", GUIMode: true, RepoPath: tempDir, Format: "terminal",
+			OutputFile: tempDir + "/out.txt", Limit: 1000}, actions.ErrInvalidConfiguration},
+		{"gui with missing repository", &cli.Config{Command: "contrib", GUIMode: true, RepoPath: "/nonexistent/path", Format: "terminal", Limit: 1000},
+			actions.ErrRepositoryAccess},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := dispatcher.ExecuteCommand(tt.config)
+			if err == nil {
+				t.Fatal("Expected an error")
+			}
+			if errorType, ok := actions.GetErrorType(err); !ok || errorType != tt.errorType {
+				t.Errorf("Expected error type %v, got %v (%v)", tt.errorType, errorType, err)
+			}
+		})
+	}
+}
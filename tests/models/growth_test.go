@@ -0,0 +1,59 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Unit tests for the code growth periods
+
+package models_test
+
+import (
+	"git-stats/models"
+	"testing"
+	"time"
+)
+
+func TestPeriodStart(t *testing.T) {
+	// 2024-03-14 is a Thursday
+	at := time.Date(2024, 3, 14, 18, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		interval string
+		start    time.Time
+		next     time.Time
+		label    string
+	}{
+		{models.GrowthDay, time.Date(2024, 3, 14, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), "2024-03-14"},
+		{models.GrowthWeek, time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC), time.Date(2024, 3, 18, 0, 0, 0, 0, time.UTC), "2024-03-11"},
+		{models.GrowthMonth, time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), "2024-03"},
+	}
+
+	for _, tt := range tests {
+		start := models.PeriodStart(at, tt.interval)
+		if !start.Equal(tt.start) {
+			t.Errorf("%s: expected period to start %s, got %s", tt.interval, tt.start, start)
+		}
+		if next := models.NextPeriod(start, tt.interval); !next.Equal(tt.next) {
+			t.Errorf("%s: expected next period %s, got %s", tt.interval, tt.next, next)
+		}
+		if label := models.PeriodLabel(start, tt.interval); label != tt.label {
+			t.Errorf("%s: expected label %q, got %q", tt.interval, tt.label, label)
+		}
+	}
+
+	if start := models.PeriodStart(at, ""); !start.Equal(tests[1].start) {
+		t.Errorf("an empty interval should mean weeks, got %s", start)
+	}
+}
+
+func TestGrowthSeries(t *testing.T) {
+	var empty models.GrowthSeries
+	if empty.Final() != 0 || empty.Insertions() != 0 || empty.Deletions() != 0 {
+		t.Error("an empty series should have no lines")
+	}
+
+	series := models.GrowthSeries{Points: []models.GrowthPoint{
+		{Insertions: 10, Deletions: 2, Net: 8, Total: 8},
+		{Insertions: 1, Deletions: 5, Net: -4, Total: 4},
+	}}
+	if series.Final() != 4 || series.Insertions() != 11 || series.Deletions() != 7 {
+		t.Errorf("expected final 4, 11 insertions and 7 deletions, got %d, %d and %d",
+			series.Final(), series.Insertions(), series.Deletions())
+	}
+}
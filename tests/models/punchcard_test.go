@@ -0,0 +1,39 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Unit tests for the hour by weekday punch card
+
+package models_test
+
+import (
+	"git-stats/models"
+	"testing"
+	"time"
+)
+
+func TestPunchCard(t *testing.T) {
+	var card models.PunchCard
+
+	if weekday, hour := card.Peak(); weekday != time.Sunday || hour != 0 || card.Max() != 0 {
+		t.Errorf("empty card should peak at Sunday 00:00 with 0 commits, got %s %d", weekday, hour)
+	}
+
+	// 2024-03-01 is a Friday
+	friday := time.Date(2024, 3, 1, 18, 30, 0, 0, time.UTC)
+	card.Add(friday)
+	card.Add(friday.Add(10 * time.Minute))
+	card.Add(friday.Add(time.Hour))
+	card.Add(friday.AddDate(0, 0, 3).Add(-9 * time.Hour)) // Monday 09:30
+
+	if card.Total() != 4 {
+		t.Errorf("expected 4 commits, got %d", card.Total())
+	}
+	if card[time.Friday][18] != 2 || card[time.Friday][19] != 1 || card[time.Monday][9] != 1 {
+		t.Errorf("commits counted in the wrong cells: %v", card)
+	}
+	if weekday, hour := card.Peak(); weekday != time.Friday || hour != 18 || card.Max() != 2 {
+		t.Errorf("expected peak Friday 18:00 with 2 commits, got %s %d with %d", weekday, hour, card.Max())
+	}
+	if card.WeekdayTotal(time.Friday) != 3 || card.WeekdayTotal(time.Sunday) != 0 {
+		t.Errorf("unexpected weekday totals: Friday %d, Sunday %d",
+			card.WeekdayTotal(time.Friday), card.WeekdayTotal(time.Sunday))
+	}
+}
@@ -4,6 +4,7 @@
 package models_test
 
 import (
+	"fmt"
 	"git-stats/models"
 	"testing"
 	"time"
@@ -104,31 +105,115 @@ func TestContributionGraph(t *testing.T) {
 	graph := &models.ContributionGraph{
 		StartDate: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
 		EndDate:   time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
-		DailyCommits: map[string]int{
+		DailyValues: map[string]int{
 			"2024-01-01": 3,
 			"2024-01-02": 1,
 			"2024-01-03": 0,
 			"2024-01-04": 5,
 			"2024-01-05": 2,
 		},
-		MaxCommits:   5,
-		TotalCommits: 11,
+		MaxValue: 5,
+		Total:    11,
 	}
 
-	if graph.MaxCommits != 5 {
-		t.Errorf("Expected max commits 5, got %d", graph.MaxCommits)
+	if graph.MaxValue != 5 {
+		t.Errorf("Expected max commits 5, got %d", graph.MaxValue)
 	}
 
-	if graph.TotalCommits != 11 {
-		t.Errorf("Expected total commits 11, got %d", graph.TotalCommits)
+	if graph.Total != 11 {
+		t.Errorf("Expected total commits 11, got %d", graph.Total)
 	}
 
-	if graph.DailyCommits["2024-01-04"] != 5 {
-		t.Errorf("Expected 5 commits on 2024-01-04, got %d", graph.DailyCommits["2024-01-04"])
+	if graph.DailyValues["2024-01-04"] != 5 {
+		t.Errorf("Expected 5 commits on 2024-01-04, got %d", graph.DailyValues["2024-01-04"])
 	}
 
-	if graph.DailyCommits["2024-01-03"] != 0 {
-		t.Errorf("Expected 0 commits on 2024-01-03, got %d", graph.DailyCommits["2024-01-03"])
+	if graph.DailyValues["2024-01-03"] != 0 {
+		t.Errorf("Expected 0 commits on 2024-01-03, got %d", graph.DailyValues["2024-01-03"])
+	}
+}
+
+func TestContributionGraphDominantAuthor(t *testing.T) {
+	graph := &models.ContributionGraph{
+		Authors: []models.AuthorContributions{
+			{Name: "Alice", Daily: map[string]int{"2024-01-01": 2, "2024-01-02": 1}, Total: 3},
+			{Name: "Bob", Daily: map[string]int{"2024-01-01": 3, "2024-01-02": 1}, Total: 4},
+		},
+	}
+
+	tests := []struct {
+		date string
+		want int
+	}{
+		{"2024-01-01", 1}, // Bob has the most
+		{"2024-01-02", 0}, // ties go to the author listed first
+		{"2024-01-03", -1},
+	}
+	for _, tt := range tests {
+		if got := graph.DominantAuthor(tt.date); got != tt.want {
+			t.Errorf("DominantAuthor(%s) = %d, want %d", tt.date, got, tt.want)
+		}
+	}
+
+	if got := (&models.ContributionGraph{}).DominantAuthor("2024-01-01"); got != -1 {
+		t.Errorf("Expected -1 without authors, got %d", got)
+	}
+}
+
+func TestMetricLabel(t *testing.T) {
+	tests := []struct {
+		metric string
+		label  string
+		title  string
+	}{
+		{"", "commits", "Commits"},
+		{models.MetricCommits, "commits", "Commits"},
+		{models.MetricLines, "lines changed", "Lines Changed"},
+		{models.MetricInsertions, "insertions", "Insertions"},
+		{models.MetricDeletions, "deletions", "Deletions"},
+		{models.MetricFiles, "files touched", "Files Touched"},
+		{models.MetricAuthors, "active authors", "Active Authors"},
+	}
+
+	for _, tt := range tests {
+		if got := models.MetricLabel(tt.metric); got != tt.label {
+			t.Errorf("MetricLabel(%q) = %q, want %q", tt.metric, got, tt.label)
+		}
+		if got := models.MetricTitle(tt.metric); got != tt.title {
+			t.Errorf("MetricTitle(%q) = %q, want %q", tt.metric, got, tt.title)
+		}
+	}
+}
+
+func TestActivityLevels(t *testing.T) {
+	thresholds := []int{3, 9, 19}
+	for value, want := range map[int]int{0: 0, 1: 1, 3: 1, 4: 2, 9: 2, 10: 3, 19: 3, 20: 4, 500: 4} {
+		if got := models.ActivityLevel(value, thresholds); got != want {
+			t.Errorf("ActivityLevel(%d) = %d, want %d", value, got, want)
+		}
+	}
+
+	ranges := []struct {
+		thresholds []int
+		want       string
+	}{
+		{[]int{3, 9, 19}, "[1-3 4-9 10-19 20+]"},
+		{[]int{1, 2, 5}, "[1 2 3-5 6+]"},
+		{[]int{0, 1, 1}, "[- 1 - 2+]"},
+	}
+	for _, tt := range ranges {
+		if got := fmt.Sprint(models.LevelRanges(tt.thresholds)); got != tt.want {
+			t.Errorf("LevelRanges(%v) = %s, want %s", tt.thresholds, got, tt.want)
+		}
+	}
+
+	// Graphs without thresholds split the busiest day into quarters
+	graph := &models.ContributionGraph{MaxValue: 100}
+	if got := fmt.Sprint(graph.LevelThresholds()); got != "[25 50 75]" {
+		t.Errorf("Expected linear thresholds, got %s", got)
+	}
+	if graph.ActivityLevel(60) != 3 {
+		t.Errorf("Expected level 3 for 60, got %d", graph.ActivityLevel(60))
 	}
 }
 
@@ -225,3 +310,45 @@ func TestTimeRange(t *testing.T) {
 		t.Errorf("Expected duration %v, got %v", expectedDuration, duration)
 	}
 }
+
+func TestWeeklyStats(t *testing.T) {
+	tests := []struct {
+		date     time.Time
+		expected time.Time
+	}{
+		{time.Date(2024, 3, 4, 9, 0, 0, 0, time.UTC), time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)},   // Monday
+		{time.Date(2024, 3, 10, 23, 0, 0, 0, time.UTC), time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)}, // Sunday
+		{time.Date(2024, 1, 3, 12, 0, 0, 0, time.UTC), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)},  // Wednesday
+	}
+
+	for _, tt := range tests {
+		if week := models.WeekStart(tt.date); !week.Equal(tt.expected) {
+			t.Errorf("WeekStart(%s) = %s, want %s", tt.date.Format("Mon 2006-01-02"), week, tt.expected)
+		}
+	}
+
+	week := models.WeeklyStats{Commits: 3, Insertions: 40, Deletions: 55}
+	if week.NetLines() != -15 {
+		t.Errorf("Expected -15 net lines, got %d", week.NetLines())
+	}
+}
+
+func TestContributorWeeklyCommits(t *testing.T) {
+	contributor := models.Contributor{
+		CommitsByDay: map[string]int{
+			"2024-03-10": 2, // end day, last week
+			"2024-03-04": 1, // 6 days before, last week
+			"2024-03-03": 4, // 7 days before, the week before
+			"2024-01-01": 9, // outside the 4 weeks
+			"2024-03-11": 5, // after the end
+		},
+	}
+
+	weeks := contributor.WeeklyCommits(time.Date(2024, 3, 10, 18, 0, 0, 0, time.UTC), 4)
+	expected := []int{0, 0, 4, 3}
+	for i := range expected {
+		if weeks[i] != expected[i] {
+			t.Fatalf("Expected %v, got %v", expected, weeks)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Unit tests for team membership
+
+package models_test
+
+import (
+	"git-stats/models"
+	"testing"
+	"time"
+)
+
+func TestTeamsTeamOf(t *testing.T) {
+	switched := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	teams := models.Teams{
+		{Name: "platform", Members: []models.TeamMember{
+			{Pattern: "*@infra.example.com"},
+			{Pattern: "alice@example.com", Until: switched.AddDate(0, 0, -1)},
+		}},
+		{Name: "web", Members: []models.TeamMember{
+			{Pattern: "alice@example.com", Since: switched},
+			{Pattern: "Bob@Example.com"},
+		}},
+	}
+
+	tests := []struct {
+		email string
+		when  time.Time
+		team  string
+	}{
+		{"carol@infra.example.com", switched, "platform"},
+		{"alice@example.com", time.Date(2024, 3, 31, 23, 30, 0, 0, time.UTC), "platform"},
+		{"ALICE@example.com", time.Date(2024, 4, 1, 0, 30, 0, 0, time.UTC), "web"},
+		{"bob@example.com", switched, "web"},
+		{"dave@example.com", switched, models.NoTeam},
+	}
+	for _, tt := range tests {
+		if team := teams.TeamOf(tt.email, tt.when); team != tt.team {
+			t.Errorf("TeamOf(%s, %s) = %q, want %q", tt.email, tt.when.Format("2006-01-02 15:04"), team, tt.team)
+		}
+	}
+
+	if teams.Find("WEB") == nil || teams.Find("ops") != nil {
+		t.Errorf("Expected Find to match names regardless of case")
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Unit tests for contribution graph color themes
+
+package models_test
+
+import (
+	"git-stats/models"
+	"strings"
+	"testing"
+)
+
+func TestParseHexColor(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected models.RGB
+		valid    bool
+	}{
+		{"#26a641", models.RGB{R: 0x26, G: 0xa6, B: 0x41}, true},
+		{"26A641", models.RGB{R: 0x26, G: 0xa6, B: 0x41}, true},
+		{"#fa0", models.RGB{R: 0xff, G: 0xaa, B: 0x00}, true},
+		{"#26a64", models.RGB{}, false},
+		{"green", models.RGB{}, false},
+		{"", models.RGB{}, false},
+	}
+
+	for _, tt := range tests {
+		color, err := models.ParseHexColor(tt.input)
+		if tt.valid != (err == nil) {
+			t.Errorf("ParseHexColor(%q) error = %v, want valid %v", tt.input, err, tt.valid)
+			continue
+		}
+		if tt.valid && color != tt.expected {
+			t.Errorf("ParseHexColor(%q) = %+v, want %+v", tt.input, color, tt.expected)
+		}
+	}
+
+	if hex := (models.RGB{R: 0x26, G: 0xa6, B: 0x41}).Hex(); hex != "#26a641" {
+		t.Errorf("Hex() = %s, want #26a641", hex)
+	}
+}
+
+func TestResolveTheme(t *testing.T) {
+	custom := map[string][]string{"Purple": {"#000", "#fff"}}
+
+	theme, err := models.ResolveTheme("", custom)
+	if err != nil || theme.Name != models.DefaultThemeName {
+		t.Fatalf("empty name should resolve to the default theme, got %+v, %v", theme, err)
+	}
+
+	theme, err = models.ResolveTheme("purple", custom)
+	if err != nil || len(theme.Colors) != 2 {
+		t.Fatalf("custom theme should resolve case-insensitively, got %+v, %v", theme, err)
+	}
+
+	for _, name := range models.BuiltinThemeNames() {
+		if _, err := models.ResolveTheme(name, nil); err != nil {
+			t.Errorf("built-in theme %s: %v", name, err)
+		}
+	}
+
+	if _, err := models.ResolveTheme("nope", custom); err == nil || !strings.Contains(err.Error(), "viridis") {
+		t.Errorf("unknown theme should fail listing the built-in themes, got %v", err)
+	}
+
+	if _, err := models.NewTheme("short", []string{"#000"}); err == nil {
+		t.Error("a theme with one color should be rejected")
+	}
+}
+
+func TestThemeLevelColor(t *testing.T) {
+	colors := func(n int) []string {
+		hex := make([]string, n)
+		for i := range hex {
+			hex[i] = models.RGB{R: uint8(i)}.Hex()
+		}
+		return hex
+	}
+
+	tests := []struct {
+		size     int
+		expected []uint8 // palette index of levels 0-4
+	}{
+		{2, []uint8{0, 1, 1, 1, 1}},
+		{5, []uint8{0, 1, 2, 3, 4}},
+		{8, []uint8{0, 1, 3, 5, 7}},
+	}
+
+	for _, tt := range tests {
+		theme, err := models.NewTheme("test", colors(tt.size))
+		if err != nil {
+			t.Fatalf("NewTheme: %v", err)
+		}
+		for level, index := range tt.expected {
+			if got := theme.LevelColor(level).R; got != index {
+				t.Errorf("%d colors: level %d uses color %d, want %d", tt.size, level, got, index)
+			}
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Commit drill-down rendering tests
+
+package visualizers
+
+import (
+	"git-stats/models"
+	"git-stats/visualizers"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestFormatCommitDetail(t *testing.T) {
+	detail := &models.CommitDetail{
+		Commit: models.Commit{
+			Hash:       "0123456789abcdef",
+			Message:    "Fix [bug] in parser",
+			Author:     models.Author{Name: "Alice", Email: "alice@example.com"},
+			AuthorDate: time.Date(2024, 3, 1, 10, 0, 0, 0, time.UTC),
+			Stats: models.CommitStats{
+				FilesChanged: 1,
+				Insertions:   1,
+				Deletions:    1,
+				Files:        []models.FileChange{{Path: "parser.go", Status: "M", Insertions: 1, Deletions: 1}},
+			},
+		},
+		FullMessage: "Fix [bug] in parser\n\nLonger explanation.",
+		Diff: "diff --git a/parser.go b/parser.go\n--- a/parser.go\n+++ b/parser.go\n" +
+			"@@ -1,1 +1,1 @@\n-\told := x[red]\n+\tnew := x\n",
+	}
+
+	output := visualizers.FormatCommitDetail(detail, 1, 3)
+
+	tests := []struct {
+		name     string
+		contains string
+	}{
+		{"position", "Commit 2 of 3 on 2024-03-01"},
+		{"hash", "commit 0123456789abcdef"},
+		{"author", "Alice <alice@example.com>"},
+		{"message body", "Longer explanation."},
+		{"escaped message", "Fix [bug[] in parser"},
+		{"file list", "M parser.go"},
+		{"added line", "[green]+    new := x[white]"},
+		{"removed line escaped", "[red]-    old := x[red[][white]"},
+		{"hunk header", "[cyan]@@ -1,1 +1,1 @@[white]"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !strings.Contains(output, tt.contains) {
+				t.Errorf("Output should contain %q\n%s", tt.contains, output)
+			}
+		})
+	}
+
+	if strings.Contains(output, "\t") {
+		t.Error("Tabs should be expanded")
+	}
+
+	if got := visualizers.FormatCommitDetail(nil, 0, 0); got != "No commit selected" {
+		t.Errorf("FormatCommitDetail(nil) = %q", got)
+	}
+}
+
+func TestFindContributor(t *testing.T) {
+	contributors := []models.Contributor{
+		{Name: "Alice", Email: "alice@example.com"},
+		{Name: "Bob", Email: "bob@example.com"},
+	}
+
+	tests := []struct {
+		name     string
+		author   models.Author
+		expected int
+	}{
+		{"by email", models.Author{Name: "A. Smith", Email: "ALICE@example.com"}, 0},
+		{"by name", models.Author{Name: "Bob", Email: "bob@other.com"}, 1},
+		{"unknown", models.Author{Name: "Carol", Email: "carol@example.com"}, -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := visualizers.FindContributor(contributors, tt.author); got != tt.expected {
+				t.Errorf("FindContributor() = %d, want %d", got, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Commit graph collapsing tests
+
+package visualizers
+
+import (
+	"reflect"
+	"testing"
+
+	"git-stats/models"
+	"git-stats/visualizers"
+)
+
+// linearGraph returns a graph of rows with the given lane glyphs; rows marked linear can be collapsed
+func linearGraph(rows ...struct {
+	graph  string
+	linear bool
+}) *models.CommitGraph {
+	graph := &models.CommitGraph{MaxLanes: 2}
+	for _, row := range rows {
+		graph.Rows = append(graph.Rows, models.CommitGraphRow{Graph: row.graph, Linear: row.linear})
+	}
+	return graph
+}
+
+func TestCollapseCommitGraph(t *testing.T) {
+	type row = struct {
+		graph  string
+		linear bool
+	}
+	tip, straight, side, merge := row{"●", false}, row{"●", true}, row{"● │", true}, row{"●─╮", false}
+
+	tests := []struct {
+		name     string
+		rows     []row
+		minRun   int
+		expanded map[int]bool
+		want     []visualizers.CommitGraphLine
+	}{
+		{
+			name:   "a run keeps its first and last commit",
+			rows:   []row{tip, straight, straight, straight, straight, straight},
+			minRun: 3,
+			want:   []visualizers.CommitGraphLine{{Row: 0}, {Row: 1}, {Row: 2, Hidden: 3}, {Row: 5}},
+		},
+		{
+			name:   "runs shorter than minRun stay open",
+			rows:   []row{tip, straight, straight, merge},
+			minRun: 3,
+			want:   []visualizers.CommitGraphLine{{Row: 0}, {Row: 1}, {Row: 2}, {Row: 3}},
+		},
+		{
+			name:   "minRun below 3 shows every commit",
+			rows:   []row{straight, straight, straight, straight},
+			minRun: 2,
+			want:   []visualizers.CommitGraphLine{{Row: 0}, {Row: 1}, {Row: 2}, {Row: 3}},
+		},
+		{
+			name:   "different lanes break a run",
+			rows:   []row{straight, straight, straight, side, side, side},
+			minRun: 3,
+			want: []visualizers.CommitGraphLine{
+				{Row: 0}, {Row: 1, Hidden: 1}, {Row: 2},
+				{Row: 3}, {Row: 4, Hidden: 1}, {Row: 5},
+			},
+		},
+		{
+			name:   "merges break a run",
+			rows:   []row{straight, straight, straight, merge, straight, straight, straight, straight},
+			minRun: 4,
+			want: []visualizers.CommitGraphLine{
+				{Row: 0}, {Row: 1}, {Row: 2}, {Row: 3},
+				{Row: 4}, {Row: 5, Hidden: 2}, {Row: 7},
+			},
+		},
+		{
+			name:     "expanded runs stay open",
+			rows:     []row{straight, straight, straight, straight},
+			minRun:   3,
+			expanded: map[int]bool{1: true},
+			want:     []visualizers.CommitGraphLine{{Row: 0}, {Row: 1}, {Row: 2}, {Row: 3}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := visualizers.CollapseCommitGraph(linearGraph(tt.rows...), tt.minRun, tt.expanded)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CollapseCommitGraph() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	if lines := visualizers.CollapseCommitGraph(nil, 3, nil); lines != nil {
+		t.Errorf("Expected no lines for a nil graph, got %+v", lines)
+	}
+}
@@ -0,0 +1,51 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Contributor comparison renderer tests
+
+package visualizers
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"git-stats/models"
+	"git-stats/visualizers"
+)
+
+func TestRenderComparison_HeatmapWindow(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	comparison := &models.ContributorComparison{
+		TimeRange: models.TimeRange{Start: start, End: end},
+		Months:    []time.Time{start},
+		Contributors: []models.ComparedContributor{
+			{Name: "Alice", TotalCommits: 2, CommitsByDay: map[string]int{"2022-03-01": 1, "2024-05-01": 1}, CommitsByMonth: []int{0}},
+			{Name: "Bob", TotalCommits: 3, CommitsByDay: map[string]int{"2022-02-01": 3}, CommitsByMonth: []int{0}},
+			{Name: "Carol", TotalCommits: 1, CommitsByDay: map[string]int{"2024-01-02": 1}, CommitsByMonth: []int{0}},
+		},
+	}
+
+	renderer := visualizers.NewComparisonRenderer(models.RenderConfig{Width: 200})
+	renderer.SetColorOptions(false)
+	output, err := renderer.RenderComparison(comparison)
+	if err != nil {
+		t.Fatalf("RenderComparison: %v", err)
+	}
+
+	// Ranges over a year show their last year, and say how many commits fall outside it
+	for _, want := range []string{"Last year of the period: 2023-06-01 to 2024-06-01", "Alice (1 of 2 commits)", "Bob (0 of 3 commits)"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Output should contain %q:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "Carol (") {
+		t.Errorf("Carol's commits are all shown and need no count:\n%s", output)
+	}
+
+	// Shorter ranges are shown whole
+	comparison.TimeRange.Start = end.AddDate(0, -6, 0)
+	output, _ = renderer.RenderComparison(comparison)
+	if strings.Contains(output, "Last year of the period") || strings.Contains(output, "commits)") {
+		t.Errorf("A range under a year should be shown whole:\n%s", output)
+	}
+}
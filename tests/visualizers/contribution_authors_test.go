@@ -0,0 +1,176 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Per-author contribution graph tests
+
+package visualizers
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"git-stats/models"
+	"git-stats/visualizers"
+)
+
+// createAuthorGraph returns a January 2024 graph of three authors: Alice busiest, Bob
+// dominating the 10th and Carol with a single commit
+func createAuthorGraph() *models.ContributionGraph {
+	return &models.ContributionGraph{
+		StartDate:   time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:     time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC),
+		DailyValues: map[string]int{"2024-01-08": 4, "2024-01-10": 3, "2024-01-12": 1},
+		MaxValue:    4,
+		Total:       8,
+		Authors: []models.AuthorContributions{
+			{Name: "Alice", Email: "alice@example.com", Daily: map[string]int{"2024-01-08": 4, "2024-01-10": 1}, Total: 5},
+			{Name: "Bob", Email: "bob@example.com", Daily: map[string]int{"2024-01-10": 2}, Total: 2},
+			{Name: "Carol", Email: "carol@example.com", Daily: map[string]int{"2024-01-12": 1}, Total: 1},
+		},
+	}
+}
+
+func TestRenderDominantAuthorGraph(t *testing.T) {
+	config := models.RenderConfig{Width: 80, ShowLegend: true}
+	renderer := visualizers.NewContributionGraphRenderer(config)
+	renderer.SetColorOptions(false, "github")
+
+	result, err := renderer.RenderDominantAuthorGraph(createAuthorGraph(), 2, config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Without colors each day shows its author's key: 1 for Alice on Monday the 8th, 2 for
+	// Bob on Wednesday the 10th and + for Carol, beyond the top 2, on Friday the 12th
+	lines := strings.Split(result, "\n")
+	for _, want := range []struct {
+		row int
+		key string
+	}{{2, "1"}, {4, "2"}, {6, "+"}} {
+		if !strings.Contains(lines[want.row], want.key) {
+			t.Errorf("Expected key %s on row %d, got %q", want.key, want.row, lines[want.row])
+		}
+	}
+	if strings.ContainsAny(lines[1], "12+") {
+		t.Errorf("Expected no activity on Sundays, got %q", lines[1])
+	}
+
+	if !strings.Contains(result, "1 Alice (5)   2 Bob (2)   + 1 other (1)") {
+		t.Errorf("Expected the legend to map keys to authors, got:\n%s", result)
+	}
+
+	if _, err := renderer.RenderDominantAuthorGraph(nil, 2, config); err == nil {
+		t.Error("Expected error for nil graph")
+	}
+}
+
+func TestRenderDominantAuthorGraphColors(t *testing.T) {
+	config := models.RenderConfig{Width: 80, ShowLegend: true}
+	renderer := visualizers.NewContributionGraphRenderer(config)
+	renderer.SetColorOptions(true, "github")
+	renderer.SetColorDepth(visualizers.TrueColor)
+
+	result, err := renderer.RenderDominantAuthorGraph(createAuthorGraph(), 2, config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	// Alice and Bob take the first palette colors, the others gray
+	for _, color := range []string{"\033[38;2;78;121;167m", "\033[38;2;242;142;43m", "\033[38;2;186;176;172m"} {
+		if !strings.Contains(result, color) {
+			t.Errorf("Expected color %q in the graph", color)
+		}
+	}
+}
+
+func TestRenderAuthorLegendWraps(t *testing.T) {
+	graph := createAuthorGraph()
+	for i := 0; i < 6; i++ {
+		graph.Authors = append(graph.Authors, models.AuthorContributions{
+			Name: fmt.Sprintf("Contributor with a long name %d", i), Daily: map[string]int{}, Total: 1})
+	}
+
+	renderer := visualizers.NewContributionGraphRenderer(models.RenderConfig{Width: 60})
+	renderer.SetColorOptions(false, "github")
+
+	legend := renderer.RenderAuthorLegend(graph, 9)
+	for _, line := range strings.Split(legend, "\n") {
+		if len([]rune(line)) > 60 && !strings.HasPrefix(line, "Each day") {
+			t.Errorf("Expected legend lines to fit 60 columns, got %q", line)
+		}
+	}
+	if !strings.Contains(legend, "9 Contributor with a long name 5 (1)") {
+		t.Errorf("Expected nine authors in the legend, got:\n%s", legend)
+	}
+}
+
+func TestRenderAuthorMultiples(t *testing.T) {
+	config := models.RenderConfig{Width: 200, ShowLegend: true}
+	renderer := visualizers.NewContributionGraphRenderer(config)
+	renderer.SetColorOptions(false, "github")
+
+	result, err := renderer.RenderAuthorMultiples(createAuthorGraph(), 2, config)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	lines := strings.Split(result, "\n")
+	if !strings.HasPrefix(lines[0], "1 Alice (5)") || !strings.Contains(lines[0], "2 Bob (2)") {
+		t.Errorf("Expected the calendars of Alice and Bob side by side, got %q", lines[0])
+	}
+	if strings.Contains(result, "Carol") {
+		t.Error("Expected only the top 2 authors to get a calendar")
+	}
+	if !strings.Contains(result, "most active day: 4 commits") || !strings.Contains(result, "1 more author not shown") {
+		t.Errorf("Expected the shared scale and the hidden authors in the legend, got:\n%s", result)
+	}
+
+	// On the shared scale Bob's busiest day is half of Alice's
+	if !strings.Contains(lines[2], "█") || strings.Count(lines[4], "█") != 0 || !strings.Contains(lines[4], "▒") {
+		t.Errorf("Expected Alice's Monday at the top level and Bob's Wednesday lower, got %q and %q", lines[2], lines[4])
+	}
+
+	narrow := models.RenderConfig{Width: 80, ShowLegend: false}
+	result, err = visualizers.NewContributionGraphRenderer(narrow).RenderAuthorMultiples(createAuthorGraph(), 2, narrow)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if strings.Contains(result, "Less") {
+		t.Error("Expected no legend when ShowLegend is false")
+	}
+	if blocks := strings.Count(result, "\nS "); blocks != 4 {
+		t.Errorf("Expected the two calendars stacked in 80 columns, got %d Sunday and Saturday rows", blocks)
+	}
+}
+
+func TestRenderContributionGraphSVG(t *testing.T) {
+	renderer := visualizers.NewContributionGraphRenderer(models.RenderConfig{})
+	graph := createAuthorGraph()
+
+	tests := []struct {
+		mode  string
+		wants []string
+	}{
+		{"", []string{"2024-01-08: 4 commits", "Less", "More"}},
+		{models.ByAuthorDominant, []string{"2024-01-10: 3 commits, mostly Bob", "#4e79a7", "#f28e2b", "Alice (5)", "1 other (1)"}},
+		{models.ByAuthorMultiples, []string{"Alice (5 commits)", "2024-01-10: 2 commits by Bob", "1 more author not shown"}},
+	}
+	for _, tt := range tests {
+		svg, err := renderer.RenderContributionGraphSVG(graph, "Team <1>", tt.mode, 2)
+		if err != nil {
+			t.Fatalf("Unexpected error for mode %q: %v", tt.mode, err)
+		}
+		if !strings.HasPrefix(svg, "<?xml") || !strings.HasSuffix(svg, "</svg>\n") || !strings.Contains(svg, "Team &lt;1&gt;") {
+			t.Errorf("Expected a standalone SVG with an escaped title for mode %q", tt.mode)
+		}
+		for _, want := range tt.wants {
+			if !strings.Contains(svg, want) {
+				t.Errorf("Expected %q in the SVG for mode %q", want, tt.mode)
+			}
+		}
+	}
+
+	if _, err := renderer.RenderContributionGraphSVG(nil, "", "", 5); err == nil {
+		t.Error("Expected error for nil graph")
+	}
+}
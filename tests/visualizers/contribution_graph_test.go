@@ -38,11 +38,11 @@ func TestRenderContributionGraph(t *testing.T) {
 	dailyCommits["2023-01-04"] = 10
 
 	graph := &models.ContributionGraph{
-		StartDate:    startDate,
-		EndDate:      endDate,
-		DailyCommits: dailyCommits,
-		MaxCommits:   10,
-		TotalCommits: 16,
+		StartDate:   startDate,
+		EndDate:     endDate,
+		DailyValues: dailyCommits,
+		MaxValue:    10,
+		Total:       16,
 	}
 
 	config := models.RenderConfig{
@@ -171,11 +171,11 @@ func TestGetDayCommits(t *testing.T) {
 	}
 
 	graph := &models.ContributionGraph{
-		StartDate:    time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
-		EndDate:      time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC),
-		DailyCommits: dailyCommits,
-		MaxCommits:   10,
-		TotalCommits: 15,
+		StartDate:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:     time.Date(2023, 1, 3, 0, 0, 0, 0, time.UTC),
+		DailyValues: dailyCommits,
+		MaxValue:    10,
+		Total:       15,
 	}
 
 	testCases := []struct {
@@ -257,11 +257,11 @@ func TestRenderLegend(t *testing.T) {
 	renderer := visualizers.NewContributionGraphRenderer(config)
 
 	graph := &models.ContributionGraph{
-		StartDate:    time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
-		EndDate:      time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
-		DailyCommits: map[string]int{"2023-01-01": 0},
-		MaxCommits:   20,
-		TotalCommits: 0,
+		StartDate:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		DailyValues: map[string]int{"2023-01-01": 0},
+		MaxValue:    20,
+		Total:       0,
 	}
 
 	result, err := renderer.RenderContributionGraph(graph, config)
@@ -292,11 +292,11 @@ func TestRenderLegend(t *testing.T) {
 
 func TestRenderContributionGraphWithoutLegend(t *testing.T) {
 	graph := &models.ContributionGraph{
-		StartDate:    time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
-		EndDate:      time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
-		DailyCommits: map[string]int{"2023-01-01": 5},
-		MaxCommits:   10,
-		TotalCommits: 5,
+		StartDate:   time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		EndDate:     time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+		DailyValues: map[string]int{"2023-01-01": 5},
+		MaxValue:    10,
+		Total:       5,
 	}
 
 	config := models.RenderConfig{ShowLegend: false}
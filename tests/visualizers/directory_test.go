@@ -0,0 +1,111 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Tests for the directory statistics table
+
+package visualizers
+
+import (
+	"git-stats/models"
+	"git-stats/visualizers"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenderDirectoryStatistics(t *testing.T) {
+	renderer := visualizers.NewChartsRenderer(models.RenderConfig{})
+	renderer.SetColorOptions(false)
+	day := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+
+	directories := []models.DirectoryStats{
+		{Path: "services/api", Manifest: "go.mod", Files: 3, Commits: 3, Insertions: 41, Deletions: 7, Authors: 2,
+			TopAuthors:  []models.ContributorSummary{{Name: "Alice", Percentage: 66.7}, {Name: "Bob", Percentage: 33.3}},
+			FirstChange: day.AddDate(0, 0, -30), LastChange: day, Trend: "increasing",
+			WeeklyCommits: []int{0, 0, 0, 0, 0, 0, 0, 1, 0, 1, 0, 1}},
+		{Path: models.RootDirectory, Files: 1, Commits: 1, Insertions: 3, Authors: 1,
+			TopAuthors:  []models.ContributorSummary{{Name: "Alice", Percentage: 100}},
+			FirstChange: day, LastChange: day, Trend: "stable", WeeklyCommits: make([]int, 12)},
+	}
+
+	headers, rows := visualizers.DirectoryTable(directories)
+	if len(headers) != 11 || headers[1] != "Module" || len(rows) != 2 {
+		t.Fatalf("Expected a Module column and two rows, got %v and %d rows", headers, len(rows))
+	}
+	if rows[0][1] != "go.mod" || rows[1][1] != "-" || rows[0][4] != "+41 -7" || rows[0][6] != "Alice 67%, Bob 33%" {
+		t.Errorf("Unexpected module row %v or root row %v", rows[0], rows[1])
+	}
+
+	output, err := renderer.RenderDirectoryStatistics(directories, models.RenderConfig{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	for _, want := range []string{"Module Statistics", "services/api", "2024-02-03", "increasing",
+		"1 module, 1 directory outside modules"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %q in the table:\n%s", want, output)
+		}
+	}
+
+	headers, _ = visualizers.DirectoryTable(directories[1:])
+	if headers[1] == "Module" {
+		t.Errorf("Expected no Module column without modules, got %v", headers)
+	}
+}
+
+// tableCells splits the header and rows of a rendered table into trimmed cells
+func tableCells(output string) [][]string {
+	var cells [][]string
+	for _, line := range strings.Split(output, "\n") {
+		if !strings.HasPrefix(line, "│") {
+			continue
+		}
+		var row []string
+		for _, cell := range strings.Split(strings.Trim(line, "│"), "│") {
+			row = append(row, strings.TrimSpace(cell))
+		}
+		cells = append(cells, row)
+	}
+	return cells
+}
+
+// assertFullCells fails unless output renders headers and rows cell for cell, without cutting any
+func assertFullCells(t *testing.T, output string, headers []string, rows [][]string) {
+	t.Helper()
+	want := append([][]string{headers}, rows...)
+	got := tableCells(output)
+	if len(got) != len(want) {
+		t.Fatalf("Expected %d table lines, got %d:\n%s", len(want), len(got), output)
+	}
+	for i := range want {
+		if strings.Join(got[i], "|") != strings.Join(want[i], "|") {
+			t.Errorf("Expected line %d to be %q, got %q", i, want[i], got[i])
+		}
+	}
+}
+
+func TestRenderDirectoryStatistics_FullCells(t *testing.T) {
+	renderer := visualizers.NewChartsRenderer(models.RenderConfig{})
+	renderer.SetColorOptions(false)
+	day := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+
+	directories := []models.DirectoryStats{
+		{Path: "services/payments/internal/reconciliation", Manifest: "package.json", Files: 12, Commits: 48,
+			Insertions: 12345, Deletions: 6789, Authors: 3,
+			TopAuthors: []models.ContributorSummary{{Name: "Alexandra Johnson", Percentage: 52.1},
+				{Name: "Bartholomew Smith", Percentage: 30}, {Name: "Chen Wei", Percentage: 17.9}},
+			FirstChange: day.AddDate(-1, 0, 0), LastChange: day, Trend: "decreasing",
+			WeeklyCommits: []int{0, 0, 0, 0, 0, 0, 0, 6, 8, 6, 6, 4}},
+		{Path: models.RootDirectory, Files: 1, Commits: 1, Insertions: 3, Authors: 1,
+			TopAuthors:  []models.ContributorSummary{{Name: "Alice", Percentage: 100}},
+			FirstChange: day, LastChange: day, Trend: "stable", WeeklyCommits: make([]int, 12)},
+	}
+	headers, rows := visualizers.DirectoryTable(directories)
+
+	// Without FitWidth, as for redirected output or -output files, no width narrows the table
+	for _, config := range []models.RenderConfig{{}, {Width: 40}} {
+		output, err := renderer.RenderDirectoryStatistics(directories, config)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		assertFullCells(t, output, headers, rows)
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Tests for the code growth charts
+
+package visualizers
+
+import (
+	"fmt"
+	"git-stats/models"
+	"git-stats/visualizers"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenderCodeGrowth(t *testing.T) {
+	renderer := visualizers.NewChartsRenderer(models.RenderConfig{})
+	renderer.SetColorOptions(false)
+	month := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	growth := &models.CodeGrowth{Interval: models.GrowthMonth, Breakdown: models.GrowthByExtension}
+	for i, net := range []int{500, 250, -100} {
+		previous := 0
+		if i > 0 {
+			previous = growth.Total.Points[i-1].Total
+		}
+		growth.Total.Points = append(growth.Total.Points, models.GrowthPoint{
+			Period: month.AddDate(0, i, 0), Insertions: max(net, 0), Deletions: max(-net, 0), Net: net, Total: previous + net,
+		})
+	}
+	for i := 0; i < 17; i++ {
+		group := models.GrowthSeries{Name: fmt.Sprintf("ext%d", i)}
+		for _, point := range growth.Total.Points {
+			group.Points = append(group.Points, models.GrowthPoint{Period: point.Period, Total: 40 - i})
+		}
+		growth.Groups = append(growth.Groups, group)
+	}
+
+	output, err := renderer.RenderCodeGrowth(growth, models.RenderConfig{Width: 60})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	for _, want := range []string{"Net Lines of Code per Month", "2024-01", "Lines added: 750, deleted: 100, net: +650",
+		"Net Lines by Extension", "│ Extension", "ext0", "ext14", "2 more groups not shown"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %q in the output:\n%s", want, output)
+		}
+	}
+	if strings.Contains(output, "ext15") || strings.Contains(output, "Excluded") {
+		t.Errorf("Expected 15 groups and no exclusion note, got:\n%s", output)
+	}
+	if strings.Contains(output, "\x1b[") {
+		t.Error("Expected no ANSI sequences without colors")
+	}
+
+	headers, rows := visualizers.GrowthTable(growth)
+	if headers[0] != "Extension" || len(rows) != 15 || rows[0][3] != "40" || rows[0][4] != "6.2%" {
+		t.Errorf("Unexpected growth table: %v %v", headers, rows[0])
+	}
+
+	if output, err := renderer.RenderCodeGrowth(&models.CodeGrowth{Interval: models.GrowthWeek}, models.RenderConfig{}); err != nil || !strings.Contains(output, "No line changes") {
+		t.Errorf("Expected a note for empty growth, got %q (%v)", output, err)
+	}
+	if _, err := renderer.RenderCodeGrowth(nil, models.RenderConfig{}); err == nil {
+		t.Error("Expected an error for nil growth")
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - GUI filter value tests
+
+package visualizers
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"git-stats/models"
+	"git-stats/visualizers"
+)
+
+func TestGUIFilter_IsEmpty(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter visualizers.GUIFilter
+		empty  bool
+		sized  bool
+	}{
+		{"zero value", visualizers.GUIFilter{}, true, false},
+		{"author", visualizers.GUIFilter{Author: "alice"}, false, false},
+		{"exclude paths", visualizers.GUIFilter{ExcludePaths: []string{"vendor/"}}, false, false},
+		{"max files", visualizers.GUIFilter{MaxFiles: 3}, false, true},
+		{"min deletions", visualizers.GUIFilter{MinDeletions: 1}, false, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.IsEmpty(); got != tt.empty {
+				t.Errorf("IsEmpty() = %v, want %v", got, tt.empty)
+			}
+			if got := tt.filter.HasSizeBounds(); got != tt.sized {
+				t.Errorf("HasSizeBounds() = %v, want %v", got, tt.sized)
+			}
+		})
+	}
+}
+
+func TestParseFilterList(t *testing.T) {
+	tests := []struct {
+		value    string
+		expected []string
+	}{
+		{"", nil},
+		{"src/", []string{"src/"}},
+		{" src/ , *.go,, docs ", []string{"src/", "*.go", "docs"}},
+	}
+
+	for _, tt := range tests {
+		if got := visualizers.ParseFilterList(tt.value); !reflect.DeepEqual(got, tt.expected) {
+			t.Errorf("ParseFilterList(%q) = %v, want %v", tt.value, got, tt.expected)
+		}
+	}
+}
+
+func TestGUIAnalysisRequest_Merge(t *testing.T) {
+	march := time.Date(2023, 3, 1, 0, 0, 0, 0, time.UTC)
+	june := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+	alice := visualizers.GUIFilter{Author: "alice"}
+	bob := visualizers.GUIFilter{Author: "bob"}
+
+	tests := []struct {
+		name    string
+		pending visualizers.GUIAnalysisRequest
+		next    visualizers.GUIAnalysisRequest
+		want    visualizers.GUIAnalysisRequest
+	}{
+		{"newer filter", visualizers.GUIAnalysisRequest{Filter: alice}, visualizers.GUIAnalysisRequest{Filter: bob},
+			visualizers.GUIAnalysisRequest{Filter: bob}},
+		{"reload keeps the pending filter", visualizers.GUIAnalysisRequest{Filter: bob}, visualizers.GUIAnalysisRequest{Filter: alice, Reload: true},
+			visualizers.GUIAnalysisRequest{Filter: bob, Reload: true}},
+		{"reload after history", visualizers.GUIAnalysisRequest{History: june}, visualizers.GUIAnalysisRequest{Reload: true},
+			visualizers.GUIAnalysisRequest{Reload: true, History: june}},
+		{"history after reload", visualizers.GUIAnalysisRequest{Reload: true}, visualizers.GUIAnalysisRequest{History: june},
+			visualizers.GUIAnalysisRequest{Reload: true, History: june}},
+		{"earliest history", visualizers.GUIAnalysisRequest{History: march}, visualizers.GUIAnalysisRequest{History: june},
+			visualizers.GUIAnalysisRequest{History: march}},
+		{"filter after history", visualizers.GUIAnalysisRequest{History: june}, visualizers.GUIAnalysisRequest{Filter: alice},
+			visualizers.GUIAnalysisRequest{Filter: alice, History: june}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.pending.Merge(tt.next); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Merge() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGUIAnalysisRequest_Handler(t *testing.T) {
+	june := time.Date(2023, 6, 1, 0, 0, 0, 0, time.UTC)
+
+	var calls []string
+	handler := func(name string) visualizers.GUIFilterFunc {
+		return func(visualizers.GUIFilter) (*models.AnalysisResult, string, error) {
+			calls = append(calls, name)
+			return &models.AnalysisResult{}, "", nil
+		}
+	}
+	history := func(since time.Time, _ visualizers.GUIFilter) (*models.AnalysisResult, string, error) {
+		calls = append(calls, "history "+since.Format("2006-01-02"))
+		return &models.AnalysisResult{}, "", nil
+	}
+
+	tests := []struct {
+		name    string
+		request visualizers.GUIAnalysisRequest
+		history visualizers.GUIHistoryFunc
+		want    []string
+	}{
+		{"filter", visualizers.GUIAnalysisRequest{}, history, []string{"filter"}},
+		{"reload", visualizers.GUIAnalysisRequest{Reload: true}, history, []string{"refresh"}},
+		{"history", visualizers.GUIAnalysisRequest{History: june}, history, []string{"history 2023-06-01"}},
+		{"reload merged with history", visualizers.GUIAnalysisRequest{}.Merge(visualizers.GUIAnalysisRequest{History: june}).
+			Merge(visualizers.GUIAnalysisRequest{Reload: true}), history, []string{"refresh", "history 2023-06-01"}},
+		{"history without a handler", visualizers.GUIAnalysisRequest{History: june}, nil, []string{"filter"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls = nil
+			if _, _, err := tt.request.Handler(handler("filter"), handler("refresh"), tt.history)(tt.request.Filter); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(calls, tt.want) {
+				t.Errorf("Expected calls %v, got %v", tt.want, calls)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - GUI key binding tests
+
+package visualizers
+
+import (
+	"strings"
+	"testing"
+
+	"git-stats/visualizers"
+)
+
+func TestNewKeyMap_Defaults(t *testing.T) {
+	keyMap, err := visualizers.NewKeyMap(nil)
+	if err != nil {
+		t.Fatalf("NewKeyMap(nil): %v", err)
+	}
+
+	for action, key := range visualizers.DefaultKeyBindings() {
+		if got, ok := keyMap.Action(key); !ok || string(got) != action {
+			t.Errorf("Action(%q) = %q, %v; want %q", key, got, ok, action)
+		}
+	}
+
+	// An upper-case letter falls back to its lower-case binding unless it is bound itself
+	if action, ok := keyMap.Action("Q"); !ok || action != visualizers.ActionQuit {
+		t.Errorf("Action(\"Q\") = %q, %v; want quit", action, ok)
+	}
+	if action, ok := keyMap.Action("H"); !ok || action != visualizers.ActionHealthView {
+		t.Errorf("Action(\"H\") = %q, %v; want health_view", action, ok)
+	}
+	if _, ok := keyMap.Action("<"); ok {
+		t.Error("< is reserved for navigation and should not be bound")
+	}
+}
+
+func TestNewKeyMap_Bindings(t *testing.T) {
+	tests := []struct {
+		name     string
+		bindings map[string]string
+		action   visualizers.GUIAction
+		key      string // canonical key expected for action
+		display  string // display name expected for action
+		err      string // expected error substring; empty means valid
+	}{
+		{"character", map[string]string{"refresh": "R"}, visualizers.ActionRefresh, "R", "R", ""},
+		{"ctrl plus", map[string]string{"refresh": "Ctrl+R"}, visualizers.ActionRefresh, "ctrl-r", "Ctrl-R", ""},
+		{"ctrl dash", map[string]string{"export": "ctrl-e"}, visualizers.ActionExport, "ctrl-e", "Ctrl-E", ""},
+		{"function key", map[string]string{"help": "F5"}, visualizers.ActionHelp, "f5", "F5", ""},
+		{"swap keys", map[string]string{"quit": "x", "clear_filter": "q"}, visualizers.ActionQuit, "x", "x", ""},
+		{"unknown action", map[string]string{"fly": "z"}, "", "", "", `unknown GUI action "fly"`},
+		{"conflict", map[string]string{"refresh": "q"}, "", "", "", "bound to both"},
+		{"reserved character", map[string]string{"refresh": "j"}, "", "", "", "reserved for navigation"},
+		{"reserved function key", map[string]string{"refresh": "F1"}, "", "", "", "reserved for navigation"},
+		{"reserved ctrl-c", map[string]string{"quit": "Ctrl+C"}, "", "", "", "reserved for navigation"},
+		{"ctrl-h is backspace", map[string]string{"refresh": "Ctrl-H"}, "", "", "", "unsupported key"},
+		{"function key out of range", map[string]string{"refresh": "F13"}, "", "", "", "unsupported key"},
+		{"space", map[string]string{"refresh": " "}, "", "", "", "unsupported key"},
+		{"word", map[string]string{"refresh": "reload"}, "", "", "", "unsupported key"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			keyMap, err := visualizers.NewKeyMap(tt.bindings)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected error containing %q, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if action, ok := keyMap.Action(tt.key); !ok || action != tt.action {
+				t.Errorf("Action(%q) = %q, %v; want %q", tt.key, action, ok, tt.action)
+			}
+			if got := keyMap.Key(tt.action); got != tt.display {
+				t.Errorf("Key(%s) = %q, want %q", tt.action, got, tt.display)
+			}
+		})
+	}
+}
+
+func TestNormalizeKeyName(t *testing.T) {
+	tests := []struct {
+		name string
+		want string
+		ok   bool
+	}{
+		{"a", "a", true},
+		{"A", "A", true},
+		{"/", "/", true},
+		{"Ctrl+R", "ctrl-r", true},
+		{" ctrl-r ", "ctrl-r", true},
+		{"F12", "f12", true},
+		{"Escape", "esc", true},
+		{"PageUp", "pgup", true},
+		{"PageDown", "pgdn", true},
+		{"Home", "home", true},
+		{"Ctrl-M", "", false},
+		{"Ctrl-I", "", false},
+		{"F4x", "", false},
+		{"\t", "", false},
+		{"", "", false},
+	}
+
+	for _, tt := range tests {
+		got, err := visualizers.NormalizeKeyName(tt.name)
+		if tt.ok != (err == nil) || got != tt.want {
+			t.Errorf("NormalizeKeyName(%q) = %q, %v; want %q, ok %v", tt.name, got, err, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestNewKeyMap_SavedDefaults(t *testing.T) {
+	// Configuration files save the default bindings, so they must load as they were written
+	keyMap, err := visualizers.NewKeyMap(map[string]string{"health_view": "h", "quit": "q", "refresh": "r"})
+	if err != nil {
+		t.Fatalf("NewKeyMap with saved defaults: %v", err)
+	}
+	if action, ok := keyMap.Action("h"); !ok || action != visualizers.ActionHealthView {
+		t.Errorf("Action(\"h\") = %q, %v; want health_view", action, ok)
+	}
+
+	// The month and year keys of the contribution graph stay free of bindings
+	for _, key := range []string{",", ".", "<", ">"} {
+		if _, err := visualizers.NewKeyMap(map[string]string{"refresh": key}); err == nil || !strings.Contains(err.Error(), "reserved for navigation") {
+			t.Errorf("binding %q: expected a reserved key error, got %v", key, err)
+		}
+	}
+}
+
+func TestParseViewName(t *testing.T) {
+	tests := []struct {
+		name string
+		want visualizers.ViewType
+	}{
+		{"", visualizers.ContributionView},
+		{"contrib", visualizers.ContributionView},
+		{" Stats ", visualizers.StatisticsView},
+		{"summary", visualizers.StatisticsView},
+		{"team", visualizers.ContributorsView},
+		{"HEALTH", visualizers.HealthView},
+		{"tree", visualizers.FilesView},
+		{"log", visualizers.GraphView},
+	}
+	for _, tt := range tests {
+		got, err := visualizers.ParseViewName(tt.name)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseViewName(%q) = %v, %v; want %v", tt.name, got, err, tt.want)
+		}
+	}
+
+	for _, name := range []string{"calendar", "contributions view"} {
+		if _, err := visualizers.ParseViewName(name); err == nil || !strings.Contains(err.Error(), "unknown GUI view") {
+			t.Errorf("ParseViewName(%q): expected an unknown view error, got %v", name, err)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - GUI mouse handling tests
+
+//go:build gui
+// +build gui
+
+package visualizers
+
+import (
+	"testing"
+	"time"
+
+	"git-stats/models"
+	"git-stats/visualizers"
+
+	"github.com/gdamore/tcell/v2"
+	"github.com/rivo/tview"
+)
+
+// mouse sends a mouse action at x, y to a primitive's mouse handler
+func mouse(p tview.Primitive, action tview.MouseAction, x, y int) (bool, tview.Primitive) {
+	event := tcell.NewEventMouse(x, y, tcell.ButtonNone, tcell.ModNone)
+	return p.MouseHandler()(action, event, func(tview.Primitive) {})
+}
+
+func TestSplitterWidget_Drag(t *testing.T) {
+	splitter := visualizers.NewSplitterWidget()
+	splitter.SetRect(40, 0, 1, 20)
+
+	var dragged []int
+	ended := 0
+	splitter.OnDrag = func(x int) { dragged = append(dragged, x) }
+	splitter.OnDragEnd = func() { ended++ }
+
+	if consumed, _ := mouse(splitter, tview.MouseLeftDown, 10, 5); consumed {
+		t.Error("Expected a press beside the splitter to be ignored")
+	}
+	if consumed, capture := mouse(splitter, tview.MouseLeftDown, 40, 5); !consumed || capture != splitter {
+		t.Fatal("Expected a press on the splitter to capture the mouse")
+	}
+
+	// Moves anywhere on screen keep dragging until the button is released
+	for _, x := range []int{30, 25} {
+		if _, capture := mouse(splitter, tview.MouseMove, x, 12); capture != splitter {
+			t.Errorf("Expected the splitter to keep the mouse while dragging to %d", x)
+		}
+	}
+	if consumed, capture := mouse(splitter, tview.MouseLeftUp, 25, 12); !consumed || capture != nil {
+		t.Error("Expected the release to end the drag and free the mouse")
+	}
+
+	if len(dragged) != 2 || dragged[0] != 30 || dragged[1] != 25 || ended != 1 {
+		t.Errorf("Expected drags to 30 and 25 and one drag end, got %v and %d", dragged, ended)
+	}
+	if _, capture := mouse(splitter, tview.MouseMove, 20, 5); capture != nil || len(dragged) != 2 {
+		t.Error("Expected moves after the release to be ignored")
+	}
+}
+
+func TestViewTabsWidget_Click(t *testing.T) {
+	screen := tcell.NewSimulationScreen("UTF-8")
+	if err := screen.Init(); err != nil {
+		t.Fatalf("Failed to create screen: %v", err)
+	}
+	defer screen.Fini()
+	screen.SetSize(120, 1)
+
+	tabs := visualizers.NewViewTabsWidget(visualizers.NewGUIState(nil))
+	tabs.SetRect(0, 0, 120, 1)
+	tabs.Draw(screen)
+
+	var selected []visualizers.ViewType
+	tabs.OnSelect = func(view visualizers.ViewType) { selected = append(selected, view) }
+
+	// Find the number of the second tab on screen and click it
+	column := -1
+	for x := 0; x < 120 && column < 0; x++ {
+		if r, _, _, _ := screen.GetContent(x, 0); r == '2' {
+			column = x
+		}
+	}
+	if column < 0 {
+		t.Fatal("Expected a second tab")
+	}
+
+	mouse(tabs, tview.MouseLeftClick, column, 0)
+	mouse(tabs, tview.MouseLeftClick, column-2, 0) // the gap between the first two tabs
+	mouse(tabs, tview.MouseMove, 1, 0)
+	if len(selected) != 1 || selected[0] != visualizers.StatisticsView {
+		t.Errorf("Expected one click on the statistics tab, got %v", selected)
+	}
+}
+
+func TestContributionGraphWidget_DateAt(t *testing.T) {
+	start := time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC) // a Sunday
+	state := visualizers.NewGUIState(nil)
+	state.ViewStartDate, state.ViewEndDate = start, start.AddDate(0, 3, 0)
+
+	graph := visualizers.NewContributionGraphWidget(&models.ContributionGraph{DailyValues: map[string]int{}}, state)
+	graph.SetRect(0, 0, 80, 12) // inside the border, cells start 4 columns and 3 rows in
+
+	tests := []struct {
+		name string
+		x, y int
+		want string
+	}{
+		{"first cell", 5, 4, "2024-01-07"},
+		{"second column of a cell", 6, 4, "2024-01-07"},
+		{"next week's Monday", 8, 5, "2024-01-15"},
+		{"Saturday", 5, 10, "2024-01-13"},
+		{"gap between weeks", 7, 4, ""},
+		{"day labels", 3, 4, ""},
+		{"month labels", 5, 3, ""},
+		{"below the cells", 5, 11, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			date, ok := graph.DateAt(tt.x, tt.y)
+			if got := date.Format("2006-01-02"); ok != (tt.want != "") || (ok && got != tt.want) {
+				t.Errorf("DateAt(%d, %d) = %s, %v; want %q", tt.x, tt.y, got, ok, tt.want)
+			}
+		})
+	}
+
+	if consumed, _ := mouse(graph, tview.MouseLeftClick, 8, 5); !consumed || state.SelectedDate.Format("2006-01-02") != "2024-01-15" {
+		t.Errorf("Expected a click to select 2024-01-15, got %s", state.SelectedDate.Format("2006-01-02"))
+	}
+}
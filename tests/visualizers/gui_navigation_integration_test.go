@@ -295,21 +295,21 @@ func createTestAnalysisResultForNavigation() *models.AnalysisResult {
 
 	// Create test contribution graph with more data points
 	contribGraph := &models.ContributionGraph{
-		StartDate:    yearAgo,
-		EndDate:      now,
-		DailyCommits: make(map[string]int),
-		MaxCommits:   15,
-		TotalCommits: 250,
+		StartDate:   yearAgo,
+		EndDate:     now,
+		DailyValues: make(map[string]int),
+		MaxValue:    15,
+		Total:       250,
 	}
 
 	// Add test data for multiple dates
-	contribGraph.DailyCommits["2024-01-15"] = 5
-	contribGraph.DailyCommits["2024-01-16"] = 3
-	contribGraph.DailyCommits["2024-01-17"] = 8
-	contribGraph.DailyCommits["2024-01-18"] = 12
-	contribGraph.DailyCommits["2024-01-19"] = 7
-	contribGraph.DailyCommits["2024-01-20"] = 15
-	contribGraph.DailyCommits["2024-01-21"] = 2
+	contribGraph.DailyValues["2024-01-15"] = 5
+	contribGraph.DailyValues["2024-01-16"] = 3
+	contribGraph.DailyValues["2024-01-17"] = 8
+	contribGraph.DailyValues["2024-01-18"] = 12
+	contribGraph.DailyValues["2024-01-19"] = 7
+	contribGraph.DailyValues["2024-01-20"] = 15
+	contribGraph.DailyValues["2024-01-21"] = 2
 
 	// Create test summary
 	summary := &models.StatsSummary{
@@ -0,0 +1,169 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Tests for Braille line charts and sparklines
+
+package visualizers
+
+import (
+	"git-stats/models"
+	"git-stats/visualizers"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func TestSparkline(t *testing.T) {
+	if line := visualizers.Sparkline([]int{0, 4, 8}); utf8.RuneCountInString(line) != 3 {
+		t.Fatalf("Expected one glyph per value, got %q", line)
+	}
+
+	line := []rune(visualizers.Sparkline([]int{0, 4, 8}))
+	if line[0] != '·' || line[2] != '█' {
+		t.Errorf("Expected a quiet start and a full last glyph, got %q", string(line))
+	}
+
+	if line := visualizers.Sparkline([]int{0, 0}); line != "··" {
+		t.Errorf("Expected quiet glyphs for no activity, got %q", line)
+	}
+}
+
+func TestRenderLineChart(t *testing.T) {
+	renderer := visualizers.NewChartsRenderer(models.RenderConfig{})
+	labels := []string{"Jan", "Feb", "Mar", "Apr", "May"}
+	series := []visualizers.ChartSeries{
+		{Name: "Commits", Values: []float64{3, 12, 7, 20, 15}},
+		{Name: "Authors", Values: []float64{1, 2, 2, 4, 3}},
+	}
+
+	chart, err := renderer.RenderLineChart(labels, series, models.RenderConfig{Width: 60})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(chart, "\n"), "\n")
+	if len(lines) != 11 { // 8 plot rows, the axis, the labels and the legend
+		t.Fatalf("Expected 11 lines, got %d:\n%s", len(lines), chart)
+	}
+	for _, line := range lines {
+		if length := utf8.RuneCountInString(line); length > 60 {
+			t.Errorf("Line is %d characters: %q", length, line)
+		}
+	}
+
+	if !strings.HasPrefix(strings.TrimSpace(lines[0]), "20 ┤") {
+		t.Errorf("Expected the top tick to be the maximum, got %q", lines[0])
+	}
+	if !strings.HasPrefix(strings.TrimSpace(lines[7]), "0 ┤") {
+		t.Errorf("Expected the bottom tick to be zero, got %q", lines[7])
+	}
+	if !strings.ContainsFunc(strings.Join(lines[:8], ""), func(r rune) bool { return r > 0x2800 && r <= 0x28FF }) {
+		t.Error("Expected Braille dots in the chart")
+	}
+	if !strings.Contains(lines[9], "Jan") || !strings.Contains(lines[9], "May") {
+		t.Errorf("Expected the first and last labels, got %q", lines[9])
+	}
+	if !strings.Contains(lines[10], "Commits") || !strings.Contains(lines[10], "Authors") {
+		t.Errorf("Expected a legend for two series, got %q", lines[10])
+	}
+
+	single, _ := renderer.RenderLineChart(labels, series[:1], models.RenderConfig{Width: 60})
+	if strings.Contains(single, "Commits") {
+		t.Error("Expected no legend for a single series")
+	}
+}
+
+func TestRenderLineChartErrors(t *testing.T) {
+	renderer := visualizers.NewChartsRenderer(models.RenderConfig{})
+
+	if _, err := renderer.RenderLineChart(nil, nil, models.RenderConfig{}); err == nil {
+		t.Error("Expected an error without labels or series")
+	}
+
+	series := []visualizers.ChartSeries{{Name: "Commits", Values: []float64{1, 2}}}
+	if _, err := renderer.RenderLineChart([]string{"a", "b", "c"}, series, models.RenderConfig{}); err == nil {
+		t.Error("Expected an error when values and labels don't line up")
+	}
+}
+
+func TestRenderHealthMetrics_LineChart(t *testing.T) {
+	renderer := visualizers.NewChartsRenderer(models.RenderConfig{})
+	health := &models.HealthMetrics{
+		ActivityTrend: "stable",
+		MonthlyGrowth: []models.MonthlyStats{
+			{Month: time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC), Commits: 20, Authors: 2},
+			{Month: time.Date(2023, 4, 1, 0, 0, 0, 0, time.UTC), Commits: 8, Authors: 1},
+		},
+	}
+
+	result, err := renderer.RenderHealthMetrics(health, models.RenderConfig{Width: 80})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.Contains(result, "Monthly Activity") || !strings.Contains(result, "└") {
+		t.Errorf("Expected a monthly line chart:\n%s", result)
+	}
+	if !strings.Contains(result, "Authors") {
+		t.Errorf("Expected an authors series:\n%s", result)
+	}
+}
+
+func TestRenderContributorStats_Sparkline(t *testing.T) {
+	renderer := visualizers.NewChartsRenderer(models.RenderConfig{})
+	contributors := []models.Contributor{
+		{
+			Name:         "Alice",
+			Email:        "alice@example.com",
+			TotalCommits: 3,
+			LastCommit:   time.Date(2024, 3, 10, 12, 0, 0, 0, time.UTC),
+			CommitsByDay: map[string]int{"2024-03-10": 2, "2024-02-20": 1},
+		},
+	}
+
+	result, err := renderer.RenderContributorStats(contributors, models.RenderConfig{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.Contains(result, "Last 12 Weeks") {
+		t.Errorf("Expected a weekly sparkline column:\n%s", result)
+	}
+	if !strings.Contains(result, "█") {
+		t.Errorf("Expected the busiest week to be a full glyph:\n%s", result)
+	}
+}
+
+func TestRenderSummaryStats_WeekLabels(t *testing.T) {
+	renderer := visualizers.NewChartsRenderer(models.RenderConfig{})
+	weeks := func(start time.Time, count int) []models.WeeklyStats {
+		var result []models.WeeklyStats
+		for i := 0; i < count; i++ {
+			result = append(result, models.WeeklyStats{Week: start.AddDate(0, 0, 7*i), Commits: i % 3})
+		}
+		return result
+	}
+
+	tests := []struct {
+		name  string
+		weeks []models.WeeklyStats
+		want  string
+		avoid string
+	}{
+		{"one year", weeks(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), 20), "Jan 01", "Jan 01 2024"},
+		{"several years", weeks(time.Date(2023, 1, 16, 0, 0, 0, 0, time.UTC), 60), "Jan 16 2023", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := &models.StatsSummary{TotalCommits: 10, CommitsByWeek: tt.weeks}
+			result, err := renderer.RenderSummaryStats(summary, models.RenderConfig{Width: 80})
+			if err != nil {
+				t.Fatalf("Expected no error, got: %v", err)
+			}
+			if !strings.Contains(result, tt.want) {
+				t.Errorf("Expected tick label %q:\n%s", tt.want, result)
+			}
+			if tt.avoid != "" && strings.Contains(result, tt.avoid) {
+				t.Errorf("Expected no year within a single year, found %q:\n%s", tt.avoid, result)
+			}
+		})
+	}
+}
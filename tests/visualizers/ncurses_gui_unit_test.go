@@ -362,17 +362,17 @@ func createTestAnalysisResult() *models.AnalysisResult {
 
 	// Create test contribution graph
 	contribGraph := &models.ContributionGraph{
-		StartDate:    yearAgo,
-		EndDate:      now,
-		DailyCommits: make(map[string]int),
-		MaxCommits:   10,
-		TotalCommits: 100,
+		StartDate:   yearAgo,
+		EndDate:     now,
+		DailyValues: make(map[string]int),
+		MaxValue:    10,
+		Total:       100,
 	}
 
 	// Add some test data
-	contribGraph.DailyCommits["2024-01-15"] = 5
-	contribGraph.DailyCommits["2024-01-16"] = 3
-	contribGraph.DailyCommits["2024-01-17"] = 8
+	contribGraph.DailyValues["2024-01-15"] = 5
+	contribGraph.DailyValues["2024-01-16"] = 3
+	contribGraph.DailyValues["2024-01-17"] = 8
 
 	// Create test summary
 	summary := &models.StatsSummary{
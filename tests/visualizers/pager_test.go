@@ -0,0 +1,219 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Tests for the interactive table pager
+
+package visualizers
+
+import (
+	"bytes"
+	"encoding/base64"
+	"git-stats/visualizers"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func newTestPager() *visualizers.TablePager {
+	table := visualizers.NewInteractiveTable(
+		[]string{"Name", "Commits", "Share"},
+		[][]string{
+			{"Alice", "50", "45.5%"},
+			{"Bob", "9", "8.2%"},
+			{"Charlie", "51", "46.3%"},
+		},
+	)
+	return visualizers.NewTablePager(table, "Contributors")
+}
+
+// typed returns the key press of a character
+func typed(r rune) visualizers.PagerKey {
+	return visualizers.PagerKey{Code: visualizers.PagerKeyRune, Rune: r}
+}
+
+func TestTablePager_Sort(t *testing.T) {
+	pager := newTestPager()
+
+	// Sort by the selected column, then reverse the order
+	pager.HandleKey(visualizers.PagerKey{Code: visualizers.PagerKeyRight})
+	pager.HandleKey(typed('s'))
+	if row := pager.SelectedRow(); row[0] != "Bob" {
+		t.Errorf("Expected Bob first in ascending commits, got %v", row)
+	}
+
+	pager.HandleKey(typed('s'))
+	if row := pager.SelectedRow(); row[0] != "Charlie" {
+		t.Errorf("Expected Charlie first in descending commits, got %v", row)
+	}
+
+	// Percentages sort as numbers
+	pager.HandleKey(typed('l'))
+	pager.HandleKey(typed('s'))
+	if row := pager.SelectedRow(); row[0] != "Bob" {
+		t.Errorf("Expected Bob's 8.2%% first, got %v", row)
+	}
+}
+
+func TestTablePager_Filter(t *testing.T) {
+	pager := newTestPager()
+
+	pager.HandleKey(typed('/'))
+	for _, r := range "li" {
+		pager.HandleKey(typed(r))
+	}
+	if rows := pager.Table.VisibleRows(); len(rows) != 2 {
+		t.Fatalf("Expected Alice and Charlie to match 'li', got %v", rows)
+	}
+
+	// Keys go to the filter until Enter
+	if pager.HandleKey(typed('q')) {
+		t.Fatal("Expected q to be typed into the filter")
+	}
+	if pager.Table.Filter != "liq" {
+		t.Errorf("Expected filter 'liq', got %q", pager.Table.Filter)
+	}
+	if !strings.Contains(pager.Render(), "No matching rows") {
+		t.Error("Expected an empty page for a filter without matches")
+	}
+
+	pager.HandleKey(visualizers.PagerKey{Code: visualizers.PagerKeyBackspace})
+	pager.HandleKey(visualizers.PagerKey{Code: visualizers.PagerKeyEnter})
+	if !strings.Contains(pager.Render(), "Filter: li") {
+		t.Error("Expected the status line to show the filter")
+	}
+
+	pager.HandleKey(typed('/'))
+	pager.HandleKey(visualizers.PagerKey{Code: visualizers.PagerKeyEscape})
+	if pager.Table.Filter != "" || len(pager.Table.VisibleRows()) != 3 {
+		t.Errorf("Expected Escape to clear the filter, got %q", pager.Table.Filter)
+	}
+
+	if !pager.HandleKey(typed('q')) {
+		t.Error("Expected q to quit outside the filter")
+	}
+}
+
+func TestTablePager_Pages(t *testing.T) {
+	var rows [][]string
+	for i := 0; i < 25; i++ {
+		rows = append(rows, []string{strings.Repeat("x", i%7+1), "1"})
+	}
+	pager := visualizers.NewTablePager(visualizers.NewInteractiveTable([]string{"Name", "Commits"}, rows), "Files")
+	pager.Height = 15 // 10 rows a page
+
+	pager.HandleKey(visualizers.PagerKey{Code: visualizers.PagerKeyPageDown})
+	if pager.Table.CurrentRow != 10 || !strings.Contains(pager.Render(), "Page 2 of 3") {
+		t.Errorf("Expected row 11 on page 2, got row %d", pager.Table.CurrentRow+1)
+	}
+
+	pager.HandleKey(visualizers.PagerKey{Code: visualizers.PagerKeyEnd})
+	if pager.Table.CurrentRow != 24 || !strings.Contains(pager.Render(), "Row 25 of 25") {
+		t.Errorf("Expected the last row, got row %d", pager.Table.CurrentRow+1)
+	}
+
+	pager.HandleKey(visualizers.PagerKey{Code: visualizers.PagerKeyPageUp})
+	pager.HandleKey(visualizers.PagerKey{Code: visualizers.PagerKeyPageUp})
+	pager.HandleKey(visualizers.PagerKey{Code: visualizers.PagerKeyUp})
+	if pager.Table.CurrentRow != 3 {
+		t.Errorf("Expected row 4, got %d", pager.Table.CurrentRow+1)
+	}
+
+	// Every screen fills the terminal height exactly
+	if lines := strings.Count(pager.Render(), "\n") + 1; lines != 15 {
+		t.Errorf("Expected 15 lines, got %d", lines)
+	}
+}
+
+func TestTablePager_Views(t *testing.T) {
+	pager := newTestPager()
+	teams := visualizers.NewInteractiveTable([]string{"Team", "Commits"}, [][]string{{"web", "59"}, {"platform", "51"}})
+	pager.AddView(teams, "Teams")
+	pager.Width = 120
+
+	if screen := pager.Render(); !strings.Contains(screen, "Contributors (1 of 2)") || !strings.Contains(screen, "Tab Table") {
+		t.Error("Expected the first of two tables with Tab in the key help")
+	}
+
+	pager.HandleKey(visualizers.PagerKey{Code: visualizers.PagerKeyDown})
+	pager.HandleKey(typed('l'))
+	pager.HandleKey(visualizers.PagerKey{Code: visualizers.PagerKeyTab})
+	if pager.Table != teams || !strings.Contains(pager.Render(), "Teams (2 of 2)") {
+		t.Fatal("Expected Tab to switch to the team table")
+	}
+	if row := pager.SelectedRow(); row == nil || row[0] != "platform" {
+		t.Errorf("Expected the team table to start at its first row, got %v", row)
+	}
+
+	// Tab returns to the first table where it was left
+	pager.HandleKey(visualizers.PagerKey{Code: visualizers.PagerKeyTab})
+	if row := pager.SelectedRow(); pager.Title != "Contributors" || row == nil || row[0] != "Bob" {
+		t.Errorf("Expected the contributor table at Bob, got %s at %v", pager.Title, row)
+	}
+	pager.HandleKey(typed('y'))
+	if !strings.Contains(pager.Render(), "Copied row 2") {
+		t.Error("Expected the copy to use the row kept in the first table")
+	}
+
+	// A single table ignores Tab and keeps the plain key help
+	single := newTestPager()
+	single.HandleKey(visualizers.PagerKey{Code: visualizers.PagerKeyTab})
+	if screen := single.Render(); strings.Contains(screen, "of 1)") || strings.Contains(screen, "Tab Table") {
+		t.Error("Expected a single table to show no table count")
+	}
+}
+
+func TestTablePager_ResizeAndWidth(t *testing.T) {
+	pager := newTestPager()
+	pager.Width = 30
+
+	before := pager.Table.ColumnWidths[0]
+	pager.HandleKey(typed('+'))
+	if pager.Table.ColumnWidths[0] != before+2 {
+		t.Errorf("Expected the Name column to widen from %d to %d, got %d", before, before+2, pager.Table.ColumnWidths[0])
+	}
+	for i := 0; i < 10; i++ {
+		pager.HandleKey(typed('-'))
+	}
+	if pager.Table.ColumnWidths[0] < 5 {
+		t.Errorf("Expected columns to keep a minimum width, got %d", pager.Table.ColumnWidths[0])
+	}
+
+	for i := 0; i < 20; i++ {
+		pager.HandleKey(typed('>'))
+	}
+	for _, line := range strings.Split(stripANSI(pager.Render()), "\n") {
+		if length := utf8.RuneCountInString(line); length > 30 {
+			t.Errorf("Line is %d characters: %q", length, line)
+		}
+	}
+}
+
+func TestTablePager_Run(t *testing.T) {
+	pager := newTestPager()
+	var out bytes.Buffer
+
+	// Down arrow, copy, quit
+	if err := pager.Run(strings.NewReader("\x1b[By\x1b[6~q"), &out); err != nil {
+		t.Fatalf("Run: %v", err)
+	}
+
+	copied := base64.StdEncoding.EncodeToString([]byte("Bob\t9\t8.2%"))
+	if !strings.Contains(out.String(), "\x1b]52;c;"+copied+"\a") {
+		t.Error("Expected the second row to be copied with OSC 52")
+	}
+	if !strings.Contains(out.String(), "Copied row 2") {
+		t.Error("Expected a status message for the copy")
+	}
+}
+
+// stripANSI removes escape sequences so line lengths count visible characters
+func stripANSI(text string) string {
+	var out strings.Builder
+	for i := 0; i < len(text); i++ {
+		if text[i] == '\x1b' {
+			for i++; i < len(text) && (text[i] < 'A' || text[i] > 'z' || text[i] == '['); i++ {
+			}
+			continue
+		}
+		out.WriteByte(text[i])
+	}
+	return out.String()
+}
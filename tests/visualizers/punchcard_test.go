@@ -0,0 +1,117 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Tests for the punch card renderer
+
+package visualizers
+
+import (
+	"git-stats/models"
+	"git-stats/visualizers"
+	"strings"
+	"testing"
+	"time"
+	"unicode/utf8"
+)
+
+func createTestPunchCard() *models.PunchCard {
+	var card models.PunchCard
+	card[time.Monday][9] = 8
+	card[time.Monday][14] = 2
+	card[time.Friday][23] = 1
+	return &card
+}
+
+func TestPunchCardLevel(t *testing.T) {
+	tests := []struct {
+		commits, max, expected int
+	}{
+		{0, 8, 0},
+		{1, 8, 1},
+		{2, 8, 1},
+		{3, 8, 2},
+		{6, 8, 3},
+		{8, 8, 4},
+		{5, 0, 0},
+	}
+
+	for _, tt := range tests {
+		if level := visualizers.PunchCardLevel(tt.commits, tt.max); level != tt.expected {
+			t.Errorf("PunchCardLevel(%d, %d) = %d, want %d", tt.commits, tt.max, level, tt.expected)
+		}
+	}
+}
+
+func TestRenderPunchCard(t *testing.T) {
+	card := createTestPunchCard()
+
+	for _, width := range []int{100, 60, 40} {
+		renderer := visualizers.NewPunchCardRenderer(models.RenderConfig{Width: width})
+		renderer.SetColorOptions(false)
+
+		output, err := renderer.RenderPunchCard(card)
+		if err != nil {
+			t.Fatalf("RenderPunchCard: %v", err)
+		}
+
+		lines := strings.Split(strings.TrimSuffix(output, "\n"), "\n")
+		if len(lines) != 8 {
+			t.Fatalf("width %d: expected an hour row and 7 weekday rows, got %d lines:\n%s", width, len(lines), output)
+		}
+		for _, line := range lines {
+			if length := utf8.RuneCountInString(line); length > width {
+				t.Errorf("width %d: line is %d characters: %q", width, length, line)
+			}
+		}
+
+		monday := lines[2]
+		if !strings.HasPrefix(monday, "Mon") || !strings.HasSuffix(monday, " 10") {
+			t.Errorf("width %d: expected Monday with 10 commits, got %q", width, monday)
+		}
+		if strings.Count(monday, "●") != 1 || strings.Count(monday, "∘") != 1 {
+			t.Errorf("width %d: expected one full and one small circle on Monday, got %q", width, monday)
+		}
+	}
+
+	renderer := visualizers.NewPunchCardRenderer(models.RenderConfig{Width: 100})
+	renderer.SetColorOptions(false)
+	if legend := renderer.RenderLegend(card); !strings.Contains(legend, "Busiest: Mon 09:00 (8 commits)") {
+		t.Errorf("unexpected legend: %q", legend)
+	}
+	if legend := renderer.RenderLegend(&models.PunchCard{}); !strings.Contains(legend, "No commits") {
+		t.Errorf("unexpected legend for an empty card: %q", legend)
+	}
+
+	if _, err := renderer.RenderPunchCard(nil); err == nil {
+		t.Error("expected an error for a nil punch card")
+	}
+}
+
+func TestRenderPunchCardSVG(t *testing.T) {
+	theme, err := models.NewTheme("test", []string{"#000000", "#111111", "#222222", "#333333", "#444444"})
+	if err != nil {
+		t.Fatalf("NewTheme: %v", err)
+	}
+
+	renderer := visualizers.NewPunchCardRenderer(models.RenderConfig{})
+	renderer.SetTheme(theme)
+
+	svg, err := renderer.RenderPunchCardSVG(createTestPunchCard(), "a <b> punch card")
+	if err != nil {
+		t.Fatalf("RenderPunchCardSVG: %v", err)
+	}
+
+	if !strings.HasPrefix(svg, "<?xml") || !strings.HasSuffix(svg, "</svg>\n") {
+		t.Error("expected a standalone SVG document")
+	}
+	if count := strings.Count(svg, "<circle"); count != 7*24 {
+		t.Errorf("expected one circle per hour, got %d", count)
+	}
+	if !strings.Contains(svg, "a &lt;b&gt; punch card") {
+		t.Error("expected the escaped title")
+	}
+	if !strings.Contains(svg, `fill="#444444"><title>Mon 09:00: 8 commits</title>`) {
+		t.Error("expected the busiest hour in the theme's last color with a tooltip")
+	}
+	if !strings.Contains(svg, `r="12.0" fill="#444444"`) {
+		t.Error("expected the busiest hour to get the largest circle")
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Tests for the team statistics table
+
+package visualizers
+
+import (
+	"git-stats/models"
+	"git-stats/visualizers"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRenderTeamStatistics(t *testing.T) {
+	renderer := visualizers.NewChartsRenderer(models.RenderConfig{})
+	renderer.SetColorOptions(false)
+	day := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+
+	teams := []models.TeamStats{
+		{Name: "web", Members: 2, Commits: 3, Insertions: 30, Deletions: 6, ActiveDays: 2, Files: 2, OwnedFiles: 1,
+			Ownership: 50, CommitShare: 75, FirstCommit: day, LastCommit: day.AddDate(0, 0, 2)},
+		{Name: models.NoTeam, Members: 1, Commits: 1, Insertions: 10, ActiveDays: 1, Files: 1, OwnedFiles: 1,
+			Ownership: 50, CommitShare: 25, FirstCommit: day, LastCommit: day},
+	}
+
+	headers, rows := visualizers.TeamTable(teams)
+	if len(headers) != 10 || len(rows) != 2 {
+		t.Fatalf("Expected 10 columns and two rows, got %v and %d rows", headers, len(rows))
+	}
+	if rows[0][3] != "75.0%" || rows[0][4] != "+30 -6" || rows[0][7] != "1 (50.0%)" || rows[0][9] != "2024-03-06" {
+		t.Errorf("Unexpected row %v", rows[0])
+	}
+
+	output, err := renderer.RenderTeamStatistics(teams, models.RenderConfig{})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	for _, want := range []string{"Team Statistics", "web", models.NoTeam, "owns the files"} {
+		if !strings.Contains(output, want) {
+			t.Errorf("Expected %q in the table:\n%s", want, output)
+		}
+	}
+
+	output, _ = renderer.RenderTeamStatistics(nil, models.RenderConfig{})
+	if !strings.Contains(output, "No team activity") {
+		t.Errorf("Expected a note without teams, got %q", output)
+	}
+}
+
+func TestRenderTeamStatistics_FullCells(t *testing.T) {
+	renderer := visualizers.NewChartsRenderer(models.RenderConfig{})
+	renderer.SetColorOptions(false)
+	day := time.Date(2024, 3, 4, 0, 0, 0, 0, time.UTC)
+
+	teams := []models.TeamStats{
+		{Name: "Platform Infrastructure and Reliability", Members: 14, Commits: 1234, Insertions: 123456,
+			Deletions: 65432, ActiveDays: 321, Files: 987, OwnedFiles: 654, Ownership: 66.3, CommitShare: 81.2,
+			FirstCommit: day.AddDate(-3, 0, 0), LastCommit: day},
+		{Name: models.NoTeam, Members: 1, Commits: 1, Insertions: 10, ActiveDays: 1, Files: 1, OwnedFiles: 1,
+			Ownership: 0.1, CommitShare: 0.1, FirstCommit: day, LastCommit: day},
+	}
+	headers, rows := visualizers.TeamTable(teams)
+
+	for _, config := range []models.RenderConfig{{}, {Width: 40}} {
+		output, err := renderer.RenderTeamStatistics(teams, config)
+		if err != nil {
+			t.Fatalf("Expected no error, got: %v", err)
+		}
+		assertFullCells(t, output, headers, rows)
+	}
+}
+
+func TestRenderTeamMultiples(t *testing.T) {
+	renderer := visualizers.NewContributionGraphRenderer(models.RenderConfig{Width: 200})
+	renderer.SetColorOptions(false, "github")
+	end := time.Date(2024, 3, 9, 0, 0, 0, 0, time.UTC)
+
+	graph := &models.ContributionGraph{StartDate: end.AddDate(0, 0, -27), EndDate: end, ByTeam: true,
+		DailyValues: map[string]int{"2024-03-04": 3}, MaxValue: 3, Total: 3}
+	for _, name := range []string{"web", "platform", "data"} {
+		graph.Authors = append(graph.Authors, models.AuthorContributions{Name: name, Daily: map[string]int{"2024-03-04": 1}, Total: 1})
+	}
+
+	output, err := renderer.RenderAuthorMultiples(graph, 2, models.RenderConfig{ShowLegend: true})
+	if err != nil {
+		t.Fatalf("Expected no error, got: %v", err)
+	}
+	if !strings.Contains(output, "web (1)") || !strings.Contains(output, "1 more team not shown") {
+		t.Errorf("Expected team calendars and a team count, got:\n%s", output)
+	}
+}
@@ -363,15 +363,15 @@ func createTestAnalysisResult() *models.AnalysisResult {
 			},
 		},
 		ContribGraph: &models.ContributionGraph{
-			StartDate:    now.AddDate(-1, 0, 0),
-			EndDate:      now,
-			DailyCommits: map[string]int{
+			StartDate: now.AddDate(-1, 0, 0),
+			EndDate:   now,
+			DailyValues: map[string]int{
 				"2024-01-15": 3,
 				"2024-01-16": 5,
 				"2024-01-17": 2,
 			},
-			MaxCommits:   5,
-			TotalCommits: 1234,
+			MaxValue: 5,
+			Total:    1234,
 		},
 		HealthMetrics: &models.HealthMetrics{
 			RepositoryAge:      365 * 24 * time.Hour,
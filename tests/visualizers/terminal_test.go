@@ -546,3 +546,39 @@ func BenchmarkColoredBarChartRender(b *testing.B) {
 		_ = chart.RenderChart()
 	}
 }
+
+func TestInteractiveTableColumns(t *testing.T) {
+	table := visualizers.NewInteractiveTable(
+		[]string{"File", "Share"},
+		[][]string{
+			{"#1 src/very/long/path/to/a/file.go", "9.5%"},
+			{"#2 main.go", "12.0%"},
+			{"#10 go.mod", "1,200"},
+		},
+	)
+
+	table.FitColumns(20)
+	if table.ColumnWidths[0] != 20 || table.ColumnWidths[1] != 7 {
+		t.Errorf("Expected widths [20 7], got %v", table.ColumnWidths)
+	}
+
+	table.ResizeColumn(1, -10)
+	if table.ColumnWidths[1] != 5 {
+		t.Errorf("Expected the minimum width of 5, got %d", table.ColumnWidths[1])
+	}
+
+	// Percentages and thousands sort as numbers; sorting leaves Rows in their order
+	table.SortBy(1)
+	rows := table.VisibleRows()
+	if rows[0][1] != "9.5%" || rows[2][1] != "1,200" {
+		t.Errorf("Expected numeric order, got %v", rows)
+	}
+	if table.Rows[0][1] != "9.5%" || table.Rows[1][1] != "12.0%" {
+		t.Error("Expected VisibleRows to leave Rows unsorted")
+	}
+
+	table.SortBy(1)
+	if rows := table.VisibleRows(); table.SortAsc || rows[0][1] != "1,200" {
+		t.Errorf("Expected the second SortBy to reverse the order, got %v", rows)
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Tests for color detection and width-aware rendering
+
+package visualizers
+
+import (
+	"git-stats/models"
+	"git-stats/visualizers"
+	"os"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestColorEnabled(t *testing.T) {
+	tests := []struct {
+		name       string
+		mode       string
+		toTerminal bool
+		noColor    string
+		force      string
+		expected   bool
+	}{
+		{"auto on a terminal", models.ColorAuto, true, "", "", true},
+		{"auto on a pipe", models.ColorAuto, false, "", "", false},
+		{"NO_COLOR on a terminal", models.ColorAuto, true, "1", "", false},
+		{"CLICOLOR_FORCE on a pipe", models.ColorAuto, false, "", "1", true},
+		{"CLICOLOR_FORCE=0 on a pipe", models.ColorAuto, false, "", "0", false},
+		{"NO_COLOR wins over CLICOLOR_FORCE", models.ColorAuto, false, "1", "1", false},
+		{"always on a pipe", models.ColorAlways, false, "1", "", true},
+		{"never on a terminal", models.ColorNever, true, "", "1", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("TERM", "xterm")
+			t.Setenv("NO_COLOR", tt.noColor)
+			t.Setenv("CLICOLOR_FORCE", tt.force)
+
+			if got := visualizers.ColorEnabled(tt.mode, tt.toTerminal); got != tt.expected {
+				t.Errorf("ColorEnabled(%q, %v) = %v, want %v", tt.mode, tt.toTerminal, got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestGetTerminalWidth_Columns(t *testing.T) {
+	// Test output is not a terminal, so COLUMNS decides
+	if visualizers.IsTerminal(os.Stdout) {
+		t.Skip("stdout is a terminal")
+	}
+
+	t.Setenv("COLUMNS", "132")
+	if width := visualizers.GetTerminalWidth(); width != 132 {
+		t.Errorf("expected width 132 from COLUMNS, got %d", width)
+	}
+
+	t.Setenv("COLUMNS", "")
+	if width := visualizers.GetTerminalWidth(); width != visualizers.DefaultTerminalWidth {
+		t.Errorf("expected default width %d, got %d", visualizers.DefaultTerminalWidth, width)
+	}
+}
+
+func TestRenderTable_FitsWidth(t *testing.T) {
+	renderer := visualizers.NewChartsRenderer(models.RenderConfig{})
+	headers := []string{"Name", "Email", "First Commit"}
+	rows := [][]string{
+		{"Alice Johnson", "alice.johnson@example.com", "2024-01-05"},
+		{"Bob", "bob@example.com", "2024-02-11"},
+	}
+
+	for _, width := range []int{80, 50, 40} {
+		table, err := renderer.RenderTable(headers, rows, models.RenderConfig{Width: width, FitWidth: true})
+		if err != nil {
+			t.Fatalf("RenderTable: %v", err)
+		}
+		for _, line := range strings.Split(strings.TrimSuffix(table, "\n"), "\n") {
+			if length := utf8.RuneCountInString(line); length > width {
+				t.Errorf("width %d: line is %d characters: %q", width, length, line)
+			}
+		}
+	}
+
+	// Headers are cut before the cells below them
+	table, _ := renderer.RenderTable(headers, rows, models.RenderConfig{Width: 50, FitWidth: true})
+	if !strings.Contains(table, "2024-01-05") || strings.Contains(table, "First Commit") {
+		t.Errorf("expected the header rather than the dates to be cut:\n%s", table)
+	}
+
+	// Without FitWidth the table keeps its natural size
+	table, _ = renderer.RenderTable(headers, rows, models.RenderConfig{Width: 50})
+	if !strings.Contains(table, "alice.johnson@example.com") || !strings.Contains(table, "First Commit") {
+		t.Errorf("expected full cells without FitWidth:\n%s", table)
+	}
+}
+
+func TestRenderBarChart_FitsWidth(t *testing.T) {
+	renderer := visualizers.NewChartsRenderer(models.RenderConfig{})
+	data := map[string]int{"alice": 120, "bob": 30}
+
+	chart, err := renderer.RenderBarChart(data, "", models.RenderConfig{Width: 40})
+	if err != nil {
+		t.Fatalf("RenderBarChart: %v", err)
+	}
+	lines := strings.Split(strings.TrimSuffix(chart, "\n"), "\n")
+	if length := utf8.RuneCountInString(lines[0]); length != 40 {
+		t.Errorf("expected the longest bar to fill 40 columns, got %d: %q", length, lines[0])
+	}
+}
+
+func TestInteractiveTable_Width(t *testing.T) {
+	table := visualizers.NewInteractiveTable(
+		[]string{"Author", "Commits"},
+		[][]string{{"Alice Johnson-Whitaker", "127"}},
+	)
+
+	if !strings.Contains(table.RenderTable(), "Alice Johnso...") {
+		t.Error("expected 15 character columns without a width")
+	}
+
+	table.Width = 60
+	if !strings.Contains(table.RenderTable(), "Alice Johnson-Whitaker") {
+		t.Error("expected columns to widen to the table width")
+	}
+}
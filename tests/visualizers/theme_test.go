@@ -0,0 +1,70 @@
+// Copyright (c) 2019 Sunil
+// Enhanced git-stats tool - Theme color depth tests
+
+package visualizers
+
+import (
+	"strings"
+	"testing"
+
+	"git-stats/models"
+	"git-stats/visualizers"
+)
+
+func TestLevelEscape_Distinct16(t *testing.T) {
+	themes := make(map[string]*models.Theme)
+	for _, name := range models.BuiltinThemeNames() {
+		theme, err := models.ResolveTheme(name, nil)
+		if err != nil {
+			t.Fatalf("ResolveTheme(%s): %v", name, err)
+		}
+		themes[name] = theme
+	}
+
+	// Custom themes have no 16-color palette of their own; close shades must still stay apart
+	custom := map[string][]string{
+		"greens": {"#30363d", "#0e4429", "#006d32", "#26a641", "#39d353"},
+		"grays":  {"#111111", "#222222", "#333333", "#444444", "#555555"},
+		"short":  {"#000000", "#00ff00"},
+	}
+	for name := range custom {
+		theme, err := models.ResolveTheme(name, custom)
+		if err != nil {
+			t.Fatalf("ResolveTheme(%s): %v", name, err)
+		}
+		themes[name] = theme
+	}
+
+	for name, theme := range themes {
+		seen := make(map[string]int)
+		for level := 0; level <= 4; level++ {
+			escape := visualizers.LevelEscape(theme, level, visualizers.Color16)
+			if !strings.HasPrefix(escape, "\033[") || strings.Contains(escape, "38;") {
+				t.Errorf("%s level %d: expected a 16-color escape, got %q", name, level, escape)
+			}
+			if previous, ok := seen[escape]; ok && theme.LevelIndex(previous) != theme.LevelIndex(level) {
+				t.Errorf("%s: levels %d and %d share %q", name, previous, level, escape)
+			}
+			seen[escape] = level
+		}
+	}
+}
+
+func TestLevelEscape_GithubKeepsClassicColors(t *testing.T) {
+	theme, err := models.ResolveTheme("github", nil)
+	if err != nil {
+		t.Fatalf("ResolveTheme: %v", err)
+	}
+
+	want := []string{"\033[90m", "\033[92m", "\033[32m", "\033[32;1m", "\033[33;1m"}
+	for level, escape := range want {
+		if got := visualizers.LevelEscape(theme, level, visualizers.Color16); got != escape {
+			t.Errorf("level %d: got %q, want %q", level, got, escape)
+		}
+	}
+
+	// Deeper terminals keep the theme's own colors
+	if got := visualizers.LevelEscape(theme, 4, visualizers.TrueColor); got != "\033[38;2;57;211;83m" {
+		t.Errorf("true color level 4: got %q", got)
+	}
+}